@@ -12,18 +12,24 @@ import (
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	globalfeekeeper "github.com/CosmosContracts/juno/v18/x/globalfee/keeper"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
+	"github.com/CosmWasm/wasmd/precompile/contracts/wasmd"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	storetypes "cosmossdk.io/store/types"
@@ -43,10 +49,15 @@ const maxBypassMinFeeMsgGasUsage = 1_000_000
 type HandlerOptions struct {
 	ante.HandlerOptions
 	AccountKeeper         evmtypes.AccountKeeper
+	Codec                 codec.Codec
+	LegacyAmino           *codec.LegacyAmino
 	IBCKeeper             *keeper.Keeper
 	EvmKeeper             *evmkeeper.Keeper
 	GlobalFeeKeeper       globalfeekeeper.Keeper
 	StakingKeeper         stakingkeeper.Keeper
+	DistrKeeper           distrkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	TransferKeeper        *ibctransferkeeper.Keeper
 	FeeMarketKeeper       feemarketkeeper.Keeper
 	WasmConfig            *wasmTypes.WasmConfig
 	WasmKeeper            *wasmkeeper.Keeper
@@ -56,8 +67,10 @@ type HandlerOptions struct {
 	MaxTxGasWanted        uint64
 	CircuitKeeper         *circuitkeeper.Keeper
 	BankKeeper            *bankkeeper.BaseKeeper
+	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
 	DisabledAuthzMsgs     []string
 	BypassMinFeeMsgTypes  []string
+	WasmdPrecompileOpts   []wasmd.Option
 }
 
 func (options *HandlerOptions) Validate() error {
@@ -88,7 +101,21 @@ func (options *HandlerOptions) Validate() error {
 	if options.ContractKeeper == nil {
 		return errors.New("contract keeper is required for ante builder")
 	}
-
+	if options.GovKeeper == nil {
+		return errors.New("gov keeper is required for ante builder")
+	}
+	if options.TransferKeeper == nil {
+		return errors.New("transfer keeper is required for ante builder")
+	}
+	if options.TokenFactoryKeeper == nil {
+		return errors.New("tokenfactory keeper is required for ante builder")
+	}
+	if options.Codec == nil {
+		return errors.New("codec is required for ante builder")
+	}
+	if options.LegacyAmino == nil {
+		return errors.New("legacy amino codec is required for ante builder")
+	}
 	return nil
 }
 
@@ -103,7 +130,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
 
-		registry.InitializePrecompiles(options.ContractKeeper, options.WasmKeeper, options.EvmKeeper, options.BankKeeper, options.AccountKeeper)
+		registry.InitializePrecompiles(options.ContractKeeper, options.WasmKeeper, options.EvmKeeper, options.BankKeeper, options.WasmKeeper, options.TokenFactoryKeeper, tokenfactorykeeper.NewMsgServerImpl(*options.TokenFactoryKeeper), stakingkeeper.NewMsgServerImpl(&options.StakingKeeper), stakingkeeper.NewQuerier(&options.StakingKeeper), distrkeeper.NewMsgServerImpl(options.DistrKeeper), distrkeeper.NewQuerier(options.DistrKeeper), options.Codec, govkeeper.NewMsgServerImpl(options.GovKeeper), govkeeper.NewQueryServer(options.GovKeeper), options.TransferKeeper, options.TransferKeeper, options.AccountKeeper, options.WasmKeeper, options.WasmdPrecompileOpts...)
 
 		var anteHandler sdk.AnteHandler
 
@@ -126,20 +153,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 					anteHandler = newEthAnteHandler(options)
 				case "/ethermint.types.v1.ExtensionOptionsWeb3Tx":
 					// handle as normal Cosmos SDK tx, except signature is checked for EIP712 representation
-					anteHandler = evmante.NewLegacyCosmosAnteHandlerEip712(evmante.HandlerOptions{
-						AccountKeeper:          options.AccountKeeper,
-						BankKeeper:             options.BankKeeper,
-						SignModeHandler:        options.SignModeHandler,
-						FeegrantKeeper:         options.FeegrantKeeper,
-						SigGasConsumer:         options.SigGasConsumer,
-						IBCKeeper:              options.IBCKeeper,
-						EvmKeeper:              options.EvmKeeper,
-						FeeMarketKeeper:        options.FeeMarketKeeper,
-						MaxTxGasWanted:         options.MaxTxGasWanted,
-						ExtensionOptionChecker: options.ExtensionOptionChecker,
-						TxFeeChecker:           options.TxFeeChecker,
-						DisabledAuthzMsgs:      options.DisabledAuthzMsgs,
-					})
+					anteHandler = newCosmosAnteHandlerEip712(options)
 				default:
 					return ctx, errorsmod.Wrapf(
 						sdkerrors.ErrUnknownExtensionOptions,
@@ -186,9 +200,16 @@ func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		// nil so that it only checks with the min gas price of the chain, not the custom fee checker. For cosmos messages, the default tx fee checker is enough
-		globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		// txs that only execute gasless contracts skip the fee decorators
+		wasmkeeper.NewGaslessTxDecorator(
+			[]sdk.AnteDecorator{
+				// nil so that it only checks with the min gas price of the chain, not the custom fee checker. For cosmos messages, the default tx fee checker is enough
+				globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage),
+				ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+			},
+			options.WasmKeeper,
+			options.TXCounterStoreService,
+		),
 		// we use evmante.NewSetPubKeyDecorator so that for eth_secp256k1 accs, we can validate the signer using the evm-cosmos mapping logic
 		evmante.NewSetPubKeyDecorator(options.AccountKeeper, options.EvmKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -131,6 +131,8 @@ import (
 	srvflags "github.com/evmos/ethermint/server/flags"
 	"github.com/spf13/cast"
 
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -155,12 +157,10 @@ import (
 	simappparams "cosmossdk.io/simapp/params"
 	evmv1 "github.com/evmos/ethermint/api/ethermint/evm/v1"
 	evmante "github.com/evmos/ethermint/app/ante"
-	"github.com/evmos/ethermint/ethereum/eip712"
 	etherminttypes "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
-	"github.com/evmos/ethermint/x/evm/vm/geth"
 	"github.com/evmos/ethermint/x/feemarket"
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -185,12 +185,6 @@ var (
 	Bech32Prefix = "orai"
 	CosmosDenom  = Bech32Prefix
 	EvmDenom     = "aorai" // atto orai. This will be converted automatically by evmutil of kava
-
-	EnabledCapabilities = []string{
-		tokenfactorytypes.EnableBurnFrom,
-		tokenfactorytypes.EnableForceTransfer,
-		tokenfactorytypes.EnableSetMetadata,
-	}
 )
 
 // These constants are derived from the above variables.
@@ -354,7 +348,7 @@ func NewWasmApp(
 		Amino:             legacyAmino,
 	}
 
-	eip712.SetEncodingConfig(encodingConfig)
+	registerEIP712EncodingConfig(encodingConfig)
 
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
@@ -617,7 +611,7 @@ func NewWasmApp(
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[evmtypes.StoreKey]), tkeys[evmtypes.TransientKey], Authority,
 		app.AccountKeeper, evmBankKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
-		nil, geth.NewEVM, tracer, evmSs,
+		nil, pcommon.NewEVM, tracer, evmSs,
 	)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
@@ -761,6 +755,8 @@ func NewWasmApp(
 
 	wasmOpts = append(bindings.RegisterCustomPlugins(&app.BankKeeper, &app.TokenFactoryKeeper), wasmOpts...)
 	wasmOpts = append(RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
+	// wraps the custom encoder and querier set by the options above
+	wasmOpts = append(wasmOpts, wasmkeeper.WithEVMKeeper(app.EvmKeeper))
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -801,12 +797,14 @@ func NewWasmApp(
 
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		keys[tokenfactorytypes.StoreKey],
-		app.GetSubspace(tokenfactorytypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
-		EnabledCapabilities,
+		app.ContractKeeper,
+		AuthorityAddr,
 	)
+	// call the before send hook contracts of tokenfactory denoms on every bank send
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.BeforeSendRestriction())
 
 	// Create fee enabled wasm ibc Stack
 	var wasmStack porttypes.IBCModule
@@ -900,7 +898,7 @@ func NewWasmApp(
 		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
 		ibchooks.NewAppModule(app.AccountKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
-		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.GetSubspace(erc20types.ModuleName)),
@@ -1135,6 +1133,8 @@ func NewWasmApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			panic(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+		// Register the bank pointer precompiles as the precompile registry is not persisted
+		registry.RegisterBankPointers(ctx, app.EvmKeeper, app.BankKeeper, app.WasmKeeper, app.WasmKeeper, app.WasmKeeper)
 	}
 
 	return app
@@ -1151,10 +1151,16 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 				TxFeeChecker:           evmante.NewDynamicFeeChecker(app.EvmKeeper),
 			},
 			AccountKeeper:         app.AccountKeeper,
+			Codec:                 app.appCodec,
+			LegacyAmino:           app.legacyAmino,
 			BankKeeper:            &app.BankKeeper,
+			TokenFactoryKeeper:    &app.TokenFactoryKeeper,
 			IBCKeeper:             app.IBCKeeper,
 			EvmKeeper:             app.EvmKeeper,
 			StakingKeeper:         *app.StakingKeeper,
+			DistrKeeper:           app.DistrKeeper,
+			GovKeeper:             &app.GovKeeper,
+			TransferKeeper:        &app.TransferKeeper,
 			GlobalFeeKeeper:       app.GlobalFeeKeeper,
 			FeeMarketKeeper:       app.FeeMarketKeeper,
 			WasmConfig:            &wasmConfig,
@@ -1200,6 +1206,8 @@ func (app *WasmApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*
 
 // BeginBlocker application updates every begin block
 func (app *WasmApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	// pointers registered in the last block become callable
+	registry.RegisterPendingBankPointers(ctx, app.EvmKeeper, app.BankKeeper, app.WasmKeeper, app.WasmKeeper, app.WasmKeeper)
 	return app.ModuleManager.BeginBlock(ctx)
 }
 
@@ -0,0 +1,320 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	evmante "github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	ethermint "github.com/evmos/ethermint/types"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// WrapTxToTypedData wraps an amino JSON sign doc into EIP-712 typed data with the fee payer added to the fee.
+// Fields holding raw JSON messages are rendered as their compact JSON string with the keys sorted as in the sign doc,
+// so that wallets display the contract message and the schema does not depend on the message content.
+func WrapTxToTypedData(chainID uint64, signDoc []byte, feePayer sdk.AccAddress) (apitypes.TypedData, error) {
+	signDoc, err := stringifyEIP712JSONFields(signDoc)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	typedData, err := eip712.WrapTxToTypedData(chainID, signDoc)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	fee, ok := typedData.Message["fee"].(map[string]interface{})
+	if !ok {
+		return apitypes.TypedData{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "fee")
+	}
+	fee["feePayer"] = feePayer.String()
+	typedData.Types["Fee"] = append([]apitypes.Type{{Name: "feePayer", Type: "string"}}, typedData.Types["Fee"]...)
+	return typedData, nil
+}
+
+// StdSignBytes returns the amino JSON sign doc bytes like legacytx.StdSignBytes, with the messages
+// encoded by the given amino codec instead of the package global.
+func StdSignBytes(cdc *codec.LegacyAmino, chainID string, accnum, sequence, timeout uint64, fee legacytx.StdFee, msgs []sdk.Msg, memo string) ([]byte, error) {
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		bz, err = sdk.SortJSON(bz)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		msgsBytes = append(msgsBytes, bz)
+	}
+	bz, err := legacy.Cdc.MarshalJSON(legacytx.StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Fee:           json.RawMessage(fee.Bytes()),
+		Memo:          memo,
+		Msgs:          msgsBytes,
+		Sequence:      sequence,
+		TimeoutHeight: timeout,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return sdk.SortJSON(bz)
+}
+
+// amino JSON sign doc with the message values decoded for field access
+type eip712SignDoc struct {
+	Msgs []struct {
+		Type  string                     `json:"type"`
+		Value map[string]json.RawMessage `json:"value"`
+	} `json:"msgs"`
+}
+
+// hasEIP712JSONFields returns true when the sign doc contains a message type with raw JSON fields
+func hasEIP712JSONFields(signDoc []byte) (bool, error) {
+	var doc eip712SignDoc
+	if err := json.Unmarshal(signDoc, &doc); err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	for _, msg := range doc.Msgs {
+		if _, ok := eip712JSONFields[msg.Type]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// stringifyEIP712JSONFields replaces the raw JSON fields of the sign doc messages with their compact JSON string
+func stringifyEIP712JSONFields(signDoc []byte) ([]byte, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(signDoc, &doc); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	var msgs eip712SignDoc
+	if err := json.Unmarshal(signDoc, &msgs); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	for i, msg := range msgs.Msgs {
+		for _, field := range eip712JSONFields[msg.Type] {
+			raw, ok := msg.Value[field]
+			if !ok {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, raw); err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrJSONUnmarshal, "msg %d field %s: %s", i, field, err)
+			}
+			bz, err := json.Marshal(buf.String())
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+			}
+			msg.Value[field] = bz
+		}
+	}
+	bz, err := json.Marshal(msgs.Msgs)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	doc["msgs"] = bz
+	return json.Marshal(doc)
+}
+
+// newCosmosAnteHandlerEip712 returns the ante handler for cosmos txs with an ExtensionOptionsWeb3Tx extension.
+// It is ethermint's legacy EIP-712 ante handler with the signature verified by eip712SigVerificationDecorator.
+func newCosmosAnteHandlerEip712(options HandlerOptions) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		evmante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
+		// disable the Msg types that cannot be included on an authz.MsgExec msgs field
+		evmante.NewAuthzLimiterDecorator(options.DisabledAuthzMsgs),
+		ante.NewSetUpContextDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		evmante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		// SetPubKeyDecorator must be called before all signature verification decorators
+		evmante.NewSetPubKeyDecorator(options.AccountKeeper, options.EvmKeeper),
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		newEip712SigVerificationDecorator(options.AccountKeeper, options.EvmKeeper, options.Codec, options.LegacyAmino),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
+	)
+}
+
+// eip712SigVerificationDecorator verifies the single signature of a Web3Tx as EIP-712 typed data.
+// Txs with raw JSON message fields are verified against the typed data from WrapTxToTypedData,
+// all others against ethermint's legacy typed data. It is not run on ReCheckTx.
+type eip712SigVerificationDecorator struct {
+	ak        evmtypes.AccountKeeper
+	evmKeeper *evmkeeper.Keeper
+	cdc       codec.Codec
+	amino     *codec.LegacyAmino
+}
+
+func newEip712SigVerificationDecorator(ak evmtypes.AccountKeeper, evmKeeper *evmkeeper.Keeper, cdc codec.Codec, amino *codec.LegacyAmino) eip712SigVerificationDecorator {
+	return eip712SigVerificationDecorator{ak: ak, evmKeeper: evmKeeper, cdc: cdc, amino: amino}
+}
+
+func (d eip712SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "tx %T doesn't implement the authsigning.Tx interface", tx)
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrTooManySignatures, "EIP-712 txs have exactly one signer, got %d signatures for %d signers", len(sigs), len(signers))
+	}
+	sig := sigs[0]
+	if err := d.evmKeeper.ValidateSignerAnte(ctx, sig.PubKey, signers[0]); err != nil {
+		return ctx, err
+	}
+	acc, err := ante.GetSignerAcc(ctx, d.ak, signers[0])
+	if err != nil {
+		return ctx, err
+	}
+	pubKey := acc.GetPubKey()
+	if !simulate && pubKey == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence)
+	}
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signerData := authsigning.SignerData{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+	if err := verifyEip712Signature(d.cdc, d.amino, pubKey, signerData, sig.Data, sigTx); err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed; please verify account number (%d) and chain-id (%s): %s", accNum, signerData.ChainID, err)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// verifyEip712Signature verifies the fee payer signature of the Web3Tx extension over the tx typed data.
+// The messages of the sign doc are encoded with the given amino codec.
+func verifyEip712Signature(cdc codec.Codec, amino *codec.LegacyAmino, pubKey cryptotypes.PubKey, signerData authsigning.SignerData, sigData signing.SignatureData, tx authsigning.Tx) error {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrNoSignatures, "tx doesn't contain any msgs to verify signature")
+	}
+	signDoc, err := StdSignBytes(
+		amino,
+		signerData.ChainID,
+		signerData.AccountNumber,
+		signerData.Sequence,
+		tx.GetTimeoutHeight(),
+		legacytx.StdFee{Amount: tx.GetFee(), Gas: tx.GetGas()},
+		msgs,
+		tx.GetMemo(),
+	)
+	if err != nil {
+		return err
+	}
+	jsonFields, err := hasEIP712JSONFields(signDoc)
+	if err != nil {
+		return err
+	}
+
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "unexpected signature data %T", sigData)
+	}
+	if len(data.Signature) != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrTooManySignatures, "EIP-712 txs must have the cosmos signature empty")
+	}
+
+	chainID, err := ethermint.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return errorsmod.Wrapf(err, "chain-id: %s", signerData.ChainID)
+	}
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok || len(extTx.GetExtensionOptions()) != 1 {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownExtensionOptions, "expected one extension option")
+	}
+	extOpt, ok := extTx.GetExtensionOptions()[0].GetCachedValue().(*ethermint.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownExtensionOptions, "unknown extension option")
+	}
+	if extOpt.TypedDataChainID != chainID.Uint64() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidChainID, "typed data chain-id")
+	}
+	feePayer, err := sdk.AccAddressFromBech32(extOpt.FeePayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "fee payer")
+	}
+
+	var typedData apitypes.TypedData
+	if jsonFields {
+		typedData, err = WrapTxToTypedData(extOpt.TypedDataChainID, signDoc, feePayer)
+	} else {
+		typedData, err = eip712.LegacyWrapTxToTypedData(cdc, extOpt.TypedDataChainID, msgs[0], signDoc, &eip712.FeeDelegationOptions{FeePayer: feePayer})
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "EIP-712 typed data")
+	}
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	if len(extOpt.FeePayerSig) != ethcrypto.SignatureLength {
+		return errorsmod.Wrap(sdkerrors.ErrorInvalidSigner, "signature must be 65 bytes [R||S||V]")
+	}
+	feePayerSig := bytes.Clone(extOpt.FeePayerSig)
+	// wallets like MetaMask add 27 to the recovery id
+	if v := feePayerSig[ethcrypto.RecoveryIDOffset]; v == 27 || v == 28 {
+		feePayerSig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+	recovered, err := ethcrypto.SigToPub(sigHash, feePayerSig)
+	if err != nil {
+		return errorsmod.Wrap(err, "recover fee payer pubkey")
+	}
+	compressed := ethcrypto.CompressPubkey(recovered)
+	if !pubKey.Equals(&ethsecp256k1.PubKey{Key: compressed}) && !pubKey.Equals(&cosmossecp256k1.PubKey{Key: compressed}) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "fee payer signature does not match the account pubkey")
+	}
+	if signer := sdk.AccAddress(pubKey.Address()); !signer.Equals(feePayer) {
+		return errorsmod.Wrapf(sdkerrors.ErrorInvalidSigner, "fee payer %s does not match signer %s", feePayer, signer)
+	}
+	if !secp256k1.VerifySignature(pubKey.Bytes(), sigHash, feePayerSig[:ethcrypto.RecoveryIDOffset]) {
+		return errorsmod.Wrap(sdkerrors.ErrorInvalidSigner, "invalid EIP-712 signature")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	ethermint "github.com/evmos/ethermint/types"
+
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestWrapTxToTypedData(t *testing.T) {
+	amino := Setup(t).LegacyAmino()
+	sender := sdk.AccAddress(make([]byte, 20))
+	contract := keeper.BuildContractAddressClassic(1, 1)
+	msg := &types.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Msg:      []byte(`{"release": {"to": "foo", "amount": 1}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("orai", 1)),
+	}
+	fee := legacytx.StdFee{Amount: sdk.NewCoins(sdk.NewInt64Coin("orai", 2)), Gas: 200_000}
+	signDoc, err := StdSignBytes(amino, SimAppChainID, 1, 2, 0, fee, []sdk.Msg{msg}, "memo")
+	require.NoError(t, err)
+
+	typedData, err := WrapTxToTypedData(9000, signDoc, sender)
+	require.NoError(t, err)
+
+	msg0 := typedData.Message["msg0"].(map[string]interface{})
+	assert.Equal(t, "wasm/MsgExecuteContract", msg0["type"])
+	value := msg0["value"].(map[string]interface{})
+	assert.Equal(t, `{"release":{"amount":1,"to":"foo"}}`, value["msg"])
+	assert.Equal(t, contract.String(), value["contract"])
+	assert.Contains(t, typedData.Types["TypeValue0"], apitypes.Type{Name: "msg", Type: "string"})
+	assert.Equal(t, sender.String(), typedData.Message["fee"].(map[string]interface{})["feePayer"])
+	assert.Equal(t, apitypes.Type{Name: "feePayer", Type: "string"}, typedData.Types["Fee"][0])
+
+	// the schema does not depend on the contract message
+	msg.Msg = []byte(`{"other":[1,2,3]}`)
+	signDoc, err = StdSignBytes(amino, SimAppChainID, 1, 2, 0, fee, []sdk.Msg{msg}, "memo")
+	require.NoError(t, err)
+	other, err := WrapTxToTypedData(9000, signDoc, sender)
+	require.NoError(t, err)
+	assert.Equal(t, typedData.Types, other.Types)
+
+	_, _, err = apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+}
+
+func TestEip712AnteHandlerWasmMsgs(t *testing.T) {
+	wasmApp := Setup(t)
+	ctx := wasmApp.NewContextLegacy(false, cmtproto.Header{ChainID: SimAppChainID, Height: 2})
+	evmParams := wasmApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, wasmApp.EvmKeeper.SetParams(ctx, evmParams))
+	ethChainID, err := ethermint.ParseChainID(SimAppChainID)
+	require.NoError(t, err)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	acc := wasmApp.AccountKeeper.NewAccountWithAddress(ctx, signer)
+	wasmApp.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, banktestutil.FundAccount(ctx, wasmApp.BankKeeper, signer, sdk.NewCoins(sdk.NewCoin(appconfig.EvmDenom, sdkmath.NewIntWithDecimal(1, 18)))))
+	other, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	execMsg := func(msg string) sdk.Msg {
+		return &types.MsgExecuteContract{
+			Sender:   signer.String(),
+			Contract: keeper.BuildContractAddressClassic(1, 1).String(),
+			Msg:      []byte(msg),
+		}
+	}
+
+	sendMsg := banktypes.NewMsgSend(signer, sdk.AccAddress(other.PubKey().Address()), sdk.NewCoins(sdk.NewInt64Coin(appconfig.EvmDenom, 1)))
+
+	specs := map[string]struct {
+		signedMsg sdk.Msg
+		txMsg     sdk.Msg
+		legacy    bool
+		feePayer  sdk.AccAddress
+		signKey   *ethsecp256k1.PrivKey
+		expErr    bool
+	}{
+		"signed contract msg": {
+			signedMsg: execMsg(`{"release":{}}`),
+			feePayer:  signer,
+			signKey:   privKey,
+		},
+		"different contract msg signed": {
+			signedMsg: execMsg(`{"burn":{}}`),
+			feePayer:  signer,
+			signKey:   privKey,
+			expErr:    true,
+		},
+		"other fee payer": {
+			signedMsg: execMsg(`{"release":{}}`),
+			feePayer:  sdk.AccAddress(other.PubKey().Address()),
+			signKey:   privKey,
+			expErr:    true,
+		},
+		"other key": {
+			signedMsg: execMsg(`{"release":{}}`),
+			feePayer:  signer,
+			signKey:   other,
+			expErr:    true,
+		},
+		"signed bank msg as legacy typed data": {
+			signedMsg: sendMsg,
+			txMsg:     sendMsg,
+			legacy:    true,
+			feePayer:  signer,
+			signKey:   privKey,
+		},
+		"other key on legacy typed data": {
+			signedMsg: sendMsg,
+			txMsg:     sendMsg,
+			legacy:    true,
+			feePayer:  signer,
+			signKey:   other,
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			acc := wasmApp.AccountKeeper.GetAccount(cacheCtx, signer)
+			fee := legacytx.StdFee{Amount: sdk.NewCoins(sdk.NewCoin(appconfig.EvmDenom, sdkmath.NewIntWithDecimal(2, 14))), Gas: 200_000}
+			signDoc, err := StdSignBytes(wasmApp.LegacyAmino(), SimAppChainID, acc.GetAccountNumber(), acc.GetSequence(), 0, fee, []sdk.Msg{spec.signedMsg}, "")
+			require.NoError(t, err)
+			var typedData apitypes.TypedData
+			if spec.legacy {
+				typedData, err = eip712.LegacyWrapTxToTypedData(wasmApp.AppCodec(), ethChainID.Uint64(), spec.signedMsg, signDoc, &eip712.FeeDelegationOptions{FeePayer: spec.feePayer})
+			} else {
+				typedData, err = WrapTxToTypedData(ethChainID.Uint64(), signDoc, spec.feePayer)
+			}
+			require.NoError(t, err)
+			_, rawData, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+			sig, err := spec.signKey.Sign([]byte(rawData))
+			require.NoError(t, err)
+			sig[64] += 27 // as MetaMask does
+
+			txBuilder := wasmApp.TxConfig().NewTxBuilder()
+			txMsg := spec.txMsg
+			if txMsg == nil {
+				txMsg = execMsg(`{"release":{}}`)
+			}
+			require.NoError(t, txBuilder.SetMsgs(txMsg))
+			txBuilder.SetGasLimit(fee.Gas)
+			txBuilder.SetFeeAmount(fee.Amount)
+			ext, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionsWeb3Tx{
+				TypedDataChainID: ethChainID.Uint64(),
+				FeePayer:         spec.feePayer.String(),
+				FeePayerSig:      sig,
+			})
+			require.NoError(t, err)
+			txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(ext)
+			require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   privKey.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+				Sequence: acc.GetSequence(),
+			}))
+
+			_, err = wasmApp.AnteHandler()(cacheCtx, txBuilder.GetTx(), false)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, acc.GetSequence()+1, wasmApp.AccountKeeper.GetAccount(cacheCtx, signer).GetSequence())
+		})
+	}
+}
@@ -6,13 +6,26 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/log"
+	simappparams "cosmossdk.io/simapp/params"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/evmos/ethermint/ethereum/eip712"
 
 	"github.com/CosmWasm/wasmd/app/params"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 )
 
+// eip712JSONFields lists the fields holding raw JSON messages by amino message type. Their content
+// has no fixed schema, so they are rendered as JSON strings in EIP-712 typed data.
+var eip712JSONFields = map[string][]string{
+	"wasm/MsgExecuteContract":             {"msg"},
+	"wasm/MsgInstantiateContract":         {"msg"},
+	"wasm/MsgInstantiateContract2":        {"msg"},
+	"wasm/MsgMigrateContract":             {"msg"},
+	"wasm/MsgStoreAndInstantiateContract": {"msg"},
+	"wasm/MsgStoreAndMigrateContract":     {"msg"},
+}
+
 // MakeEncodingConfig creates a new EncodingConfig with all modules registered. For testing only
 func MakeEncodingConfig(t testing.TB) params.EncodingConfig {
 	t.Helper()
@@ -31,3 +44,8 @@ func makeEncodingConfig(tempApp *WasmApp) params.EncodingConfig {
 	}
 	return encodingConfig
 }
+
+// registerEIP712EncodingConfig sets the codecs ethermint decodes EIP-712 sign docs with.
+func registerEIP712EncodingConfig(encodingConfig simappparams.EncodingConfig) {
+	eip712.SetEncodingConfig(encodingConfig)
+}
@@ -157,7 +157,8 @@ func TestAppImportExport(t *testing.T) {
 		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
 		slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
-		wasmtypes.StoreKey:     {wasmtypes.TXCounterPrefix},
+		// gasless usage and tx counters are per block or window and pending bank pointers per block, all not exported
+		wasmtypes.StoreKey: {wasmtypes.TXCounterPrefix, wasmtypes.GaslessContractUsagePrefix, wasmtypes.GaslessTxCounterPrefix, wasmtypes.PendingBankPointerPrefix},
 	}
 
 	storeKeys := app.GetStoreKeys()
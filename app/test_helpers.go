@@ -42,10 +42,14 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 )
 
@@ -150,7 +154,7 @@ func Setup(t *testing.T, opts ...wasmkeeper.Option) *WasmApp {
 
 	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, SimAppChainID, opts, balance)
 	// register precompile contracts
-	registry.InitializePrecompiles(app.ContractKeeper, app.WasmKeeper, app.EvmKeeper, app.BankKeeper, app.AccountKeeper)
+	registry.InitializePrecompiles(app.ContractKeeper, app.WasmKeeper, app.EvmKeeper, app.BankKeeper, app.WasmKeeper, app.TokenFactoryKeeper, tokenfactorykeeper.NewMsgServerImpl(app.TokenFactoryKeeper), stakingkeeper.NewMsgServerImpl(app.StakingKeeper), stakingkeeper.NewQuerier(app.StakingKeeper), distrkeeper.NewMsgServerImpl(app.DistrKeeper), distrkeeper.NewQuerier(app.DistrKeeper), app.AppCodec(), govkeeper.NewMsgServerImpl(&app.GovKeeper), govkeeper.NewQueryServer(&app.GovKeeper), app.TransferKeeper, app.TransferKeeper, app.AccountKeeper, app.WasmKeeper)
 
 	return app
 }
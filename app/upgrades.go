@@ -31,6 +31,7 @@ import (
 	"github.com/CosmWasm/wasmd/app/upgrades"
 	"github.com/CosmWasm/wasmd/app/upgrades/noop"
 	v050 "github.com/CosmWasm/wasmd/app/upgrades/v050"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
 	v2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -146,6 +147,8 @@ func setupLegacyKeyTables(k *paramskeeper.Keeper) {
 			// wasm
 		case wasmtypes.ModuleName:
 			keyTable = v2.ParamKeyTable() //nolint:staticcheck
+		case tokenfactorytypes.ModuleName:
+			keyTable = tokenfactorytypes.ParamKeyTable() //nolint:staticcheck
 		case ibcexported.ModuleName:
 			keyTable = ibcclienttypes.ParamKeyTable()
 			keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/spf13/cobra"
+
+	"github.com/CosmWasm/wasmd/app"
+)
+
+// signEIP712Command signs a generated tx as EIP-712 typed data, the way Ethereum wallets sign Web3Tx txs
+func signEIP712Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-eip712 [file]",
+		Short: "Sign a transaction generated offline as EIP-712 typed data",
+		Long: `Sign a transaction created with the --generate-only flag as EIP-712 typed data, like Ethereum
+wallets do. The signature is added as an ExtensionOptionsWeb3Tx extension signed by the --from key,
+which must be an eth_secp256k1 key. Contract messages are signed as JSON strings.
+The signed transaction is printed and can be submitted with the broadcast command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory, err = txFactory.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+			unsignedTx := txBuilder.GetTx()
+			chainID, err := ethermint.ParseChainID(txFactory.ChainID())
+			if err != nil {
+				return err
+			}
+			key, err := txFactory.Keybase().Key(clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			pubKey, err := key.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			signDoc, err := app.StdSignBytes(
+				clientCtx.LegacyAmino,
+				txFactory.ChainID(),
+				txFactory.AccountNumber(),
+				txFactory.Sequence(),
+				unsignedTx.GetTimeoutHeight(),
+				legacytx.StdFee{Amount: unsignedTx.GetFee(), Gas: unsignedTx.GetGas()},
+				unsignedTx.GetMsgs(),
+				unsignedTx.GetMemo(),
+			)
+			if err != nil {
+				return err
+			}
+			typedData, err := app.WrapTxToTypedData(chainID.Uint64(), signDoc, clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+			_, rawData, err := apitypes.TypedDataAndHash(typedData)
+			if err != nil {
+				return err
+			}
+			// eth_secp256k1 keys sign the keccak256 hash of the data
+			sig, _, err := txFactory.Keybase().Sign(clientCtx.FromName, []byte(rawData), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			if err != nil {
+				return err
+			}
+
+			extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+			if !ok {
+				return fmt.Errorf("tx builder %T does not support extension options", txBuilder)
+			}
+			ext, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionsWeb3Tx{
+				TypedDataChainID: chainID.Uint64(),
+				FeePayer:         clientCtx.FromAddress.String(),
+				FeePayerSig:      sig,
+			})
+			if err != nil {
+				return err
+			}
+			extBuilder.SetExtensionOptions(ext)
+			// the cosmos signature stays empty, it is carried by the extension
+			err = txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+				Sequence: txFactory.Sequence(),
+			})
+			if err != nil {
+				return err
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
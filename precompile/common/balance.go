@@ -0,0 +1,53 @@
+package common
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// SyncBalances wraps fn to mirror the changes it makes to the EVM balances of the given accounts on the
+// StateDB. The EVM keeper writes the StateDB balances of the accounts an EVM transaction touched back into
+// the bank module when it commits, which would undo the transfers of the EVM denom a precompile makes
+// through the Cosmos modules. A debit fails when the StateDB balance does not cover it.
+func SyncBalances(
+	evmKeeper EVMKeeper,
+	stateDB contract.StateDB,
+	addrs []common.Address,
+	fn func(ctx sdk.Context) ([]byte, error),
+) func(ctx sdk.Context) ([]byte, error) {
+	return func(ctx sdk.Context) ([]byte, error) {
+		before := make(map[common.Address]*big.Int, len(addrs))
+		for _, addr := range addrs {
+			// the StateDB loads a balance when first read, so it is read before it changes in the context
+			stateDB.GetBalance(addr)
+			before[addr] = evmKeeper.GetBalance(ctx, addr)
+		}
+		ret, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			balance, ok := before[addr]
+			if !ok {
+				continue
+			}
+			delete(before, addr)
+			delta := new(big.Int).Sub(evmKeeper.GetBalance(ctx, addr), balance)
+			switch delta.Sign() {
+			case 1:
+				stateDB.AddBalance(addr, delta)
+			case -1:
+				delta.Neg(delta)
+				if stateDB.GetBalance(addr).Cmp(delta) < 0 {
+					return nil, sdkerrors.ErrInsufficientFunds.Wrapf("%s is spent in the EVM", addr)
+				}
+				stateDB.SubBalance(addr, delta)
+			}
+		}
+		return ret, nil
+	}
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// NewLog returns the EVM log of the given event emitted by the precompile at addr.
+// The args are expected in the order of the event inputs. Indexed args are added as topics.
+func NewLog(ctx sdk.Context, addr common.Address, event abi.Event, args ...interface{}) (*ethtypes.Log, error) {
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf("event %s: expected %d arguments but got %d", event.Name, len(event.Inputs), len(args))
+	}
+	var indexed [][]interface{}
+	var nonIndexed []interface{}
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, []interface{}{args[i]})
+			continue
+		}
+		nonIndexed = append(nonIndexed, args[i])
+	}
+	topics := []common.Hash{event.ID}
+	if len(indexed) != 0 {
+		indexedTopics, err := abi.MakeTopics(indexed...)
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %w", event.Name, err)
+		}
+		for _, t := range indexedTopics {
+			topics = append(topics, t[0])
+		}
+	}
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %w", event.Name, err)
+	}
+	return &ethtypes.Log{
+		Address:     addr,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
+// EmitLog appends the EVM log of the given event to the StateDB. The log is discarded with the
+// other state changes when the call reverts.
+func EmitLog(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, event abi.Event, args ...interface{}) error {
+	log, err := NewLog(ctx, addr, event, args...)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(log)
+	return nil
+}
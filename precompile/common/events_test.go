@@ -0,0 +1,65 @@
+package common_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+)
+
+func TestNewLog(t *testing.T) {
+	const rawABI = `[{"anonymous":false,"inputs":[
+		{"indexed":true,"name":"from","type":"address"},
+		{"indexed":false,"name":"denom","type":"string"},
+		{"indexed":true,"name":"label","type":"string"},
+		{"indexed":false,"name":"amount","type":"uint256"}
+	],"name":"Foo","type":"event"}]`
+	parsed, err := abi.JSON(strings.NewReader(rawABI))
+	require.NoError(t, err)
+	event := parsed.Events["Foo"]
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(7)
+	addr := common.HexToAddress("0x9000000000000000000000000000000000000001")
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	specs := map[string]struct {
+		args   []interface{}
+		expErr bool
+	}{
+		"all args": {
+			args: []interface{}{from, "stake", "bar", big.NewInt(10)},
+		},
+		"missing args": {
+			args:   []interface{}{from, "stake", "bar"},
+			expErr: true,
+		},
+		"wrong type": {
+			args:   []interface{}{from, "stake", "bar", "10"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			log, err := pcommon.NewLog(ctx, addr, event, spec.args...)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, addr, log.Address)
+			assert.Equal(t, uint64(7), log.BlockNumber)
+			assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(from.Bytes()), crypto.Keccak256Hash([]byte("bar"))}, log.Topics)
+			data, err := event.Inputs.NonIndexed().Unpack(log.Data)
+			require.NoError(t, err)
+			assert.Equal(t, []interface{}{"stake", big.NewInt(10)}, data)
+		})
+	}
+}
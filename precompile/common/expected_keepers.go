@@ -2,14 +2,20 @@ package common
 
 import (
 	"context"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 type EVMKeeper interface {
 	GetCosmosAddressMapping(ctx sdk.Context, evmAddress common.Address) sdk.AccAddress
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	GetEvmAddressMapping(ctx sdk.Context, addr sdk.AccAddress) (*common.Address, error)
 	SetMappingEvmAddressInner(
 		ctx sdk.Context,
@@ -20,11 +26,17 @@ type EVMKeeper interface {
 
 type WasmdKeeper interface {
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error)
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error)
+	UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error
+	ClearContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
 }
 
 type WasmdViewKeeper interface {
 	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
 }
 
 type BankKeeper interface {
@@ -36,6 +48,32 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
+type BankAllowanceKeeper interface {
+	GetBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string) sdkmath.Int
+	SetBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string, amount sdkmath.Int) error
+	SpendBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string, amount sdkmath.Int) error
+}
+
+type BankPointerKeeper interface {
+	GetBankPointerDenom(ctx context.Context, pointer common.Address) (string, bool)
+	IterateBankPointers(ctx context.Context, cb func(denom string, pointer common.Address) bool)
+}
+
+type PendingBankPointerKeeper interface {
+	BankPointerKeeper
+	IteratePendingBankPointers(ctx context.Context, cb func(denom string, pointer common.Address) bool)
+	ClearPendingBankPointers(ctx context.Context)
+}
+
+type TokenFactoryKeeper interface {
+	GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+	GetDenomsFromCreator(ctx sdk.Context, creator string) []string
+}
+
+type PrecompileGasKeeper interface {
+	GetParams(ctx context.Context) wasmtypes.Params
+}
+
 type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetAccount(ctx context.Context, acc sdk.AccountI)
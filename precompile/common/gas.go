@@ -0,0 +1,206 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// revertSelector is the function selector of the solidity Error(string) revert reason
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// RunWithGasLimit executes fn on a snapshot of the Cosmos state of ctx. The gas meter of ctx is limited to
+// the supplied EVM gas by WithGasSchedule, which also charges the SDK gas consumed by fn, so the supplied
+// gas is returned untouched. Running out of gas is returned as an EVM revert. State changes made by fn are
+// only committed when it succeeds.
+func RunWithGasLimit(
+	ctx sdk.Context,
+	suppliedGas uint64,
+	fn func(ctx sdk.Context) ([]byte, error),
+) ([]byte, uint64, error) {
+	return runWithGasMeter(func() ([]byte, uint64, error) {
+		ret, err := RunInSnapshot(ctx, fn)
+		if err != nil {
+			return nil, 0, err
+		}
+		return ret, suppliedGas, nil
+	})
+}
+
+// unscheduledGasCost charges the SDK gas consumed by precompiles without a cost in the gas schedule
+var unscheduledGasCost = wasmtypes.PrecompileGasCost{SDKGasMultiplier: sdkmath.LegacyOneDec()}
+
+// gasScheduledPrecompile charges the calls of a precompile according to the precompile gas schedule
+// of the wasm module params
+type gasScheduledPrecompile struct {
+	address    common.Address
+	abi        abi.ABI
+	precompile contract.StatefulPrecompiledContract
+	keeper     PrecompileGasKeeper
+}
+
+// WithGasSchedule wraps the precompile at the given address to charge its calls according to the
+// precompile gas schedule. The base and per byte input costs of the called method are charged
+// upfront. The gas left is converted into the SDK gas limit of a gas meter that the precompile runs
+// on, and the SDK gas it consumes is charged times the SDK gas multiplier. Without a cost in the
+// schedule, the SDK gas consumed is charged one to one.
+func WithGasSchedule(address common.Address, contractABI abi.ABI, precompile contract.StatefulPrecompiledContract, keeper PrecompileGasKeeper) contract.StatefulPrecompiledContract {
+	return &gasScheduledPrecompile{
+		address:    address,
+		abi:        contractABI,
+		precompile: precompile,
+		keeper:     keeper,
+	}
+}
+
+func (p gasScheduledPrecompile) Run(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, remainingGas uint64, err error) {
+	ctx, err := GetPrecompileCtx(accessibleState)
+	if err != nil {
+		return nil, 0, err
+	}
+	var methodName string
+	if len(input) >= contract.SelectorLen {
+		if method, err := p.abi.MethodById(input[:contract.SelectorLen]); err == nil {
+			methodName = method.Name
+		}
+	}
+	cost := p.keeper.GetParams(ctx).PrecompileGas.GasCost(p.address, methodName)
+	if cost == nil {
+		cost = &unscheduledGasCost
+	}
+
+	fixedGas := saturatingAdd(cost.BaseGas, saturatingMul(cost.PerByteGas, uint64(len(input))))
+	if suppliedGas < fixedGas {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	availableGas := suppliedGas - fixedGas
+	sdkGasLimit := toSDKGas(cost.SDKGasMultiplier, availableGas)
+	gasMeter := storetypes.NewGasMeter(sdkGasLimit)
+	state := gasMeteredState{
+		stateDB:      gasMeteredStateDB{StateDB: accessibleState.GetStateDB(), ctx: ctx.WithGasMeter(gasMeter)},
+		delegateCall: IsDelegateCall(accessibleState),
+	}
+
+	ret, sdkGasLeft, err := runWithGasMeter(func() ([]byte, uint64, error) {
+		return p.precompile.Run(state, caller, addr, input, sdkGasLimit, readOnly, value)
+	})
+	// the precompile may charge SDK gas on the gas meter and deduct gas from the supplied gas
+	sdkGasUsed := gasMeter.GasConsumedToLimit()
+	if sdkGasLeft < sdkGasLimit {
+		sdkGasUsed = saturatingAdd(sdkGasUsed, sdkGasLimit-sdkGasLeft)
+	}
+	if sdkGasUsed >= sdkGasLimit {
+		return ret, 0, err
+	}
+	evmGas := toEVMGas(cost.SDKGasMultiplier, sdkGasUsed)
+	if evmGas > availableGas {
+		evmGas = availableGas
+	}
+	return ret, availableGas - evmGas, err
+}
+
+// runWithGasMeter returns running out of SDK gas in fn as an EVM revert
+func runWithGasMeter(fn func() ([]byte, uint64, error)) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		oog, ok := r.(storetypes.ErrorOutOfGas)
+		if !ok {
+			panic(r)
+		}
+		ret = RevertReason(fmt.Sprintf("out of gas in location: %s", oog.Descriptor))
+		remainingGas = 0
+		rerr = vm.ErrExecutionReverted
+	}()
+	return fn()
+}
+
+// gasMeteredState gives the precompile the context of the call with the gas meter of the precompile
+type gasMeteredState struct {
+	stateDB      gasMeteredStateDB
+	delegateCall bool
+}
+
+func (s gasMeteredState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+// IsDelegateCall returns true when the precompile was called with a delegatecall or callcode
+func (s gasMeteredState) IsDelegateCall() bool {
+	return s.delegateCall
+}
+
+type gasMeteredStateDB struct {
+	contract.StateDB
+	ctx sdk.Context
+}
+
+// Ctx returns the context of the precompile call
+func (s gasMeteredStateDB) Ctx() sdk.Context {
+	return s.ctx
+}
+
+// toSDKGas returns the SDK gas that can be bought with the given EVM gas, rounded down
+func toSDKGas(multiplier sdkmath.LegacyDec, evmGas uint64) uint64 {
+	return decToUint64(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(evmGas)).Quo(multiplier).TruncateInt())
+}
+
+// toEVMGas returns the EVM gas charged for the given SDK gas, rounded up
+func toEVMGas(multiplier sdkmath.LegacyDec, sdkGas uint64) uint64 {
+	return decToUint64(multiplier.MulInt(sdkmath.NewIntFromUint64(sdkGas)).Ceil().TruncateInt())
+}
+
+// decToUint64 returns i capped at max uint64
+func decToUint64(i sdkmath.Int) uint64 {
+	if !i.IsUint64() {
+		return math.MaxUint64
+	}
+	return i.Uint64()
+}
+
+// saturatingMul returns x*y capped at max uint64
+func saturatingMul(x, y uint64) uint64 {
+	if x != 0 && y > math.MaxUint64/x {
+		return math.MaxUint64
+	}
+	return x * y
+}
+
+// saturatingAdd returns x+y capped at max uint64
+func saturatingAdd(x, y uint64) uint64 {
+	if x > math.MaxUint64-y {
+		return math.MaxUint64
+	}
+	return x + y
+}
+
+// RevertReason returns the ABI encoded solidity Error(string) for the given reason
+func RevertReason(reason string) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	packed, err := (abi.Arguments{{Type: stringType}}).Pack(reason)
+	if err != nil {
+		return nil
+	}
+	return append(append([]byte{}, revertSelector...), packed...)
+}
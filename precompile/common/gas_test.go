@@ -0,0 +1,283 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRunWithGasLimit(t *testing.T) {
+	myErr := errors.New("testing")
+	specs := map[string]struct {
+		consume         uint64
+		fnErr           error
+		expErr          error
+		expRevertReason string
+	}{
+		"returns supplied gas": {
+			consume: 400,
+		},
+		"all gas consumed": {
+			consume: 1000,
+		},
+		"out of gas": {
+			consume:         1001,
+			expErr:          vm.ErrExecutionReverted,
+			expRevertReason: "out of gas in location: testing",
+		},
+		"error returned": {
+			consume: 100,
+			fnErr:   myErr,
+			expErr:  myErr,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// the gas meter is limited by the gas schedule
+			gasMeter := storetypes.NewGasMeter(1000)
+			ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+				WithGasMeter(gasMeter)
+
+			// when
+			ret, remaining, err := pcommon.RunWithGasLimit(ctx, 1000, func(ctx sdk.Context) ([]byte, error) {
+				ctx.GasMeter().ConsumeGas(spec.consume, "testing")
+				return []byte("result"), spec.fnErr
+			})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				assert.Equal(t, uint64(0), remaining)
+				if spec.expRevertReason != "" {
+					reason, err := abi.UnpackRevert(ret)
+					require.NoError(t, err)
+					assert.Equal(t, spec.expRevertReason, reason)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("result"), ret)
+			assert.Equal(t, uint64(1000), remaining)
+			assert.Equal(t, spec.consume, gasMeter.GasConsumed())
+		})
+	}
+}
+
+func TestWithGasSchedule(t *testing.T) {
+	const rawABI = `[
+		{"inputs":[],"name":"foo","outputs":[],"stateMutability":"view","type":"function"},
+		{"inputs":[],"name":"bar","outputs":[],"stateMutability":"view","type":"function"}
+	]`
+	parsed, err := abi.JSON(strings.NewReader(rawABI))
+	require.NoError(t, err)
+	addr := common.HexToAddress("0x9000000000000000000000000000000000000001")
+	fooInput := parsed.Methods["foo"].ID
+	barInput := append(parsed.Methods["bar"].ID, make([]byte, 6)...)
+	cost := func(baseGas, perByteGas uint64, multiplier string) wasmtypes.PrecompileGasCost {
+		return wasmtypes.PrecompileGasCost{BaseGas: baseGas, PerByteGas: perByteGas, SDKGasMultiplier: sdkmath.LegacyMustNewDecFromStr(multiplier)}
+	}
+	myErr := errors.New("testing")
+
+	specs := map[string]struct {
+		schedule       wasmtypes.PrecompileGasSchedule
+		input          []byte
+		suppliedGas    uint64
+		consume        uint64
+		consumeCtx     uint64
+		runErr         error
+		expSuppliedGas uint64
+		expRemaining   uint64
+		expErr         error
+		expNotCalled   bool
+	}{
+		"no schedule": {
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        400,
+			expSuppliedGas: 1000,
+			expRemaining:   600,
+		},
+		"default cost": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(100, 10, "1"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        400,
+			expSuppliedGas: 860,
+			expRemaining:   460,
+		},
+		"precompile cost": {
+			schedule: wasmtypes.PrecompileGasSchedule{
+				Default: ptr(cost(100, 10, "1")),
+				Methods: []wasmtypes.PrecompileMethodGasCost{{Address: addr.Hex(), Cost: cost(200, 0, "1")}},
+			},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        400,
+			expSuppliedGas: 800,
+			expRemaining:   400,
+		},
+		"method cost": {
+			schedule: wasmtypes.PrecompileGasSchedule{
+				Default: ptr(cost(100, 10, "1")),
+				Methods: []wasmtypes.PrecompileMethodGasCost{
+					{Address: addr.Hex(), Cost: cost(200, 0, "1")},
+					{Address: addr.Hex(), Method: "bar", Cost: cost(0, 5, "1")},
+				},
+			},
+			input:          barInput,
+			suppliedGas:    1000,
+			consume:        400,
+			expSuppliedGas: 950,
+			expRemaining:   550,
+		},
+		"other precompile cost": {
+			schedule: wasmtypes.PrecompileGasSchedule{
+				Methods: []wasmtypes.PrecompileMethodGasCost{{Address: "0x9000000000000000000000000000000000000002", Cost: cost(200, 0, "1")}},
+			},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        400,
+			expSuppliedGas: 1000,
+			expRemaining:   600,
+		},
+		"multiplied sdk gas": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(0, 0, "2.5"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        101,
+			expSuppliedGas: 400,
+			expRemaining:   747,
+		},
+		"fractional multiplier": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(0, 0, "0.5"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        1500,
+			expSuppliedGas: 2000,
+			expRemaining:   250,
+		},
+		"unknown selector charged default cost": {
+			schedule: wasmtypes.PrecompileGasSchedule{
+				Default: ptr(cost(100, 1, "1")),
+				Methods: []wasmtypes.PrecompileMethodGasCost{{Address: addr.Hex(), Method: "foo", Cost: cost(200, 0, "1")}},
+			},
+			input:          []byte{1, 2},
+			suppliedGas:    1000,
+			expSuppliedGas: 898,
+			expRemaining:   898,
+		},
+		"error returned": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(100, 0, "1"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        400,
+			runErr:         myErr,
+			expSuppliedGas: 900,
+			expRemaining:   500,
+			expErr:         myErr,
+		},
+		"sdk gas consumed on the context": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(100, 0, "2"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consumeCtx:     100,
+			expSuppliedGas: 450,
+			expRemaining:   700,
+		},
+		"sdk gas consumed on the context and deducted": {
+			input:          fooInput,
+			suppliedGas:    1000,
+			consume:        100,
+			consumeCtx:     200,
+			expSuppliedGas: 1000,
+			expRemaining:   700,
+		},
+		"out of sdk gas on the context": {
+			schedule:       wasmtypes.PrecompileGasSchedule{Default: ptr(cost(100, 0, "1"))},
+			input:          fooInput,
+			suppliedGas:    1000,
+			consumeCtx:     901,
+			expSuppliedGas: 900,
+			expErr:         vm.ErrExecutionReverted,
+		},
+		"out of gas for fixed cost": {
+			schedule:     wasmtypes.PrecompileGasSchedule{Default: ptr(cost(100, 10, "1"))},
+			input:        fooInput,
+			suppliedGas:  139,
+			expErr:       vm.ErrOutOfGas,
+			expNotCalled: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+			evm := &vm.EVM{StateDB: statedb.New(ctx, nil, statedb.NewEmptyTxConfig(common.Hash{}))}
+			var called bool
+			var gotSuppliedGas uint64
+			precompile := mockPrecompile(func(ctx sdk.Context, suppliedGas uint64) ([]byte, uint64, error) {
+				called, gotSuppliedGas = true, suppliedGas
+				ctx.GasMeter().ConsumeGas(spec.consumeCtx, "testing")
+				if spec.consume > suppliedGas {
+					return nil, 0, vm.ErrOutOfGas
+				}
+				return []byte("result"), suppliedGas - spec.consume, spec.runErr
+			})
+			keeper := mockPrecompileGasKeeper{params: wasmtypes.Params{PrecompileGas: spec.schedule}}
+
+			// when
+			ret, remaining, err := pcommon.WithGasSchedule(addr, parsed, precompile, keeper).
+				Run(evm, common.Address{}, addr, spec.input, spec.suppliedGas, true, nil)
+
+			// then
+			assert.Zero(t, ctx.GasMeter().GasConsumed(), "sdk gas is charged as evm gas only")
+			assert.Equal(t, !spec.expNotCalled, called)
+			assert.Equal(t, spec.expSuppliedGas, gotSuppliedGas)
+			assert.Equal(t, spec.expRemaining, remaining)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("result"), ret)
+		})
+	}
+}
+
+type mockPrecompile func(ctx sdk.Context, suppliedGas uint64) ([]byte, uint64, error)
+
+func (m mockPrecompile) Run(accessibleState contract.AccessibleState, _, _ common.Address, _ []byte, suppliedGas uint64, _ bool, _ *big.Int) ([]byte, uint64, error) {
+	ctx, err := pcommon.GetPrecompileCtx(accessibleState)
+	if err != nil {
+		return nil, 0, err
+	}
+	return m(ctx, suppliedGas)
+}
+
+type mockPrecompileGasKeeper struct {
+	params wasmtypes.Params
+}
+
+func (m mockPrecompileGasKeeper) GetParams(context.Context) wasmtypes.Params {
+	return m.params
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
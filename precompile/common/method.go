@@ -0,0 +1,68 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// MethodOptions describes how a precompile method can be called
+type MethodOptions struct {
+	// Mutating methods change state and cannot be called from a staticcall
+	Mutating bool
+	// Payable methods accept a value to be sent along
+	Payable bool
+	// RejectDelegateCall methods act on behalf of the caller and cannot be called with a delegatecall,
+	// which would let a contract act on behalf of the account calling it
+	RejectDelegateCall bool
+}
+
+// MethodFunc is the method specific logic of a precompile method. It gets the Cosmos context of the call
+// and the unpacked method arguments.
+type MethodFunc func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error)
+
+// RunMethod runs the shared prelude of a precompile method before fn. It gets the Cosmos context, checks the
+// call against the method options and unpacks the input. A panic in fn is returned as error.
+func RunMethod(
+	accessibleState contract.AccessibleState,
+	method abi.Method,
+	opts MethodOptions,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+	fn MethodFunc,
+) (ret []byte, remainingGas uint64, rerr error) {
+	ctx, err := GetPrecompileCtx(accessibleState)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error calling precompile method", "method", method.Name, "error", rerr.Error())
+		}
+	}()
+	if opts.Mutating && readOnly {
+		return nil, 0, fmt.Errorf("cannot call %s from staticcall", method.Name)
+	}
+	if opts.RejectDelegateCall && IsDelegateCall(accessibleState) {
+		return nil, 0, fmt.Errorf("cannot delegatecall %s", method.Name)
+	}
+	if !opts.Payable {
+		if err := ValidateNonPayable(value); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		return nil, 0, err
+	}
+	return fn(ctx, args)
+}
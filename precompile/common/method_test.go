@@ -0,0 +1,119 @@
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+)
+
+type mockAccessibleState struct {
+	stateDB      contract.StateDB
+	delegateCall bool
+}
+
+func (m mockAccessibleState) GetStateDB() contract.StateDB {
+	return m.stateDB
+}
+
+func (m mockAccessibleState) IsDelegateCall() bool {
+	return m.delegateCall
+}
+
+func TestRunMethod(t *testing.T) {
+	uintType, err := abi.NewType("uint64", "", nil)
+	require.NoError(t, err)
+	method := abi.NewMethod("foo", "foo", abi.Function, "", false, false, abi.Arguments{{Name: "x", Type: uintType}}, nil)
+	input, err := method.Inputs.Pack(uint64(7))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		opts         pcommon.MethodOptions
+		input        []byte
+		readOnly     bool
+		delegateCall bool
+		value        *big.Int
+		fn           pcommon.MethodFunc
+		expErr       string
+	}{
+		"success": {
+			opts:  pcommon.MethodOptions{Mutating: true, Payable: true},
+			input: input,
+			value: big.NewInt(1),
+		},
+		"mutating from staticcall": {
+			opts:     pcommon.MethodOptions{Mutating: true},
+			input:    input,
+			readOnly: true,
+			expErr:   "cannot call foo from staticcall",
+		},
+		"non mutating from staticcall": {
+			input:    input,
+			readOnly: true,
+		},
+		"delegatecall rejected": {
+			opts:         pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true},
+			input:        input,
+			delegateCall: true,
+			expErr:       "cannot delegatecall foo",
+		},
+		"delegatecall allowed": {
+			opts:         pcommon.MethodOptions{Mutating: true},
+			input:        input,
+			delegateCall: true,
+		},
+		"value sent to non payable": {
+			input:  input,
+			value:  big.NewInt(1),
+			expErr: "sending funds to a non-payable function",
+		},
+		"invalid input": {
+			input:  []byte{1},
+			expErr: "length insufficient",
+		},
+		"panic": {
+			input: input,
+			fn: func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+				panic("testing")
+			},
+			expErr: "testing",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey("test")
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			state := mockAccessibleState{stateDB: statedb.New(ctx, nil, statedb.NewEmptyTxConfig(common.Hash{})), delegateCall: spec.delegateCall}
+			fn := spec.fn
+			if fn == nil {
+				fn = func(_ sdk.Context, args []interface{}) ([]byte, uint64, error) {
+					return []byte("result"), args[0].(uint64), nil
+				}
+			}
+
+			// when
+			ret, gas, err := pcommon.RunMethod(state, method, spec.opts, spec.input, spec.readOnly, spec.value, fn)
+
+			// then
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				assert.Nil(t, ret)
+				assert.Zero(t, gas)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("result"), ret)
+			assert.Equal(t, uint64(7), gas)
+		})
+	}
+}
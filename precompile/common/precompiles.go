@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"math/big"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/precompile/contract"
-	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 func ValidateArgsLength(args []interface{}, length int) error {
@@ -27,10 +27,13 @@ func ValidateNonPayable(value *big.Int) error {
 	return nil
 }
 
+// GetPrecompileCtx returns the Cosmos context of the StateDB. With the StateDB of the EVM constructor, it
+// is the context of the current call frame. The context is marked as within the EVM, so that the contracts
+// called by precompiles cannot commit EVM calls of their own.
 func GetPrecompileCtx(accessibleState contract.AccessibleState) (sdk.Context, error) {
-	ctxer, ok := accessibleState.GetStateDB().(*statedb.StateDB)
+	ctxer, ok := accessibleState.GetStateDB().(interface{ Ctx() sdk.Context })
 	if !ok {
 		return sdk.UnwrapSDKContext(context.Background()), errors.New("cannot get context from EVM")
 	}
-	return ctxer.Ctx(), nil
+	return wasmtypes.WithinEVM(ctxer.Ctx()), nil
 }
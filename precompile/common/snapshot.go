@@ -0,0 +1,19 @@
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RunInSnapshot executes fn on a cache-multistore snapshot of the Cosmos state. The snapshot is
+// committed when the precompile call succeeds and discarded when it returns an error or panics,
+// so that a reverted precompile call leaves no partial Cosmos state changes behind. Reverts of
+// enclosing EVM call frames are handled by the StateDB of NewEVM.
+func RunInSnapshot(ctx sdk.Context, fn func(ctx sdk.Context) ([]byte, error)) ([]byte, error) {
+	cacheCtx, commit := ctx.CacheContext()
+	ret, err := fn(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+	commit()
+	return ret, nil
+}
@@ -0,0 +1,72 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+)
+
+func TestRunInSnapshot(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	specs := map[string]struct {
+		fn        func(ctx sdk.Context) ([]byte, error)
+		expCommit bool
+		expPanic  bool
+	}{
+		"success": {
+			fn: func(ctx sdk.Context) ([]byte, error) {
+				ctx.KVStore(key).Set([]byte("foo"), []byte("bar"))
+				ctx.EventManager().EmitEvent(sdk.NewEvent("testing"))
+				return []byte("result"), nil
+			},
+			expCommit: true,
+		},
+		"error": {
+			fn: func(ctx sdk.Context) ([]byte, error) {
+				ctx.KVStore(key).Set([]byte("foo"), []byte("bar"))
+				ctx.EventManager().EmitEvent(sdk.NewEvent("testing"))
+				return nil, errors.New("testing")
+			},
+		},
+		"panic": {
+			fn: func(ctx sdk.Context) ([]byte, error) {
+				ctx.KVStore(key).Set([]byte("foo"), []byte("bar"))
+				ctx.EventManager().EmitEvent(sdk.NewEvent("testing"))
+				panic("testing")
+			},
+			expPanic: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			if spec.expPanic {
+				require.Panics(t, func() {
+					_, _ = pcommon.RunInSnapshot(ctx, spec.fn)
+				})
+			} else {
+				ret, err := pcommon.RunInSnapshot(ctx, spec.fn)
+				if spec.expCommit {
+					require.NoError(t, err)
+					assert.Equal(t, []byte("result"), ret)
+				} else {
+					require.Error(t, err)
+				}
+			}
+			if spec.expCommit {
+				assert.Equal(t, []byte("bar"), ctx.KVStore(key).Get([]byte("foo")))
+				assert.Len(t, ctx.EventManager().Events(), 1)
+				return
+			}
+			assert.Nil(t, ctx.KVStore(key).Get([]byte("foo")))
+			assert.Empty(t, ctx.EventManager().Events())
+		})
+	}
+}
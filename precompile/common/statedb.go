@@ -0,0 +1,142 @@
+package common
+
+import (
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+)
+
+// ctxSnapshot is the branch of the Cosmos context made for an EVM snapshot revision
+type ctxSnapshot struct {
+	revision int
+	// parent is the context the branch was made from
+	parent sdk.Context
+	// write commits the branch into the parent. It is nil until a precompile uses the context.
+	write func()
+}
+
+// StateDB wraps the ethermint StateDB to give precompiles a Cosmos context that follows the EVM call
+// frames. Each snapshot taken by the EVM branches the context the first time a precompile uses it, and
+// reverting to the snapshot discards the branch together with the EVM state. The branches of frames
+// that were not reverted are written back with CommitCtx once the EVM call has returned.
+type StateDB struct {
+	*statedb.StateDB
+	ctx       sdk.Context
+	snapshots []ctxSnapshot
+}
+
+// NewStateDB constructor
+func NewStateDB(stateDB *statedb.StateDB) *StateDB {
+	return &StateDB{StateDB: stateDB, ctx: stateDB.Ctx()}
+}
+
+// Ctx returns the Cosmos context of the current call frame
+func (s *StateDB) Ctx() sdk.Context {
+	if n := len(s.snapshots); n != 0 && s.snapshots[n-1].write == nil {
+		s.snapshots[n-1].parent = s.ctx
+		s.ctx, s.snapshots[n-1].write = s.ctx.CacheContext()
+	}
+	return s.ctx
+}
+
+// Snapshot returns an identifier for the current revision of the EVM and Cosmos state
+func (s *StateDB) Snapshot() int {
+	revision := s.StateDB.Snapshot()
+	s.snapshots = append(s.snapshots, ctxSnapshot{revision: revision})
+	return revision
+}
+
+// RevertToSnapshot reverts all EVM and Cosmos state changes made since the given revision
+func (s *StateDB) RevertToSnapshot(revision int) {
+	s.StateDB.RevertToSnapshot(revision)
+	idx := sort.Search(len(s.snapshots), func(i int) bool {
+		return s.snapshots[i].revision >= revision
+	})
+	for i := idx; i < len(s.snapshots); i++ {
+		if s.snapshots[i].write != nil {
+			s.ctx = s.snapshots[i].parent
+			break
+		}
+	}
+	s.snapshots = s.snapshots[:idx]
+}
+
+// CommitCtx writes the Cosmos state changes of the call frames that were not reverted into the
+// context of the wrapped StateDB. The EVM state is committed by the EVM keeper with Commit.
+func (s *StateDB) CommitCtx() {
+	for i := len(s.snapshots) - 1; i >= 0; i-- {
+		if s.snapshots[i].write != nil {
+			s.snapshots[i].write()
+		}
+	}
+	s.snapshots = nil
+	s.ctx = s.StateDB.Ctx()
+}
+
+var _ evm.Constructor = NewEVM
+
+// EVM commits the Cosmos state changes of the precompile calls when a top level call returns. The
+// EVM keeper only starts calls and creates at the top level.
+type EVM struct {
+	evm.EVM
+	stateDB *StateDB
+}
+
+// NewEVM is the EVM constructor for the EVM keeper. It wraps the StateDB so that the Cosmos state
+// changes of precompile calls are reverted together with the EVM call frame they were made in, and
+// keeps track of the call frames so that precompiles can reject delegatecalls.
+func NewEVM(
+	blockCtx vm.BlockContext,
+	txCtx vm.TxContext,
+	stateDB vm.StateDB,
+	chainConfig *params.ChainConfig,
+	config vm.Config,
+	customPrecompiles evm.PrecompiledContracts,
+) evm.EVM {
+	e := &EVM{}
+	e.EVM = geth.NewEVM(blockCtx, txCtx, e.wrap(stateDB), chainConfig, withCallFrameTracer(config), customPrecompiles)
+	return e
+}
+
+// Reset resets the EVM with a new transaction context and StateDB
+func (e *EVM) Reset(txCtx vm.TxContext, stateDB vm.StateDB) {
+	e.EVM.Reset(txCtx, e.wrap(stateDB))
+}
+
+// Call executes the contract at addr and commits the Cosmos state changes of the precompile calls
+func (e *EVM) Call(caller vm.ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) ([]byte, uint64, error) {
+	ret, leftOverGas, err := e.EVM.Call(caller, addr, input, gas, value)
+	e.commit()
+	return ret, leftOverGas, err
+}
+
+// Create creates a contract and commits the Cosmos state changes of the precompile calls
+func (e *EVM) Create(caller vm.ContractRef, code []byte, gas uint64, value *big.Int) ([]byte, common.Address, uint64, error) {
+	ret, contractAddr, leftOverGas, err := e.EVM.Create(caller, code, gas, value)
+	e.commit()
+	return ret, contractAddr, leftOverGas, err
+}
+
+// wrap returns the StateDB that the EVM runs on
+func (e *EVM) wrap(stateDB vm.StateDB) vm.StateDB {
+	e.stateDB = nil
+	sdb, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return stateDB
+	}
+	e.stateDB = NewStateDB(sdb)
+	return e.stateDB
+}
+
+func (e *EVM) commit() {
+	if e.stateDB != nil {
+		e.stateDB.CommitCtx()
+	}
+}
@@ -0,0 +1,106 @@
+package common_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+)
+
+func TestStateDBSnapshots(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	write := func(stateDB *pcommon.StateDB, k string) {
+		ctx := stateDB.Ctx()
+		ctx.KVStore(key).Set([]byte(k), []byte("value"))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(k))
+	}
+	specs := map[string]struct {
+		run     func(stateDB *pcommon.StateDB)
+		expKeys []string
+	}{
+		"no snapshot": {
+			run: func(stateDB *pcommon.StateDB) {
+				write(stateDB, "a")
+			},
+			expKeys: []string{"a"},
+		},
+		"frames not reverted": {
+			run: func(stateDB *pcommon.StateDB) {
+				stateDB.Snapshot()
+				write(stateDB, "a")
+				stateDB.Snapshot()
+				write(stateDB, "b")
+			},
+			expKeys: []string{"a", "b"},
+		},
+		"inner frame reverted": {
+			run: func(stateDB *pcommon.StateDB) {
+				stateDB.Snapshot()
+				write(stateDB, "a")
+				inner := stateDB.Snapshot()
+				write(stateDB, "b")
+				stateDB.RevertToSnapshot(inner)
+				write(stateDB, "c")
+			},
+			expKeys: []string{"a", "c"},
+		},
+		"outer frame reverted after inner frame succeeded": {
+			run: func(stateDB *pcommon.StateDB) {
+				stateDB.Snapshot()
+				write(stateDB, "a")
+				outer := stateDB.Snapshot()
+				stateDB.Snapshot()
+				write(stateDB, "b")
+				stateDB.RevertToSnapshot(outer)
+			},
+			expKeys: []string{"a"},
+		},
+		"frame reverted without precompile calls": {
+			run: func(stateDB *pcommon.StateDB) {
+				stateDB.Snapshot()
+				write(stateDB, "a")
+				inner := stateDB.Snapshot()
+				stateDB.Snapshot()
+				stateDB.RevertToSnapshot(inner)
+				write(stateDB, "b")
+			},
+			expKeys: []string{"a", "b"},
+		},
+		"all frames reverted": {
+			run: func(stateDB *pcommon.StateDB) {
+				outer := stateDB.Snapshot()
+				write(stateDB, "a")
+				stateDB.Snapshot()
+				write(stateDB, "b")
+				stateDB.RevertToSnapshot(outer)
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			stateDB := pcommon.NewStateDB(statedb.New(ctx, nil, statedb.NewEmptyTxConfig(common.Hash{})))
+
+			// when
+			spec.run(stateDB)
+			stateDB.CommitCtx()
+
+			// then
+			var gotKeys []string
+			for _, k := range []string{"a", "b", "c"} {
+				if ctx.KVStore(key).Has([]byte(k)) {
+					gotKeys = append(gotKeys, k)
+				}
+			}
+			assert.Equal(t, spec.expKeys, gotKeys)
+			assert.Len(t, ctx.EventManager().Events(), len(spec.expKeys))
+			assert.Equal(t, ctx.MultiStore(), stateDB.Ctx().MultiStore())
+		})
+	}
+}
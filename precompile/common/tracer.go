@@ -0,0 +1,110 @@
+package common
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// callFrameTracer keeps track of the types of the EVM call frames so that precompiles can tell how they
+// were called. The EVM passes the precompile address for every type of call, so the caller and address
+// of a precompile call do not tell a delegatecall apart. The hooks are forwarded to the configured tracer
+// when tracing was enabled.
+type callFrameTracer struct {
+	tracer vm.EVMLogger
+	debug  bool
+	frames []vm.OpCode
+}
+
+var _ vm.EVMLogger = &callFrameTracer{}
+
+// withCallFrameTracer returns the EVM config with tracing enabled on a callFrameTracer
+func withCallFrameTracer(config vm.Config) vm.Config {
+	config.Tracer = &callFrameTracer{tracer: config.Tracer, debug: config.Debug}
+	config.Debug = true
+	return config
+}
+
+// isDelegateCall returns true when the current call frame runs the code of another address on behalf of
+// its caller
+func (t *callFrameTracer) isDelegateCall() bool {
+	if len(t.frames) == 0 {
+		return false
+	}
+	typ := t.frames[len(t.frames)-1]
+	return typ == vm.DELEGATECALL || typ == vm.CALLCODE
+}
+
+func (t *callFrameTracer) CaptureTxStart(gasLimit uint64) {
+	if t.debug {
+		t.tracer.CaptureTxStart(gasLimit)
+	}
+}
+
+func (t *callFrameTracer) CaptureTxEnd(restGas uint64) {
+	if t.debug {
+		t.tracer.CaptureTxEnd(restGas)
+	}
+}
+
+func (t *callFrameTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.frames = append(t.frames[:0], typ)
+	if t.debug {
+		t.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+func (t *callFrameTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	t.frames = t.frames[:0]
+	if t.debug {
+		t.tracer.CaptureEnd(output, gasUsed, d, err)
+	}
+}
+
+func (t *callFrameTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.frames = append(t.frames, typ)
+	if t.debug {
+		t.tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+func (t *callFrameTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) != 0 {
+		t.frames = t.frames[:len(t.frames)-1]
+	}
+	if t.debug {
+		t.tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
+func (t *callFrameTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if t.debug {
+		t.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+func (t *callFrameTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if t.debug {
+		t.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+// IsDelegateCall returns true when the precompile was called with a delegatecall or callcode. It is
+// false when the EVM does not keep track of its call frames.
+func IsDelegateCall(accessibleState contract.AccessibleState) bool {
+	switch s := accessibleState.(type) {
+	case interface{ IsDelegateCall() bool }:
+		return s.IsDelegateCall()
+	case *vm.EVM:
+		t, ok := s.Config.Tracer.(*callFrameTracer)
+		return ok && t.isDelegateCall()
+	}
+	return false
+}
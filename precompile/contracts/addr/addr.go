@@ -12,6 +12,7 @@ import (
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -33,6 +34,15 @@ const (
 	GetEvmAddressMethod    = "getEvmAddr"
 	AssociateMethod        = "associate"
 	AssociatePubKeyMethod  = "associatePubKey"
+	GetCosmosAddrsMethod   = "getCosmosAddrs"
+	GetEvmAddrsMethod      = "getEvmAddrs"
+	IsAssociatedMethod     = "isAssociated"
+	Bech32ConvertMethod    = "bech32Convert"
+
+	AssociatedEvent = "Associated"
+
+	// AddressLookupGas is the gas charged for each address of a batch lookup, on top of the store reads
+	AddressLookupGas uint64 = 2_000
 )
 
 type PrecompileExecutor struct {
@@ -66,6 +76,22 @@ func NewContract(evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContra
 			ABI.Methods[AssociatePubKeyMethod].ID,
 			executor.associatePublicKey,
 		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[GetCosmosAddrsMethod].ID,
+			executor.getCosmosAddrs,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[GetEvmAddrsMethod].ID,
+			executor.getEvmAddrs,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[IsAssociatedMethod].ID,
+			executor.isAssociated,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[Bech32ConvertMethod].ID,
+			executor.bech32Convert,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -122,7 +148,7 @@ func (p PrecompileExecutor) getCosmosAddr(accessibleState contract.AccessibleSta
 	cosmosAddress := p.evmKeeper.GetCosmosAddressMapping(ctx, evmAddress)
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -179,7 +205,259 @@ func (p PrecompileExecutor) getEvmAddr(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) getCosmosAddrs(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying getCosmosAddrs using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[GetCosmosAddrsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+	evmAddresses := args[0].([]common.Address)
+
+	remainingGas, rerr = deductLookupGas(suppliedGas, len(evmAddresses))
+	if rerr != nil {
+		return
+	}
+
+	cosmosAddresses := make([]string, len(evmAddresses))
+	for i, evmAddress := range evmAddresses {
+		cosmosAddresses[i] = p.evmKeeper.GetCosmosAddressMapping(ctx, evmAddress).String()
+	}
+
+	ret, rerr = method.Outputs.Pack(cosmosAddresses)
+	return
+}
+
+// getEvmAddrs returns the EVM address associated with each cosmos address, or the zero address
+// for cosmos addresses that are not associated, so that a single unknown address does not fail the batch.
+func (p PrecompileExecutor) getEvmAddrs(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying getEvmAddrs using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[GetEvmAddrsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+	bech32Addresses := args[0].([]string)
+
+	remainingGas, rerr = deductLookupGas(suppliedGas, len(bech32Addresses))
+	if rerr != nil {
+		return
+	}
+
+	evmAddresses := make([]common.Address, len(bech32Addresses))
+	for i, bech32Address := range bech32Addresses {
+		cosmosAddress, err := sdk.AccAddressFromBech32(bech32Address)
+		if err != nil {
+			rerr = fmt.Errorf("invalid cosmos address at index %d: %w", i, err)
+			return
+		}
+		if evmAddress, err := p.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress); err == nil {
+			evmAddresses[i] = *evmAddress
+		}
+	}
+
+	ret, rerr = method.Outputs.Pack(evmAddresses)
+	return
+}
+
+// isAssociated reports whether the address, either a bech32 cosmos address or a hex EVM address,
+// has been associated with its counterpart.
+func (p PrecompileExecutor) isAssociated(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying isAssociated using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[IsAssociatedMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+	address := args[0].(string)
+
+	var associated bool
+	if common.IsHexAddress(address) {
+		// the mapped cosmos address falls back to the EVM address bytes, so check the mapping both ways
+		evmAddress := common.HexToAddress(address)
+		cosmosAddress := p.evmKeeper.GetCosmosAddressMapping(ctx, evmAddress)
+		mapped, err := p.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+		associated = err == nil && *mapped == evmAddress
+	} else {
+		cosmosAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			rerr = err
+			return
+		}
+		_, err = p.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+		associated = err == nil
+	}
+
+	ret, rerr = method.Outputs.Pack(associated)
+	remainingGas = suppliedGas
+	return
+}
+
+// bech32Convert re-encodes a bech32 address with another human readable prefix, e.g. to derive the
+// address of an account on an IBC counterparty. The input is validated as a bech32 account address.
+func (p PrecompileExecutor) bech32Convert(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying bech32Convert using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[Bech32ConvertMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+	address := args[0].(string)
+	prefix := args[1].(string)
+
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		rerr = fmt.Errorf("invalid bech32 address %s: %w", address, err)
+		return
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		rerr = err
+		return
+	}
+	if prefix == "" {
+		rerr = errors.New("prefix cannot be empty")
+		return
+	}
+	converted, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(converted)
+	remainingGas = suppliedGas
 	return
 }
 
@@ -271,9 +549,13 @@ func (p PrecompileExecutor) associate(accessibleState contract.AccessibleState,
 		rerr = err
 		return
 	}
+	if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[AssociatedEvent], *evmAddress, cosmosAddress.String()); err != nil {
+		rerr = err
+		return
+	}
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -336,9 +618,13 @@ func (p PrecompileExecutor) associatePublicKey(accessibleState contract.Accessib
 		rerr = err
 		return
 	}
+	if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[AssociatedEvent], *evmAddress, cosmosAddress.String()); err != nil {
+		rerr = err
+		return
+	}
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -360,6 +646,11 @@ func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, caller common.Ad
 	return cosmosAddress, evmAddress, err
 }
 
+// deductLookupGas charges AddressLookupGas for each address of a batch before any lookup is done
+func deductLookupGas(suppliedGas uint64, batchSize int) (uint64, error) {
+	return contract.DeductGas(suppliedGas, uint64(batchSize)*AddressLookupGas)
+}
+
 func decodeHexString(hexString string) ([]byte, error) {
 	trimmed := strings.TrimPrefix(hexString, "0x")
 	if len(trimmed)%2 != 0 {
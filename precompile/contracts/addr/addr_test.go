@@ -11,6 +11,7 @@ import (
 	"github.com/CosmWasm/wasmd/precompile/contracts/addr"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/go-bip39"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -360,6 +361,13 @@ func TestAssociatePubKey(t *testing.T) {
 				mappedEvmAddress, err := tApp.EvmKeeper.GetEvmAddressMapping(ctx, targetCosmosAddress)
 				require.NoError(t, err)
 				require.Equal(t, &targetEvmAddress, mappedEvmAddress)
+				logs := tt.args.evm.StateDB.(*statedb.StateDB).Logs()
+				require.NotEmpty(t, logs)
+				event := addr.ABI.Events[addr.AssociatedEvent]
+				require.Equal(t, []common.Hash{event.ID, common.BytesToHash(targetEvmAddress.Bytes())}, logs[len(logs)-1].Topics)
+				data, err := event.Inputs.NonIndexed().Unpack(logs[len(logs)-1].Data)
+				require.NoError(t, err)
+				require.Equal(t, []interface{}{targetCosmosAddress.String()}, data)
 			}
 		})
 	}
@@ -526,3 +534,165 @@ func TestAssociate(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchLookups(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	associatedCosmosAddress, associatedEvmAddress := MockAddressPair()
+	unassociatedCosmosAddress, unassociatedEvmAddress := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, associatedCosmosAddress, associatedEvmAddress)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := addr.NewContract(tApp.EvmKeeper)
+	run := func(methodName string, gas uint64, args ...interface{}) ([]interface{}, uint64, error) {
+		method := addr.ABI.Methods[methodName]
+		inputs, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		ret, remainingGas, err := p.Run(&evm, associatedEvmAddress, associatedEvmAddress, append(method.ID, inputs...), gas, true, big.NewInt(0))
+		if err != nil {
+			return nil, remainingGas, err
+		}
+		out, err := method.Outputs.Unpack(ret)
+		require.NoError(t, err)
+		return out, remainingGas, nil
+	}
+
+	// when
+	out, _, err := run(addr.GetCosmosAddrsMethod, suppliedGas, []common.Address{associatedEvmAddress, unassociatedEvmAddress})
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []string{associatedCosmosAddress.String(), sdk.AccAddress(unassociatedEvmAddress.Bytes()).String()}, out[0])
+
+	// when
+	out, _, err = run(addr.GetEvmAddrsMethod, suppliedGas, []string{unassociatedCosmosAddress.String(), associatedCosmosAddress.String()})
+	// then unassociated addresses map to the zero address
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{{}, associatedEvmAddress}, out[0])
+
+	// when
+	_, _, err = run(addr.GetEvmAddrsMethod, suppliedGas, []string{associatedCosmosAddress.String(), "invalid"})
+	// then
+	require.ErrorContains(t, err, "invalid cosmos address at index 1")
+
+	// when
+	out, _, err = run(addr.GetEvmAddrsMethod, suppliedGas, []string{})
+	// then
+	require.NoError(t, err)
+	require.Empty(t, out[0])
+
+	// gas is proportional to the batch size
+	_, remainingOne, err := run(addr.GetCosmosAddrsMethod, suppliedGas, []common.Address{unassociatedEvmAddress})
+	require.NoError(t, err)
+	_, remainingThree, err := run(addr.GetCosmosAddrsMethod, suppliedGas, []common.Address{unassociatedEvmAddress, unassociatedEvmAddress, unassociatedEvmAddress})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, remainingOne-remainingThree, 2*addr.AddressLookupGas)
+
+	// when
+	_, _, err = run(addr.GetCosmosAddrsMethod, 2*addr.AddressLookupGas, []common.Address{unassociatedEvmAddress, unassociatedEvmAddress, unassociatedEvmAddress})
+	// then
+	require.ErrorContains(t, err, "out of gas")
+}
+
+func TestIsAssociated(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	associatedCosmosAddress, associatedEvmAddress := MockAddressPair()
+	unassociatedCosmosAddress, unassociatedEvmAddress := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, associatedCosmosAddress, associatedEvmAddress)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	method := addr.ABI.Methods[addr.IsAssociatedMethod]
+	p := addr.NewContract(tApp.EvmKeeper)
+
+	tests := []struct {
+		name       string
+		address    string
+		want       bool
+		wantErrMsg string
+	}{
+		{name: "associated cosmos address", address: associatedCosmosAddress.String(), want: true},
+		{name: "associated evm address", address: associatedEvmAddress.Hex(), want: true},
+		{name: "unassociated cosmos address", address: unassociatedCosmosAddress.String()},
+		{name: "unassociated evm address", address: unassociatedEvmAddress.Hex()},
+		{name: "cast of unassociated evm address", address: sdk.AccAddress(unassociatedEvmAddress.Bytes()).String()},
+		{name: "invalid address", address: "invalid", wantErrMsg: "decoding bech32 failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, err := method.Inputs.Pack(tt.address)
+			require.NoError(t, err)
+
+			// when
+			ret, _, err := p.Run(&evm, associatedEvmAddress, associatedEvmAddress, append(method.ID, inputs...), suppliedGas, true, big.NewInt(0))
+
+			// then
+			if tt.wantErrMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			out, err := method.Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out[0])
+		})
+	}
+}
+
+func TestBech32Convert(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	cosmosAddress, evmAddress := MockAddressPair()
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	method := addr.ABI.Methods[addr.Bech32ConvertMethod]
+	p := addr.NewContract(tApp.EvmKeeper)
+
+	cosmosHubAddress, err := bech32.ConvertAndEncode("cosmos", cosmosAddress)
+	require.NoError(t, err)
+	// change the last character of the checksum
+	invalidChecksum := cosmosHubAddress[:len(cosmosHubAddress)-1] + "q"
+	if invalidChecksum == cosmosHubAddress {
+		invalidChecksum = cosmosHubAddress[:len(cosmosHubAddress)-1] + "p"
+	}
+
+	tests := []struct {
+		name       string
+		address    string
+		prefix     string
+		want       string
+		wantErrMsg string
+	}{
+		{name: "to other prefix", address: cosmosAddress.String(), prefix: "cosmos", want: cosmosHubAddress},
+		{name: "from other prefix", address: cosmosHubAddress, prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(), want: cosmosAddress.String()},
+		{name: "invalid checksum", address: invalidChecksum, prefix: "cosmos", wantErrMsg: "invalid bech32 address"},
+		{name: "invalid address", address: "invalid", prefix: "cosmos", wantErrMsg: "invalid bech32 address"},
+		{name: "empty prefix", address: cosmosAddress.String(), prefix: "", wantErrMsg: "prefix cannot be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, err := method.Inputs.Pack(tt.address, tt.prefix)
+			require.NoError(t, err)
+
+			// when
+			ret, _, err := p.Run(&evm, evmAddress, evmAddress, append(method.ID, inputs...), suppliedGas, true, big.NewInt(0))
+
+			// then
+			if tt.wantErrMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			out, err := method.Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out[0])
+		})
+	}
+}
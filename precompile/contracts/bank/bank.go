@@ -13,7 +13,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/precompile/contract"
-	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 // Singleton StatefulPrecompiledContract.
@@ -26,13 +25,19 @@ var (
 )
 
 const (
-	SendMethod        = "send"
-	BalanceMethod     = "balance"
-	AllBalancesMethod = "allBalances"
-	NameMethod        = "name"
-	SymbolMethod      = "symbol"
-	DecimalsMethod    = "decimals"
-	SupplyMethod      = "supply"
+	SendMethod         = "send"
+	BalanceMethod      = "balance"
+	AllBalancesMethod  = "allBalances"
+	NameMethod         = "name"
+	SymbolMethod       = "symbol"
+	DecimalsMethod     = "decimals"
+	SupplyMethod       = "supply"
+	ApproveMethod      = "approve"
+	AllowanceMethod    = "allowance"
+	TransferFromMethod = "transferFrom"
+
+	TransferEvent = "Transfer"
+	ApprovalEvent = "Approval"
 )
 
 type CoinBalance struct {
@@ -41,8 +46,9 @@ type CoinBalance struct {
 }
 
 type PrecompileExecutor struct {
-	evmKeeper  pcommon.EVMKeeper
-	bankKeeper pcommon.BankKeeper
+	evmKeeper       pcommon.EVMKeeper
+	bankKeeper      pcommon.BankKeeper
+	allowanceKeeper pcommon.BankAllowanceKeeper
 }
 
 // NewContract returns a new wasmd stateful precompiled contract.
@@ -51,11 +57,12 @@ type PrecompileExecutor struct {
 //	The functions of this contract (once implemented), will be used to exercise and test the various aspects of
 //	the EVM such as gas usage, argument parsing, events, etc. The specific operations tested under this contract are
 //	still to be determined.
-func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, accountKeeper pcommon.AccountKeeper) contract.StatefulPrecompiledContract {
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, allowanceKeeper pcommon.BankAllowanceKeeper, accountKeeper pcommon.AccountKeeper) contract.StatefulPrecompiledContract {
 
 	executor := &PrecompileExecutor{
-		evmKeeper:  evmKeeper,
-		bankKeeper: bankKeeper,
+		evmKeeper:       evmKeeper,
+		bankKeeper:      bankKeeper,
+		allowanceKeeper: allowanceKeeper,
 	}
 
 	functions := []*contract.StatefulPrecompileFunction{
@@ -87,6 +94,18 @@ func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, acc
 			ABI.Methods[SupplyMethod].ID,
 			executor.supply,
 		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[ApproveMethod].ID,
+			executor.approve,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[AllowanceMethod].ID,
+			executor.allowance,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[TransferFromMethod].ID,
+			executor.transferFrom,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -132,6 +151,10 @@ func (p PrecompileExecutor) send(accessibleState contract.AccessibleState,
 		rerr = errors.New("cannot call send from staticcall")
 		return
 	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall send")
+		return
+	}
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		rerr = err
 		return
@@ -145,27 +168,246 @@ func (p PrecompileExecutor) send(accessibleState contract.AccessibleState,
 	receiverEvmAddr := args[0].(common.Address)
 
 	denom := args[1].(string)
-	if denom == "" {
-		rerr = errors.New("invalid denom")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
 		return
 	}
 
 	amount := args[2].(*big.Int)
-	if amount.Cmp(big.NewInt(0)) == 0 {
-		// short circuit
-		ret, rerr = method.Outputs.Pack(true)
-		return
-	}
 
 	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 	receiverCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, receiverEvmAddr)
-	if err := p.bankKeeper.SendCoins(ctx, senderCosmosAddr, receiverCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller, receiverEvmAddr}, func(ctx sdk.Context) ([]byte, error) {
+		if amount.Sign() != 0 {
+			if err := p.bankKeeper.SendCoins(ctx, senderCosmosAddr, receiverCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+				return nil, err
+			}
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[TransferEvent], caller, receiverEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) approve(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[ApproveMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
 		rerr = err
 		return
 	}
 
-	ret, rerr = method.Outputs.Pack(true)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	if readOnly {
+		rerr = errors.New("cannot call approve from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall approve")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	spenderEvmAddr := args[0].(common.Address)
+
+	denom := args[1].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
+		return
+	}
+
+	amount := args[2].(*big.Int)
+
+	ownerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, spenderEvmAddr)
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if err := p.allowanceKeeper.SetBankAllowance(ctx, ownerCosmosAddr, spenderCosmosAddr, denom, sdkmath.NewIntFromBigInt(amount)); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[ApprovalEvent], caller, spenderEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) allowance(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[AllowanceMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	ownerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[1].(common.Address))
+
+	denom := args[2].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
+		return
+	}
+
+	allowance := p.allowanceKeeper.GetBankAllowance(ctx, ownerCosmosAddr, spenderCosmosAddr, denom)
+
+	ret, rerr = method.Outputs.Pack(allowance.BigInt())
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) transferFrom(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[TransferFromMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call transferFrom from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall transferFrom")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 4); err != nil {
+		rerr = err
+		return
+	}
+
+	fromEvmAddr := args[0].(common.Address)
+	toEvmAddr := args[1].(common.Address)
+
+	denom := args[2].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
+		return
+	}
+
+	amount := args[3].(*big.Int)
+
+	spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	fromCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, fromEvmAddr)
+	toCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, toEvmAddr)
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{fromEvmAddr, toEvmAddr}, func(ctx sdk.Context) ([]byte, error) {
+		if amount.Sign() != 0 {
+			coin := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))
+			if err := p.allowanceKeeper.SpendBankAllowance(ctx, fromCosmosAddr, spenderCosmosAddr, denom, coin.Amount); err != nil {
+				return nil, err
+			}
+			if err := p.bankKeeper.SendCoins(ctx, fromCosmosAddr, toCosmosAddr, sdk.NewCoins(coin)); err != nil {
+				return nil, err
+			}
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[TransferEvent], fromEvmAddr, toEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
 	return
 }
 
@@ -213,15 +455,15 @@ func (p PrecompileExecutor) balance(accessibleState contract.AccessibleState,
 	cosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, evmAddr)
 
 	denom := args[1].(string)
-	if denom == "" {
-		rerr = errors.New("invalid denom")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
 		return
 	}
 
 	balance := p.bankKeeper.GetBalance(ctx, cosmosAddr, denom)
 
 	ret, rerr = method.Outputs.Pack(balance.Amount.BigInt())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -280,7 +522,7 @@ func (p PrecompileExecutor) allBalances(accessibleState contract.AccessibleState
 	}
 
 	ret, rerr = method.Outputs.Pack(coinBalances)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -315,7 +557,7 @@ func (p PrecompileExecutor) name(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(metadata.Name)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -350,7 +592,7 @@ func (p PrecompileExecutor) symbol(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(metadata.Symbol)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -426,7 +668,7 @@ func (p PrecompileExecutor) supply(accessibleState contract.AccessibleState,
 	denom := args[0].(string)
 	coin := p.bankKeeper.GetSupply(ctx, denom)
 	ret, rerr = method.Outputs.Pack(coin.Amount.BigInt())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	remainingGas = suppliedGas
 	return
 }
 
@@ -448,11 +690,10 @@ func (p PrecompileExecutor) getMetadata(accessibleState contract.AccessibleState
 		return nil, err
 	}
 
-	ctxer, ok := accessibleState.GetStateDB().(*statedb.StateDB)
-	if !ok {
-		return nil, errors.New("cannot get context from EVM")
+	ctx, err := pcommon.GetPrecompileCtx(accessibleState)
+	if err != nil {
+		return nil, err
 	}
-	ctx := ctxer.Ctx()
 
 	denom := args[0].(string)
 	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
 	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
@@ -52,10 +54,22 @@ func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.
 	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
 }
 
+// delegateCallState is the state of a precompile called with a delegatecall
+type delegateCallState struct {
+	*vm.EVM
+}
+
+func (delegateCallState) IsDelegateCall() bool {
+	return true
+}
+
 func TestSend(t *testing.T) {
 	denom := "ukava"
 	tApp := app.Setup(t)
 	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
 	mockAddr, mockEVMAddr := MockAddressPair()
 	sdk.RegisterDenom(denom, sdkmath.LegacyNewDec(6))
 	receiveCosmosAddr, mockReceiverEVMAddr := MockAddressPair()
@@ -73,7 +87,7 @@ func TestSend(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, accountKeeper)
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, accountKeeper)
 	method := bank.ABI.Methods[bank.SendMethod]
 	suppliedGas := uint64(10_000_000)
 
@@ -93,6 +107,16 @@ func TestSend(t *testing.T) {
 
 	balance := bankKeeper.GetBalance(ctx, receiveCosmosAddr, denom)
 	require.Equal(t, balance.Amount, sdkmath.NewInt(10))
+
+	// and the transfer is logged
+	logs := evm.StateDB.(*statedb.StateDB).Logs()
+	require.Len(t, logs, 1)
+	event := bank.ABI.Events[bank.TransferEvent]
+	assert.Equal(t, registry.AddrContractAddress, logs[0].Address)
+	assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(mockEVMAddr.Bytes()), common.BytesToHash(mockReceiverEVMAddr.Bytes())}, logs[0].Topics)
+	data, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{denom, big.NewInt(10)}, data)
 }
 
 func TestBalance(t *testing.T) {
@@ -114,7 +138,7 @@ func TestBalance(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, accountKeeper)
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, accountKeeper)
 	method := bank.ABI.Methods[bank.BalanceMethod]
 	suppliedGas := uint64(10_000_000)
 
@@ -148,7 +172,7 @@ func TestSupply(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, accountKeeper)
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, accountKeeper)
 	method := bank.ABI.Methods[bank.SupplyMethod]
 	suppliedGas := uint64(10_000_000)
 
@@ -166,3 +190,190 @@ func TestSupply(t *testing.T) {
 	require.Equal(t, 1, len(output))
 	require.Equal(t, output[0].(*big.Int), big.NewInt(mintCoins[0].Amount.Int64()))
 }
+
+func TestApproveAndTransferFrom(t *testing.T) {
+	denom := "ukava"
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	spenderAddr, spenderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, spenderAddr, spenderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, ownerAddr, coins))
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, tApp.GetAccountKeeper())
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := bank.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.BankContractAddress, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+
+	// when approved
+	output, err := run(ownerEVMAddr, bank.ApproveMethod, false, spenderEVMAddr, denom, big.NewInt(60))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true}, output)
+
+	// then
+	output, err = run(receiverEVMAddr, bank.AllowanceMethod, true, ownerEVMAddr, spenderEVMAddr, denom)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(60)}, output)
+	logs := evm.StateDB.(*statedb.StateDB).Logs()
+	require.Len(t, logs, 1)
+	event := bank.ABI.Events[bank.ApprovalEvent]
+	assert.Equal(t, registry.BankContractAddress, logs[0].Address)
+	assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(ownerEVMAddr.Bytes()), common.BytesToHash(spenderEVMAddr.Bytes())}, logs[0].Topics)
+	data, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{denom, big.NewInt(60)}, data)
+
+	// when spent by the spender
+	output, err = run(spenderEVMAddr, bank.TransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(40))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true}, output)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(40), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(20), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+	logs = evm.StateDB.(*statedb.StateDB).Logs()
+	require.Len(t, logs, 2)
+	event = bank.ABI.Events[bank.TransferEvent]
+	assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(ownerEVMAddr.Bytes()), common.BytesToHash(receiverEVMAddr.Bytes())}, logs[1].Topics)
+	data, err = event.Inputs.NonIndexed().Unpack(logs[1].Data)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{denom, big.NewInt(40)}, data)
+
+	// when the allowance is exceeded
+	_, err = run(spenderEVMAddr, bank.TransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(21))
+	require.Error(t, err)
+	assert.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(20), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+
+	// when not approved
+	_, err = run(receiverEVMAddr, bank.TransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(1))
+	require.Error(t, err)
+
+	// when the balance is not sufficient the allowance is not spent
+	_, err = run(ownerEVMAddr, bank.ApproveMethod, false, spenderEVMAddr, denom, big.NewInt(1000))
+	require.NoError(t, err)
+	_, err = run(spenderEVMAddr, bank.TransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(61))
+	require.Error(t, err)
+	assert.Equal(t, sdkmath.NewInt(1000), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+
+	// when the denom is invalid
+	for _, invalidDenom := range []string{"", "1ukava", "u kava"} {
+		_, err = run(ownerEVMAddr, bank.ApproveMethod, false, spenderEVMAddr, invalidDenom, big.NewInt(1))
+		require.ErrorContains(t, err, "invalid denom")
+		_, err = run(receiverEVMAddr, bank.AllowanceMethod, true, ownerEVMAddr, spenderEVMAddr, invalidDenom)
+		require.ErrorContains(t, err, "invalid denom")
+		_, err = run(spenderEVMAddr, bank.TransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, invalidDenom, big.NewInt(1))
+		require.ErrorContains(t, err, "invalid denom")
+	}
+
+	// when called from staticcall
+	_, err = run(ownerEVMAddr, bank.ApproveMethod, true, spenderEVMAddr, denom, big.NewInt(1))
+	require.Error(t, err)
+	_, err = run(spenderEVMAddr, bank.TransferFromMethod, true, ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(1))
+	require.Error(t, err)
+
+	// when delegatecalled
+	for methodName, args := range map[string][]interface{}{
+		bank.ApproveMethod:      {spenderEVMAddr, denom, big.NewInt(1)},
+		bank.TransferFromMethod: {ownerEVMAddr, receiverEVMAddr, denom, big.NewInt(1)},
+	} {
+		method := bank.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		_, _, err = p.Run(delegateCallState{&evm}, spenderEVMAddr, registry.BankContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+		require.ErrorContains(t, err, "cannot delegatecall "+methodName)
+	}
+	assert.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(1000), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+}
+
+func TestTransferFromCommit(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	spenderAddr, spenderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, spenderAddr, spenderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, ownerAddr, coins))
+	require.NoError(t, tApp.WasmKeeper.SetBankAllowance(ctx, ownerAddr, spenderAddr, appconfig.CosmosDenom, sdkmath.NewInt(100)))
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, tApp.GetAccountKeeper())
+	method := bank.ABI.Methods[bank.TransferFromMethod]
+	suppliedGas := uint64(10_000_000)
+
+	specs := map[string]struct {
+		amount             int64
+		expOwnerBalance    int64
+		expReceiverBalance int64
+	}{
+		"transfer": {
+			amount:             40,
+			expOwnerBalance:    60,
+			expReceiverBalance: 40,
+		},
+		"zero amount": {
+			amount:             0,
+			expOwnerBalance:    100,
+			expReceiverBalance: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := vm.EVM{StateDB: stateDB}
+			// the EVM transaction touches both accounts so they are written back on commit
+			stateDB.SetNonce(ownerEVMAddr, stateDB.GetNonce(ownerEVMAddr)+1)
+			stateDB.SetNonce(receiverEVMAddr, stateDB.GetNonce(receiverEVMAddr)+1)
+			amount := big.NewInt(spec.amount)
+			input, err := method.Inputs.Pack(ownerEVMAddr, receiverEVMAddr, appconfig.CosmosDenom, amount)
+			require.NoError(t, err)
+
+			// when
+			_, remainingGas, err := p.Run(&evm, spenderEVMAddr, registry.BankContractAddress, append(method.ID, input...), suppliedGas, false, nil)
+			require.NoError(t, err)
+			require.NoError(t, stateDB.Commit())
+
+			// then the transfer is kept once the EVM state is committed
+			assert.Equal(t, suppliedGas, remainingGas)
+			assert.Equal(t, sdkmath.NewInt(spec.expOwnerBalance), bankKeeper.GetBalance(ctx, ownerAddr, appconfig.CosmosDenom).Amount)
+			assert.Equal(t, sdkmath.NewInt(spec.expReceiverBalance), bankKeeper.GetBalance(ctx, receiverAddr, appconfig.CosmosDenom).Amount)
+			logs := stateDB.Logs()
+			require.Len(t, logs, 1)
+			event := bank.ABI.Events[bank.TransferEvent]
+			assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(ownerEVMAddr.Bytes()), common.BytesToHash(receiverEVMAddr.Bytes())}, logs[0].Topics)
+			data, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+			require.NoError(t, err)
+			require.Len(t, data, 2)
+			assert.Equal(t, appconfig.CosmosDenom, data[0])
+			assert.Equal(t, amount.String(), data[1].(*big.Int).String())
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package bank
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawPointerABI contains the raw IERC20 ABI of the bank pointer contracts.
+	//go:embed pointer_abi.json
+	RawPointerABI string
+
+	PointerABI = contract.MustParseABI(RawPointerABI)
+)
+
+const (
+	PointerNameMethod         = "name"
+	PointerSymbolMethod       = "symbol"
+	PointerDecimalsMethod     = "decimals"
+	PointerTotalSupplyMethod  = "totalSupply"
+	PointerBalanceOfMethod    = "balanceOf"
+	PointerTransferMethod     = "transfer"
+	PointerApproveMethod      = "approve"
+	PointerAllowanceMethod    = "allowance"
+	PointerTransferFromMethod = "transferFrom"
+
+	PointerTransferEvent = "Transfer"
+	PointerApprovalEvent = "Approval"
+)
+
+type PointerExecutor struct {
+	evmKeeper       pcommon.EVMKeeper
+	bankKeeper      pcommon.BankKeeper
+	allowanceKeeper pcommon.BankAllowanceKeeper
+	pointerKeeper   pcommon.BankPointerKeeper
+}
+
+// NewPointerContract returns the ERC20 contract of bank denoms. The same contract is registered at the
+// pointer address of every denom and resolves the denom from the address it is called at. Allowances
+// are shared with the bank precompile.
+func NewPointerContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, allowanceKeeper pcommon.BankAllowanceKeeper, pointerKeeper pcommon.BankPointerKeeper) contract.StatefulPrecompiledContract {
+
+	executor := &PointerExecutor{
+		evmKeeper:       evmKeeper,
+		bankKeeper:      bankKeeper,
+		allowanceKeeper: allowanceKeeper,
+		pointerKeeper:   pointerKeeper,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerNameMethod].ID,
+			executor.name,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerSymbolMethod].ID,
+			executor.symbol,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerDecimalsMethod].ID,
+			executor.decimals,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerTotalSupplyMethod].ID,
+			executor.totalSupply,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerBalanceOfMethod].ID,
+			executor.balanceOf,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerTransferMethod].ID,
+			executor.transfer,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerApproveMethod].ID,
+			executor.approve,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerAllowanceMethod].ID,
+			executor.allowance,
+		),
+		contract.NewStatefulPrecompileFunction(
+			PointerABI.Methods[PointerTransferFromMethod].ID,
+			executor.transferFrom,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate bank pointer precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+// denom returns the bank denom of the pointer contract called
+func (p PointerExecutor) denom(ctx sdk.Context, callingContract common.Address) (string, error) {
+	denom, found := p.pointerKeeper.GetBankPointerDenom(ctx, callingContract)
+	if !found {
+		return "", fmt.Errorf("no bank pointer registered at %s", callingContract)
+	}
+	return denom, nil
+}
+
+func (p PointerExecutor) name(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerNameMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			name := denom
+			if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found && metadata.Name != "" {
+				name = metadata.Name
+			}
+			return method.Outputs.Pack(name)
+		})
+	})
+}
+
+func (p PointerExecutor) symbol(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerSymbolMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			symbol := denom
+			if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found && metadata.Symbol != "" {
+				symbol = metadata.Symbol
+			}
+			return method.Outputs.Pack(symbol)
+		})
+	})
+}
+
+func (p PointerExecutor) decimals(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerDecimalsMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			// amounts are in the base denom, scaled by the exponent of the display denom
+			var decimals uint8
+			if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found {
+				for _, unit := range metadata.DenomUnits {
+					if unit.Denom == metadata.Display && unit.Exponent <= 255 {
+						decimals = uint8(unit.Exponent)
+					}
+				}
+			}
+			return method.Outputs.Pack(decimals)
+		})
+	})
+}
+
+func (p PointerExecutor) totalSupply(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerTotalSupplyMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			coin := p.bankKeeper.GetSupply(ctx, denom)
+			return method.Outputs.Pack(coin.Amount.BigInt())
+		})
+	})
+}
+
+func (p PointerExecutor) balanceOf(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerBalanceOfMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			cosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+			balance := p.bankKeeper.GetBalance(ctx, cosmosAddr, denom)
+			return method.Outputs.Pack(balance.Amount.BigInt())
+		})
+	})
+}
+
+func (p PointerExecutor) transfer(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerTransferMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		toEvmAddr := args[0].(common.Address)
+		amount := args[1].(*big.Int)
+
+		senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+		receiverCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, toEvmAddr)
+		stateDB := accessibleState.GetStateDB()
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller, toEvmAddr}, func(ctx sdk.Context) ([]byte, error) {
+			// zero transfers are valid ERC20 transfers and are logged too
+			if amount.Sign() != 0 {
+				if err := p.bankKeeper.SendCoins(ctx, senderCosmosAddr, receiverCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+					return nil, err
+				}
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, callingContract, PointerABI.Events[PointerTransferEvent], caller, toEvmAddr, amount); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(true)
+		}))
+	})
+}
+
+func (p PointerExecutor) approve(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerApproveMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		spenderEvmAddr := args[0].(common.Address)
+		amount := args[1].(*big.Int)
+
+		ownerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+		spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, spenderEvmAddr)
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			if err := p.allowanceKeeper.SetBankAllowance(ctx, ownerCosmosAddr, spenderCosmosAddr, denom, sdkmath.NewIntFromBigInt(amount)); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, PointerABI.Events[PointerApprovalEvent], caller, spenderEvmAddr, amount); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(true)
+		})
+	})
+}
+
+func (p PointerExecutor) allowance(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerAllowanceMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			ownerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+			spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[1].(common.Address))
+			allowance := p.allowanceKeeper.GetBankAllowance(ctx, ownerCosmosAddr, spenderCosmosAddr, denom)
+			return method.Outputs.Pack(allowance.BigInt())
+		})
+	})
+}
+
+func (p PointerExecutor) transferFrom(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) ([]byte, uint64, error) {
+	method := PointerABI.Methods[PointerTransferFromMethod]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		denom, err := p.denom(ctx, callingContract)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		fromEvmAddr := args[0].(common.Address)
+		toEvmAddr := args[1].(common.Address)
+		amount := args[2].(*big.Int)
+
+		spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+		fromCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, fromEvmAddr)
+		toCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, toEvmAddr)
+		stateDB := accessibleState.GetStateDB()
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{fromEvmAddr, toEvmAddr}, func(ctx sdk.Context) ([]byte, error) {
+			if amount.Sign() != 0 {
+				coin := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))
+				if err := p.allowanceKeeper.SpendBankAllowance(ctx, fromCosmosAddr, spenderCosmosAddr, denom, coin.Amount); err != nil {
+					return nil, err
+				}
+				if err := p.bankKeeper.SendCoins(ctx, fromCosmosAddr, toCosmosAddr, sdk.NewCoins(coin)); err != nil {
+					return nil, err
+				}
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, callingContract, PointerABI.Events[PointerTransferEvent], fromEvmAddr, toEvmAddr, amount); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(true)
+		}))
+	})
+}
@@ -0,0 +1,157 @@
+package bank_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/modules"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestPointer(t *testing.T) {
+	denom := "factory/creator/token"
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	spenderAddr, spenderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, spenderAddr, spenderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, ownerAddr, coins))
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		Display:    "token",
+		Name:       "Token",
+		Symbol:     "TKN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "token", Exponent: 6}},
+	})
+
+	pointer, err := tApp.WasmKeeper.RegisterBankPointer(ctx, denom)
+	require.NoError(t, err)
+	registry.RegisterPendingBankPointers(ctx, tApp.EvmKeeper, bankKeeper, tApp.WasmKeeper, tApp.WasmKeeper, tApp.WasmKeeper)
+	module, found := modules.GetPrecompileModuleByAddress(pointer)
+	require.True(t, found)
+	tApp.WasmKeeper.IteratePendingBankPointers(ctx, func(denom string, _ common.Address) bool {
+		t.Fatalf("pending pointer of %s not cleared", denom)
+		return true
+	})
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := bank.PointerABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := module.Contract.Run(&evm, caller, pointer, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+
+	// metadata and balances
+	output, err := run(ownerEVMAddr, bank.PointerNameMethod, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"Token"}, output)
+	output, err = run(ownerEVMAddr, bank.PointerSymbolMethod, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"TKN"}, output)
+	output, err = run(ownerEVMAddr, bank.PointerDecimalsMethod, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{uint8(6)}, output)
+	output, err = run(ownerEVMAddr, bank.PointerTotalSupplyMethod, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(100)}, output)
+	output, err = run(receiverEVMAddr, bank.PointerBalanceOfMethod, true, ownerEVMAddr)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(100)}, output)
+
+	// when transferred
+	output, err = run(ownerEVMAddr, bank.PointerTransferMethod, false, receiverEVMAddr, big.NewInt(10))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true}, output)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(90), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+	logs := evm.StateDB.(*statedb.StateDB).Logs()
+	require.Len(t, logs, 1)
+	event := bank.PointerABI.Events[bank.PointerTransferEvent]
+	assert.Equal(t, pointer, logs[0].Address)
+	assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(ownerEVMAddr.Bytes()), common.BytesToHash(receiverEVMAddr.Bytes())}, logs[0].Topics)
+	assert.Equal(t, common.BigToHash(big.NewInt(10)).Bytes(), logs[0].Data)
+
+	// when approved
+	output, err = run(ownerEVMAddr, bank.PointerApproveMethod, false, spenderEVMAddr, big.NewInt(50))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true}, output)
+
+	// then the allowance is shared with the bank precompile
+	output, err = run(receiverEVMAddr, bank.PointerAllowanceMethod, true, ownerEVMAddr, spenderEVMAddr)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(50)}, output)
+	assert.Equal(t, sdkmath.NewInt(50), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+	logs = evm.StateDB.(*statedb.StateDB).Logs()
+	require.Len(t, logs, 2)
+	assert.Equal(t, bank.PointerABI.Events[bank.PointerApprovalEvent].ID, logs[1].Topics[0])
+
+	// when spent
+	output, err = run(spenderEVMAddr, bank.PointerTransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, big.NewInt(30))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true}, output)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(40), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(20), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+
+	// when the allowance is exceeded
+	_, err = run(spenderEVMAddr, bank.PointerTransferFromMethod, false, ownerEVMAddr, receiverEVMAddr, big.NewInt(21))
+	require.Error(t, err)
+
+	// when called from staticcall
+	_, err = run(ownerEVMAddr, bank.PointerTransferMethod, true, receiverEVMAddr, big.NewInt(1))
+	require.Error(t, err)
+
+	// when delegatecalled
+	for methodName, args := range map[string][]interface{}{
+		bank.PointerTransferMethod:     {receiverEVMAddr, big.NewInt(1)},
+		bank.PointerApproveMethod:      {spenderEVMAddr, big.NewInt(1)},
+		bank.PointerTransferFromMethod: {ownerEVMAddr, receiverEVMAddr, big.NewInt(1)},
+	} {
+		method := bank.PointerABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		_, _, err = module.Contract.Run(delegateCallState{&evm}, ownerEVMAddr, pointer, append(method.ID, input...), 10_000_000, false, nil)
+		require.ErrorContains(t, err, "cannot delegatecall "+methodName)
+	}
+	assert.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(20), tApp.WasmKeeper.GetBankAllowance(ctx, ownerAddr, spenderAddr, denom))
+
+	// when called at an address without a registered pointer
+	method := bank.PointerABI.Methods[bank.PointerTotalSupplyMethod]
+	_, _, err = module.Contract.Run(&evm, ownerEVMAddr, wasmtypes.BankPointerAddress("other"), method.ID, 10_000_000, true, nil)
+	require.Error(t, err)
+}
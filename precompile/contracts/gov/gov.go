@@ -0,0 +1,586 @@
+package gov
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of gov contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	VoteMethod           = "vote"
+	VoteWeightedMethod   = "voteWeighted"
+	DepositMethod        = "deposit"
+	SubmitProposalMethod = "submitProposal"
+	ProposalMethod       = "proposal"
+	TallyMethod          = "tally"
+
+	VoteEvent           = "Vote"
+	VoteWeightedEvent   = "VoteWeighted"
+	DepositEvent        = "Deposit"
+	SubmitProposalEvent = "SubmitProposal"
+)
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type WeightedVoteOption struct {
+	Option int32
+	// Weight is a decimal string, the weights of all options must add up to 1
+	Weight string
+}
+
+// Proposal times are unix timestamps in seconds, zero when not set
+type Proposal struct {
+	Id              uint64
+	Status          int32
+	SubmitTime      int64
+	DepositEndTime  int64
+	VotingStartTime int64
+	VotingEndTime   int64
+	Title           string
+	Summary         string
+	TotalDeposit    []Coin
+}
+
+type Tally struct {
+	Yes        *big.Int
+	Abstain    *big.Int
+	No         *big.Int
+	NoWithVeto *big.Int
+}
+
+type PrecompileExecutor struct {
+	evmKeeper pcommon.EVMKeeper
+	cdc       codec.JSONCodec
+	govMsg    govv1.MsgServer
+	govQuery  govv1.QueryServer
+}
+
+// NewContract returns a new gov stateful precompiled contract.
+//
+//	The mutating functions are executed through the gov msg server with the cosmos address mapped to
+//	the EVM caller as proposer, voter or depositor. The messages of submitted proposals are decoded from
+//	their JSON encoding with the codec.
+func NewContract(evmKeeper pcommon.EVMKeeper, cdc codec.JSONCodec, govMsg govv1.MsgServer, govQuery govv1.QueryServer) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper: evmKeeper,
+		cdc:       cdc,
+		govMsg:    govMsg,
+		govQuery:  govQuery,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[VoteMethod].ID,
+			executor.vote,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[VoteWeightedMethod].ID,
+			executor.voteWeighted,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[DepositMethod].ID,
+			executor.deposit,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[SubmitProposalMethod].ID,
+			executor.submitProposal,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[ProposalMethod].ID,
+			executor.proposal,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[TallyMethod].ID,
+			executor.tally,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate gov precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) vote(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[VoteMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call vote from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall vote")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	proposalID := args[0].(uint64)
+	option := args[1].(int32)
+
+	voterCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := govv1.NewMsgVote(voterCosmosAddr, proposalID, govv1.VoteOption(option), args[2].(string))
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.govMsg.Vote(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[VoteEvent], caller, proposalID, option); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) voteWeighted(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[VoteWeightedMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call voteWeighted from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall voteWeighted")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	proposalID := args[0].(uint64)
+	weightedOptions := *abi.ConvertType(args[1], new([]WeightedVoteOption)).(*[]WeightedVoteOption)
+	options := make(govv1.WeightedVoteOptions, 0, len(weightedOptions))
+	for _, o := range weightedOptions {
+		weight, err := sdkmath.LegacyNewDecFromStr(o.Weight)
+		if err != nil {
+			rerr = err
+			return
+		}
+		options = append(options, govv1.NewWeightedVoteOption(govv1.VoteOption(o.Option), weight))
+	}
+
+	voterCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := govv1.NewMsgVoteWeighted(voterCosmosAddr, proposalID, options, args[2].(string))
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.govMsg.VoteWeighted(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[VoteWeightedEvent], caller, proposalID); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) deposit(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[DepositMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call deposit from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall deposit")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	proposalID := args[0].(uint64)
+
+	depositorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := govv1.NewMsgDeposit(depositorCosmosAddr, proposalID, toSDKCoins(args[1]))
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.govMsg.Deposit(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[DepositEvent], caller, proposalID); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) submitProposal(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[SubmitProposalMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call submitProposal from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall submitProposal")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 6); err != nil {
+		rerr = err
+		return
+	}
+
+	// the messages are JSON encoded like in the proposal files of the gov CLI
+	encodedMsgs := args[0].([]string)
+	msgs := make([]sdk.Msg, 0, len(encodedMsgs))
+	for _, encodedMsg := range encodedMsgs {
+		var msg sdk.Msg
+		if err := p.cdc.UnmarshalInterfaceJSON([]byte(encodedMsg), &msg); err != nil {
+			rerr = err
+			return
+		}
+		msgs = append(msgs, msg)
+	}
+
+	proposerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg, err := govv1.NewMsgSubmitProposal(msgs, toSDKCoins(args[1]), proposerCosmosAddr.String(), args[2].(string), args[3].(string), args[4].(string), args[5].(bool))
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		resp, err := p.govMsg.SubmitProposal(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[SubmitProposalEvent], caller, resp.ProposalId); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(resp.ProposalId)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) proposal(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying proposal using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ProposalMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	resp, err := p.govQuery.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	proposal := resp.Proposal
+	totalDeposit := make([]Coin, 0, len(proposal.TotalDeposit))
+	for _, c := range proposal.TotalDeposit {
+		totalDeposit = append(totalDeposit, Coin{Amount: c.Amount.BigInt(), Denom: c.Denom})
+	}
+
+	ret, rerr = method.Outputs.Pack(Proposal{
+		Id:              proposal.Id,
+		Status:          int32(proposal.Status),
+		SubmitTime:      unixTime(proposal.SubmitTime),
+		DepositEndTime:  unixTime(proposal.DepositEndTime),
+		VotingStartTime: unixTime(proposal.VotingStartTime),
+		VotingEndTime:   unixTime(proposal.VotingEndTime),
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		TotalDeposit:    totalDeposit,
+	})
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) tally(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying tally using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TallyMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	// the tally of proposals in voting period is computed from the current votes. The gov keeper
+	// deletes the votes while counting, so it runs on a discarded branch of the context.
+	cacheCtx, _ := ctx.CacheContext()
+	resp, err := p.govQuery.TallyResult(cacheCtx, &govv1.QueryTallyResultRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	var tally Tally
+	for _, c := range []struct {
+		count string
+		dst   **big.Int
+	}{
+		{resp.Tally.YesCount, &tally.Yes},
+		{resp.Tally.AbstainCount, &tally.Abstain},
+		{resp.Tally.NoCount, &tally.No},
+		{resp.Tally.NoWithVetoCount, &tally.NoWithVeto},
+	} {
+		count, ok := sdkmath.NewIntFromString(c.count)
+		if !ok {
+			rerr = fmt.Errorf("invalid tally count %s", c.count)
+			return
+		}
+		*c.dst = count.BigInt()
+	}
+
+	ret, rerr = method.Outputs.Pack(tally)
+	remainingGas = suppliedGas
+	return
+}
+
+// toSDKCoins converts the coins of the ABI arguments
+func toSDKCoins(arg interface{}) sdk.Coins {
+	amount := *abi.ConvertType(arg, new([]Coin)).(*[]Coin)
+	coins := make(sdk.Coins, 0, len(amount))
+	for _, c := range amount {
+		coins = append(coins, sdk.NewCoin(c.Denom, sdkmath.NewIntFromBigInt(c.Amount)))
+	}
+	return sdk.NewCoins(coins...)
+}
+
+func unixTime(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
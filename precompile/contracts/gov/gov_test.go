@@ -0,0 +1,296 @@
+package gov_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/gov"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// delegateCallState is the state of a precompile called with a delegatecall
+type delegateCallState struct {
+	*vm.EVM
+}
+
+func (delegateCallState) IsDelegateCall() bool {
+	return true
+}
+
+func TestGov(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	voterAddr, voterEVMAddr := MockAddressPair()
+	otherAddr, otherEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, voterAddr, voterEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, otherAddr, otherEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	params, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(params.MinDeposit...).Add(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, voterAddr, coins))
+
+	// the voter is a delegator for its vote to be tallied
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(voterAddr.String(), validators[0].GetOperator(), sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000))))
+	require.NoError(t, err)
+	proposal, err := tApp.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", otherAddr, false)
+	require.NoError(t, err)
+
+	p := gov.NewContract(tApp.EvmKeeper, tApp.AppCodec(), govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := gov.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.GovContractAddress, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+	getProposal := func() *gov.Proposal {
+		output, err := run(otherEVMAddr, gov.ProposalMethod, true, proposal.Id)
+		require.NoError(t, err)
+		return abi.ConvertType(output[0], new(gov.Proposal)).(*gov.Proposal)
+	}
+	getTally := func() []int64 {
+		output, err := run(otherEVMAddr, gov.TallyMethod, true, proposal.Id)
+		require.NoError(t, err)
+		tally := abi.ConvertType(output[0], new(gov.Tally)).(*gov.Tally)
+		return []int64{tally.Yes.Int64(), tally.Abstain.Int64(), tally.No.Int64(), tally.NoWithVeto.Int64()}
+	}
+
+	gotProposal := getProposal()
+	assert.Equal(t, proposal.Id, gotProposal.Id)
+	assert.Equal(t, int32(govv1.StatusDepositPeriod), gotProposal.Status)
+	assert.Equal(t, "title", gotProposal.Title)
+	assert.Equal(t, proposal.SubmitTime.Unix(), gotProposal.SubmitTime)
+	assert.Zero(t, gotProposal.VotingStartTime)
+
+	// when voted in deposit period
+	_, err = run(voterEVMAddr, gov.VoteMethod, false, proposal.Id, int32(govv1.OptionYes), "")
+	require.Error(t, err)
+
+	// when deposited
+	deposit := make([]gov.Coin, 0, len(params.MinDeposit))
+	for _, c := range params.MinDeposit {
+		deposit = append(deposit, gov.Coin{Amount: c.Amount.BigInt(), Denom: c.Denom})
+	}
+	_, err = run(voterEVMAddr, gov.DepositMethod, false, proposal.Id, deposit)
+	require.NoError(t, err)
+
+	// then
+	gotProposal = getProposal()
+	assert.Equal(t, int32(govv1.StatusVotingPeriod), gotProposal.Status)
+	assert.Equal(t, deposit, gotProposal.TotalDeposit)
+	assert.NotZero(t, gotProposal.VotingEndTime)
+
+	// when voted
+	_, err = run(voterEVMAddr, gov.VoteMethod, false, proposal.Id, int32(govv1.OptionYes), "")
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []int64{1_000, 0, 0, 0}, getTally())
+	// and the tally keeps the vote
+	vote, err := tApp.GovKeeper.Votes.Get(ctx, collections.Join(proposal.Id, voterAddr))
+	require.NoError(t, err)
+	assert.Equal(t, govv1.OptionYes, vote.Options[0].Option)
+	assert.Equal(t, []int64{1_000, 0, 0, 0}, getTally())
+
+	// when voted weighted
+	options := []gov.WeightedVoteOption{{Option: int32(govv1.OptionYes), Weight: "0.6"}, {Option: int32(govv1.OptionNo), Weight: "0.4"}}
+	_, err = run(voterEVMAddr, gov.VoteWeightedMethod, false, proposal.Id, options, "")
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []int64{600, 0, 400, 0}, getTally())
+	assert.Len(t, evm.StateDB.(*statedb.StateDB).Logs(), 3)
+
+	// when weights do not add up to 1
+	options = []gov.WeightedVoteOption{{Option: int32(govv1.OptionYes), Weight: "0.5"}}
+	_, err = run(voterEVMAddr, gov.VoteWeightedMethod, false, proposal.Id, options, "")
+	require.Error(t, err)
+
+	// when called from staticcall
+	_, err = run(voterEVMAddr, gov.VoteMethod, true, proposal.Id, int32(govv1.OptionNo), "")
+	require.Error(t, err)
+
+	// when delegatecalled
+	for methodName, args := range map[string][]interface{}{
+		gov.VoteMethod:           {proposal.Id, int32(govv1.OptionNo), ""},
+		gov.VoteWeightedMethod:   {proposal.Id, []gov.WeightedVoteOption{{Option: int32(govv1.OptionNo), Weight: "1"}}, ""},
+		gov.DepositMethod:        {proposal.Id, deposit},
+		gov.SubmitProposalMethod: {[]string{}, deposit, "", "title", "summary", false},
+	} {
+		method := gov.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		_, _, err = p.Run(delegateCallState{&evm}, voterEVMAddr, registry.GovContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+		require.ErrorContains(t, err, "cannot delegatecall "+methodName)
+	}
+	assert.Equal(t, []int64{600, 0, 400, 0}, getTally())
+	assert.Equal(t, deposit, getProposal().TotalDeposit)
+
+	// when the proposal does not exist
+	_, err = run(otherEVMAddr, gov.ProposalMethod, true, proposal.Id+1)
+	require.Error(t, err)
+}
+
+func TestSubmitProposal(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	// deposit the EVM denom
+	params, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinDeposit = sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(100)))
+	require.NoError(t, tApp.GovKeeper.Params.Set(ctx, params))
+	proposerAddr, proposerEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, proposerAddr, proposerEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, proposerAddr, coins))
+
+	govAddr := tApp.GetAccountKeeper().GetModuleAddress(govtypes.ModuleName)
+	sendMsg, err := tApp.AppCodec().MarshalInterfaceJSON(banktypes.NewMsgSend(govAddr, proposerAddr, sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1)))))
+	require.NoError(t, err)
+	deposit := []gov.Coin{{Amount: big.NewInt(100), Denom: appconfig.CosmosDenom}}
+
+	p := gov.NewContract(tApp.EvmKeeper, tApp.AppCodec(), govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	method := gov.ABI.Methods[gov.SubmitProposalMethod]
+
+	specs := map[string]struct {
+		msgs       []string
+		deposit    []gov.Coin
+		expBalance int64
+		expErr     bool
+	}{
+		"with messages": {
+			msgs:       []string{string(sendMsg)},
+			deposit:    deposit,
+			expBalance: 900,
+		},
+		"without messages": {
+			msgs:       []string{},
+			deposit:    deposit,
+			expBalance: 900,
+		},
+		"initial deposit too small": {
+			msgs:       []string{},
+			deposit:    []gov.Coin{},
+			expBalance: 1_000,
+			expErr:     true,
+		},
+		"invalid message": {
+			msgs:       []string{`{"@type":"/unknown.Msg"}`},
+			deposit:    deposit,
+			expBalance: 1_000,
+			expErr:     true,
+		},
+		"insufficient deposit funds": {
+			msgs:       []string{},
+			deposit:    []gov.Coin{{Amount: big.NewInt(1_001), Denom: appconfig.CosmosDenom}},
+			expBalance: 1_000,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := vm.EVM{StateDB: stateDB}
+			// the EVM transaction bumps the proposer nonce so the proposer account is written back on commit
+			stateDB.SetNonce(proposerEVMAddr, stateDB.GetNonce(proposerEVMAddr)+1)
+			input, err := method.Inputs.Pack(spec.msgs, spec.deposit, "metadata", "title", "summary", false)
+			require.NoError(t, err)
+
+			// when
+			res, _, err := p.Run(&evm, proposerEVMAddr, registry.GovContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+			require.NoError(t, stateDB.Commit())
+
+			// then
+			assert.Equal(t, sdkmath.NewInt(spec.expBalance), bankKeeper.GetBalance(ctx, proposerAddr, appconfig.CosmosDenom).Amount)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Empty(t, stateDB.Logs())
+				return
+			}
+			require.NoError(t, err)
+			output, err := method.Outputs.Unpack(res)
+			require.NoError(t, err)
+			proposal, err := tApp.GovKeeper.Proposals.Get(ctx, output[0].(uint64))
+			require.NoError(t, err)
+			assert.Equal(t, proposerAddr.String(), proposal.Proposer)
+			assert.Equal(t, "title", proposal.Title)
+			assert.Len(t, proposal.Messages, len(spec.msgs))
+			require.Len(t, stateDB.Logs(), 1)
+			event := gov.ABI.Events[gov.SubmitProposalEvent]
+			assert.Equal(t, []common.Hash{event.ID, common.BytesToHash(proposerEVMAddr.Bytes()), common.BigToHash(new(big.Int).SetUint64(proposal.Id))}, stateDB.Logs()[0].Topics)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package ibc
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of ibc contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	TransferMethod   = "transfer"
+	DenomTraceMethod = "denomTrace"
+
+	TransferEvent = "Transfer"
+)
+
+type PrecompileExecutor struct {
+	evmKeeper     pcommon.EVMKeeper
+	transferMsg   transfertypes.MsgServer
+	transferQuery transfertypes.QueryServer
+}
+
+// NewContract returns a new ibc stateful precompiled contract.
+//
+//	Transfers are ICS-20 transfers on the transfer port, executed through the transfer msg server with
+//	the cosmos address mapped to the EVM caller as sender.
+func NewContract(evmKeeper pcommon.EVMKeeper, transferMsg transfertypes.MsgServer, transferQuery transfertypes.QueryServer) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:     evmKeeper,
+		transferMsg:   transferMsg,
+		transferQuery: transferQuery,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[TransferMethod].ID,
+			executor.transfer,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[DenomTraceMethod].ID,
+			executor.denomTrace,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate ibc precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) transfer(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[TransferMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call transfer from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall transfer")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 8); err != nil {
+		rerr = err
+		return
+	}
+
+	channel := args[0].(string)
+	receiver := args[1].(string)
+	denom := args[2].(string)
+	amount := args[3].(*big.Int)
+	timeoutHeight := clienttypes.NewHeight(args[4].(uint64), args[5].(uint64))
+	timeoutTimestamp := args[6].(uint64)
+	memo := args[7].(string)
+
+	// without timeout the packet times out after the default relative timeout, from this chain's block time
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp
+	}
+	if err := validateMemo(memo); err != nil {
+		rerr = err
+		return
+	}
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		channel,
+		sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)),
+		senderCosmosAddr.String(),
+		receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+		memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		resp, err := p.transferMsg.Transfer(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[TransferEvent], caller, channel, receiver, denom, amount, resp.Sequence); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(resp.Sequence)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) denomTrace(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying denomTrace using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DenomTraceMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	// the denom is either ibc/{hash} or the hash only
+	resp, err := p.transferQuery.DenomTrace(ctx, &transfertypes.QueryDenomTraceRequest{Hash: args[0].(string)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(resp.DenomTrace.Path, resp.DenomTrace.BaseDenom)
+	remainingGas = suppliedGas
+	return
+}
+
+// validateMemo rejects memos in the packet-forward-middleware format that would fail to be
+// forwarded, so that the transfer reverts here instead of on the counterparty chain.
+// Other memos are passed through as is.
+func validateMemo(memo string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil
+	}
+	if _, ok := fields["forward"]; !ok {
+		return nil
+	}
+	var metadata packetforwardtypes.PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return fmt.Errorf("invalid forward memo: %w", err)
+	}
+	if metadata.Forward == nil {
+		return errors.New("invalid forward memo: forward is empty")
+	}
+	return metadata.Forward.Validate()
+}
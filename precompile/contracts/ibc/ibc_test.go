@@ -0,0 +1,139 @@
+package ibc_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/ibc"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// delegateCallState is the state of a precompile called with a delegatecall
+type delegateCallState struct {
+	*vm.EVM
+}
+
+func (delegateCallState) IsDelegateCall() bool {
+	return true
+}
+
+func TestIBC(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	senderAddr, senderEVMAddr := MockAddressPair()
+	receiverAddr, _ := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, senderAddr, senderEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, senderAddr, coins))
+	trace := transfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	tApp.TransferKeeper.SetDenomTrace(ctx, trace)
+
+	p := ibc.NewContract(tApp.EvmKeeper, tApp.TransferKeeper, tApp.TransferKeeper)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := ibc.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.IBCContractAddress, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+	transfer := func(readOnly bool, channel, memo string) error {
+		_, err := run(senderEVMAddr, ibc.TransferMethod, readOnly, channel, receiverAddr.String(), "denom", big.NewInt(100), uint64(0), uint64(0), uint64(0), memo)
+		return err
+	}
+
+	// when queried with the ibc denom
+	output, err := run(senderEVMAddr, ibc.DenomTraceMethod, true, trace.IBCDenom())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []interface{}{"transfer/channel-0", "uatom"}, output)
+
+	// when queried with the hash only
+	output, err = run(senderEVMAddr, ibc.DenomTraceMethod, true, trace.Hash().String())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []interface{}{"transfer/channel-0", "uatom"}, output)
+
+	// when the trace does not exist
+	_, err = run(senderEVMAddr, ibc.DenomTraceMethod, true, transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom())
+	require.Error(t, err)
+
+	// when the forward memo is invalid
+	err = transfer(false, "channel-0", `{"forward":{"receiver":"","port":"transfer","channel":"channel-1"}}`)
+	require.ErrorContains(t, err, "receiver")
+
+	// when the channel does not exist
+	err = transfer(false, "channel-0", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`)
+	require.Error(t, err)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, senderAddr, "denom").Amount)
+	assert.Empty(t, evm.StateDB.(*statedb.StateDB).Logs())
+
+	// when called from staticcall
+	err = transfer(true, "channel-0", "")
+	require.Error(t, err)
+	// when delegatecalled
+	method := ibc.ABI.Methods[ibc.TransferMethod]
+	input, err := method.Inputs.Pack("channel-0", receiverAddr.String(), "denom", big.NewInt(100), uint64(0), uint64(0), uint64(0), "")
+	require.NoError(t, err)
+	_, _, err = p.Run(delegateCallState{&evm}, senderEVMAddr, registry.IBCContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+	require.ErrorContains(t, err, "cannot delegatecall transfer")
+}
@@ -1,14 +1,16 @@
 package json
 
 import (
+	"bytes"
 	_ "embed"
 	gjson "encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 )
 
@@ -25,34 +27,55 @@ const (
 	ExtractAsBytesMethod     = "extractAsBytes"
 	ExtractAsBytesListMethod = "extractAsBytesList"
 	ExtractAsUint256Method   = "extractAsUint256"
+	ExtractAsInt256Method    = "extractAsInt256"
+	ExtractAsBoolMethod      = "extractAsBool"
+	ExtractAsAddressMethod   = "extractAsAddress"
+	ExtractAsStringMethod    = "extractAsString"
+	ExtractArrayLengthMethod = "extractArrayLength"
 )
 
+var (
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	maxInt256  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	minInt256  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+)
+
+// extractFn converts the raw JSON value at the path into the output of the method
+type extractFn func(value gjson.RawMessage) (interface{}, error)
+
 type PrecompileExecutor struct {
 }
 
-// NewContract returns a new wasmd stateful precompiled contract.
+// NewContract returns a new json stateful precompiled contract.
 //
-//	This contract is used for testing purposes only and should not be used on public chains.
-//	The functions of this contract (once implemented), will be used to exercise and test the various aspects of
-//	the EVM such as gas usage, argument parsing, events, etc. The specific operations tested under this contract are
-//	still to be determined.
+//	The extract methods take a JSON document and a path of object keys separated by dots and array
+//	indexes in brackets, e.g. `a.b[2].c`, and return the value at the path converted into the
+//	method output type. A top-level key equal to the whole path takes precedence over the path.
+//	Malformed input, unknown paths and values of the wrong type revert with a reason.
 func NewContract() contract.StatefulPrecompiledContract {
 
 	executor := &PrecompileExecutor{}
 
-	functions := []*contract.StatefulPrecompileFunction{
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsBytesMethod].ID,
-			executor.extractAsBytes,
-		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsBytesListMethod].ID,
-			executor.extractAsBytesList,
-		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsUint256Method].ID,
-			executor.ExtractAsUint256,
-		),
+	extractFns := map[string]extractFn{
+		ExtractAsBytesMethod:     extractBytes,
+		ExtractAsBytesListMethod: extractBytesList,
+		ExtractAsUint256Method:   extractUint256,
+		ExtractAsInt256Method:    extractInt256,
+		ExtractAsBoolMethod:      extractBool,
+		ExtractAsAddressMethod:   extractAddress,
+		ExtractAsStringMethod:    extractString,
+		ExtractArrayLengthMethod: extractArrayLength,
+	}
+	functions := make([]*contract.StatefulPrecompileFunction, 0, len(extractFns))
+	for _, method := range ABI.Methods {
+		fn, ok := extractFns[method.Name]
+		if !ok {
+			panic(fmt.Sprintf("failed to instantiate json precompile: method %s not implemented", method.Name))
+		}
+		functions = append(functions, contract.NewStatefulPrecompileFunction(
+			method.ID,
+			executor.extract(method.Name, fn),
+		))
 	}
 
 	// Construct the contract with functions.
@@ -65,221 +88,151 @@ func NewContract() contract.StatefulPrecompiledContract {
 	return precompile
 }
 
-func (p PrecompileExecutor) extractAsBytes(accessibleState contract.AccessibleState,
-	caller common.Address,
-	callingContract common.Address,
-	packedInput []byte,
-	suppliedGas uint64,
-	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error Extracting as bytes: ", rerr.Error())
+// extract returns the executor of the method, extracting the value at the path of the input with fn
+func (p PrecompileExecutor) extract(methodName string, fn extractFn) contract.RunStatefulPrecompileFunc {
+	return func(accessibleState contract.AccessibleState,
+		caller common.Address,
+		callingContract common.Address,
+		packedInput []byte,
+		suppliedGas uint64,
+		readOnly bool,
+		value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+		ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+		if rerr != nil {
 			return
 		}
-	}()
-	method := ABI.Methods[ExtractAsBytesMethod]
-
-	args, err := method.Inputs.Unpack(packedInput)
-	if err != nil {
-		rerr = err
-		return
-	}
 
-	if err := pcommon.ValidateNonPayable(value); err != nil {
-		rerr = err
-		return
-	}
-
-	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
-		rerr = err
-		return
-	}
-
-	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
-		rerr = err
-		return
-	}
-	key := args[1].(string)
-	result, ok := decoded[key]
-	if !ok {
-		rerr = fmt.Errorf("Could not decode key extractAsBytes\n")
-		return
-	}
-
-	// in the case of a string value, remove the quotes
-	if len(result) >= 2 && result[0] == '"' && result[len(result)-1] == '"' {
-		result = result[1 : len(result)-1]
-	}
-
-	ret, rerr = method.Outputs.Pack([]byte(result))
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
-	return
-}
-
-func (p PrecompileExecutor) extractAsBytesList(accessibleState contract.AccessibleState,
-	caller common.Address,
-	callingContract common.Address,
-	packedInput []byte,
-	suppliedGas uint64,
-	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s\n", err)
-			ctx.Logger().Error("Error Extracting as bytes list: ", rerr.Error())
+		defer func() {
+			if err := recover(); err != nil {
+				ret = nil
+				remainingGas = 0
+				rerr = fmt.Errorf("%s", err)
+				ctx.Logger().Error("Error extracting json using precompile: ", rerr.Error())
+				return
+			}
+		}()
+		method := ABI.Methods[methodName]
+
+		args, err := method.Inputs.Unpack(packedInput)
+		if err != nil {
+			rerr = err
 			return
 		}
-	}()
-	method := ABI.Methods[ExtractAsBytesListMethod]
 
-	args, err := method.Inputs.Unpack(packedInput)
-	if err != nil {
-		rerr = err
-		return
-	}
+		if err := pcommon.ValidateNonPayable(value); err != nil {
+			rerr = err
+			return
+		}
 
-	if err := pcommon.ValidateNonPayable(value); err != nil {
-		rerr = err
-		return
-	}
+		if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+			rerr = err
+			return
+		}
 
-	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
-		rerr = err
-		return
+		remainingGas = suppliedGas
+
+		// type assertion will always succeed because it's already validated in p.Prepare call in Run()
+		path := args[1].(string)
+		result, err := lookup(args[0].([]byte), path)
+		if err == nil {
+			var out interface{}
+			if out, err = fn(result); err == nil {
+				ret, rerr = method.Outputs.Pack(out)
+				return
+			}
+			err = fmt.Errorf("value at %q %w", path, err)
+		}
+		return pcommon.RevertReason(fmt.Sprintf("%s: %s", methodName, err)), remainingGas, vm.ErrExecutionReverted
 	}
+}
 
-	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
-		rerr = err
-		return
-	}
-	key := args[1].(string)
-	result, ok := decoded[key]
-	if !ok {
-		rerr = fmt.Errorf("input does not contain key %s in extractAsBytesList\n", key)
-		return
+func extractBytes(value gjson.RawMessage) (interface{}, error) {
+	// in the case of a string value, remove the quotes
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		value = value[1 : len(value)-1]
 	}
+	return []byte(value), nil
+}
 
-	decodedResult := []gjson.RawMessage{}
-	if err := gjson.Unmarshal(result, &decodedResult); err != nil {
-		rerr = err
-		return
+func extractBytesList(value gjson.RawMessage) (interface{}, error) {
+	var array []gjson.RawMessage
+	if err := gjson.Unmarshal(value, &array); err != nil || array == nil {
+		return nil, errors.New("is not an array")
 	}
-
-	decodedBytes := [][]byte{}
-	for _, r := range decodedResult {
-		decodedBytes = append(decodedBytes, []byte(r))
+	decodedBytes := make([][]byte, len(array))
+	for i, r := range array {
+		decodedBytes[i] = []byte(r)
 	}
-
-	ret, rerr = method.Outputs.Pack(decodedBytes)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
-	return
+	return decodedBytes, nil
 }
 
-func (p PrecompileExecutor) ExtractAsUint256(accessibleState contract.AccessibleState,
-	caller common.Address,
-	callingContract common.Address,
-	packedInput []byte,
-	suppliedGas uint64,
-	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error Extracting as uint256: ", rerr.Error())
-			return
-		}
-	}()
-
-	byteArr := make([]byte, 32)
-	uint_, err := p.extractAsUint256(packedInput, value)
+func extractUint256(value gjson.RawMessage) (interface{}, error) {
+	i, err := parseInteger(value)
 	if err != nil {
-		rerr = err
-		return
+		return nil, err
 	}
-
-	if uint_.BitLen() > 256 {
-		rerr = fmt.Errorf("value does not fit in 32 bytes\n")
+	if i.Sign() < 0 || i.Cmp(maxUint256) > 0 {
+		return nil, errors.New("does not fit in uint256")
 	}
-
-	uint_.FillBytes(byteArr)
-
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
-
-	return byteArr, remainingGas, nil
+	return i, nil
 }
 
-func (p PrecompileExecutor) extractAsUint256(
-	packedInput []byte,
-	value *big.Int) (*big.Int, error) {
-
-	method := ABI.Methods[ExtractAsUint256Method]
-
-	args, err := method.Inputs.Unpack(packedInput)
+func extractInt256(value gjson.RawMessage) (interface{}, error) {
+	i, err := parseInteger(value)
 	if err != nil {
 		return nil, err
 	}
-
-	if err := pcommon.ValidateNonPayable(value); err != nil {
-		return nil, err
-	}
-
-	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
-		return nil, err
+	if i.Cmp(minInt256) < 0 || i.Cmp(maxInt256) > 0 {
+		return nil, errors.New("does not fit in int256")
 	}
+	return i, nil
+}
 
-	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
-		return nil, err
+// parseInteger parses a JSON number or a JSON string of a base 10 integer
+func parseInteger(value gjson.RawMessage) (*big.Int, error) {
+	s := string(value)
+	var str string
+	if err := gjson.Unmarshal(value, &str); err == nil {
+		s = str
 	}
-	key := args[1].(string)
-	result, ok := decoded[key]
+	i, ok := new(big.Int).SetString(s, 10)
 	if !ok {
-		return nil, fmt.Errorf("input does not contain key %s", key)
+		return nil, errors.New("is not an integer")
 	}
+	return i, nil
+}
+
+func extractBool(value gjson.RawMessage) (interface{}, error) {
+	switch {
+	case bytes.Equal(value, []byte("true")):
+		return true, nil
+	case bytes.Equal(value, []byte("false")):
+		return false, nil
+	}
+	return nil, errors.New("is not a bool")
+}
 
-	// Assuming result is your byte slice
-	// Convert byte slice to string and trim quotation marks
-	strValue := strings.Trim(string(result), "\"")
+func extractAddress(value gjson.RawMessage) (interface{}, error) {
+	var s string
+	if err := gjson.Unmarshal(value, &s); err != nil || !common.IsHexAddress(s) {
+		return nil, errors.New("is not a hex address")
+	}
+	return common.HexToAddress(s), nil
+}
 
-	// Convert the string to big.Int
-	value, success := new(big.Int).SetString(strValue, 10)
-	if !success {
-		return nil, fmt.Errorf("failed to convert %s to big.Int", strValue)
+func extractString(value gjson.RawMessage) (interface{}, error) {
+	var s string
+	if len(value) == 0 || value[0] != '"' || gjson.Unmarshal(value, &s) != nil {
+		return nil, errors.New("is not a string")
 	}
+	return s, nil
+}
 
-	return value, nil
+func extractArrayLength(value gjson.RawMessage) (interface{}, error) {
+	var array []gjson.RawMessage
+	if err := gjson.Unmarshal(value, &array); err != nil || array == nil {
+		return nil, errors.New("is not an array")
+	}
+	return big.NewInt(int64(len(array))), nil
 }
@@ -7,9 +7,11 @@ import (
 	"github.com/CosmWasm/wasmd/app"
 	"github.com/CosmWasm/wasmd/precompile/contracts/json"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -143,3 +145,178 @@ func TestExtractAsUint256(t *testing.T) {
 		require.Equal(t, 0, output[0].(*big.Int).Cmp(test.expectedOutput))
 	}
 }
+
+func TestExtractByPath(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := json.NewContract()
+	suppliedGas := uint64(10_000_000)
+	body := []byte(`{
+		"a": {"b": [{"c": 1}, {"c": "-2"}, {"c": true, "d": "0x000000000000000000000000000000000000dEaD"}]},
+		"s": "quoted \"string\"",
+		"n": null,
+		"big": "115792089237316195423570985008687907853269984665640564039457584007913129639936",
+		"list": [[1, 2], []],
+		"k.v": "dotted",
+		"e[1]": 5
+	}`)
+
+	specs := map[string]struct {
+		method    string
+		path      string
+		expOutput interface{}
+		expRevert string
+	}{
+		"nested bytes": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a.b[0]",
+			expOutput: []byte(`{"c": 1}`),
+		},
+		"nested bytes list": {
+			method:    json.ExtractAsBytesListMethod,
+			path:      "list[0]",
+			expOutput: [][]byte{[]byte("1"), []byte("2")},
+		},
+		"nested uint256": {
+			method:    json.ExtractAsUint256Method,
+			path:      "a.b[0].c",
+			expOutput: big.NewInt(1),
+		},
+		"int256 from string": {
+			method:    json.ExtractAsInt256Method,
+			path:      "a.b[1].c",
+			expOutput: big.NewInt(-2),
+		},
+		"bool": {
+			method:    json.ExtractAsBoolMethod,
+			path:      "a.b[2].c",
+			expOutput: true,
+		},
+		"address": {
+			method:    json.ExtractAsAddressMethod,
+			path:      "a.b[2].d",
+			expOutput: common.HexToAddress("0x000000000000000000000000000000000000dEaD"),
+		},
+		"unescaped string": {
+			method:    json.ExtractAsStringMethod,
+			path:      "s",
+			expOutput: `quoted "string"`,
+		},
+		"array length": {
+			method:    json.ExtractArrayLengthMethod,
+			path:      "a.b",
+			expOutput: big.NewInt(3),
+		},
+		"empty array length": {
+			method:    json.ExtractArrayLengthMethod,
+			path:      "list[1]",
+			expOutput: big.NewInt(0),
+		},
+		"top-level key with a dot": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "k.v",
+			expOutput: []byte("dotted"),
+		},
+		"top-level key with brackets": {
+			method:    json.ExtractAsUint256Method,
+			path:      "e[1]",
+			expOutput: big.NewInt(5),
+		},
+		"unknown key": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a.x",
+			expRevert: `extractAsBytes: input does not contain key "x" at "a"`,
+		},
+		"index out of range": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a.b[3]",
+			expRevert: `extractAsBytes: index 3 out of range at "a.b"`,
+		},
+		"index into object": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a[0]",
+			expRevert: `extractAsBytes: value at "a" is not an array`,
+		},
+		"key into array": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a.b.c",
+			expRevert: `extractAsBytes: value at "a.b" is not an object`,
+		},
+		"invalid path": {
+			method:    json.ExtractAsBytesMethod,
+			path:      "a..b",
+			expRevert: `extractAsBytes: invalid path "a..b": empty key at 2`,
+		},
+		"negative uint256": {
+			method:    json.ExtractAsUint256Method,
+			path:      "a.b[1].c",
+			expRevert: `extractAsUint256: value at "a.b[1].c" does not fit in uint256`,
+		},
+		"uint256 overflow": {
+			method:    json.ExtractAsUint256Method,
+			path:      "big",
+			expRevert: `extractAsUint256: value at "big" does not fit in uint256`,
+		},
+		"not a bool": {
+			method:    json.ExtractAsBoolMethod,
+			path:      "a.b[0].c",
+			expRevert: `extractAsBool: value at "a.b[0].c" is not a bool`,
+		},
+		"null string": {
+			method:    json.ExtractAsStringMethod,
+			path:      "n",
+			expRevert: `extractAsString: value at "n" is not a string`,
+		},
+		"not an address": {
+			method:    json.ExtractAsAddressMethod,
+			path:      "s",
+			expRevert: `extractAsAddress: value at "s" is not a hex address`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			method := json.ABI.Methods[spec.method]
+			args, err := method.Inputs.Pack(body, spec.path)
+			require.NoError(t, err)
+
+			res, _, err := p.Run(&evm, registry.JsonContractAddress, registry.JsonContractAddress,
+				append(method.ID, args...),
+				suppliedGas,
+				true,
+				nil,
+			)
+			if spec.expRevert != "" {
+				require.ErrorIs(t, err, vm.ErrExecutionReverted)
+				reason, err := abi.UnpackRevert(res)
+				require.NoError(t, err)
+				assert.Equal(t, spec.expRevert, reason)
+				return
+			}
+			require.NoError(t, err)
+			output, err := method.Outputs.Unpack(res)
+			require.NoError(t, err)
+			require.Len(t, output, 1)
+			if exp, ok := spec.expOutput.(*big.Int); ok {
+				assert.Equal(t, exp.String(), output[0].(*big.Int).String())
+				return
+			}
+			assert.Equal(t, spec.expOutput, output[0])
+		})
+	}
+
+	// when the input is not json
+	method := json.ABI.Methods[json.ExtractAsBytesMethod]
+	args, err := method.Inputs.Pack([]byte("{"), "a")
+	require.NoError(t, err)
+	res, _, err := p.Run(&evm, registry.JsonContractAddress, registry.JsonContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+
+	// then
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, err := abi.UnpackRevert(res)
+	require.NoError(t, err)
+	assert.Equal(t, "extractAsBytes: invalid json input", reason)
+}
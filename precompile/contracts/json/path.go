@@ -0,0 +1,118 @@
+package json
+
+import (
+	"bytes"
+	gjson "encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSegment is an object key or an array index of a path
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s pathSegment) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+	return s.key
+}
+
+// parsePath parses a path of object keys separated by dots and array indexes in brackets,
+// e.g. `a.b[2].c` or `[0].a`. The empty path selects the whole document.
+// Keys can not contain dots or brackets.
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed bracket at %d", path, i)
+			}
+			digits := path[i+1 : i+end]
+			index, err := strconv.ParseUint(digits, 10, 31)
+			if err != nil || (len(digits) > 1 && digits[0] == '0') {
+				return nil, fmt.Errorf("invalid path %q: invalid index %q", path, digits)
+			}
+			segments = append(segments, pathSegment{index: int(index), isIndex: true})
+			i += end + 1
+		default:
+			if len(segments) != 0 {
+				if path[i] != '.' {
+					return nil, fmt.Errorf("invalid path %q: expected '.' or '[' at %d", path, i)
+				}
+				i++
+			}
+			end := strings.IndexAny(path[i:], ".[]")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty key at %d", path, i)
+			}
+			segments = append(segments, pathSegment{key: path[i : i+end]})
+			i += end
+		}
+	}
+	return segments, nil
+}
+
+// lookup returns the raw JSON value at the path in the document. A top-level key equal to the whole
+// path is selected before the path is parsed, so that keys with dots or brackets can still be extracted.
+func lookup(document []byte, path string) (gjson.RawMessage, error) {
+	if !gjson.Valid(document) {
+		return nil, fmt.Errorf("invalid json input")
+	}
+	var object map[string]gjson.RawMessage
+	if err := gjson.Unmarshal(document, &object); err == nil {
+		if v, ok := object[path]; ok {
+			return bytes.TrimSpace(v), nil
+		}
+	}
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	value := gjson.RawMessage(document)
+	for i, segment := range segments {
+		parent := joinPath(segments[:i])
+		if segment.isIndex {
+			var array []gjson.RawMessage
+			if err := gjson.Unmarshal(value, &array); err != nil || array == nil {
+				return nil, fmt.Errorf("value at %q is not an array", parent)
+			}
+			if segment.index >= len(array) {
+				return nil, fmt.Errorf("index %d out of range at %q", segment.index, parent)
+			}
+			value = array[segment.index]
+			continue
+		}
+		var object map[string]gjson.RawMessage
+		if err := gjson.Unmarshal(value, &object); err != nil || object == nil {
+			return nil, fmt.Errorf("value at %q is not an object", parent)
+		}
+		v, ok := object[segment.key]
+		if !ok {
+			return nil, fmt.Errorf("input does not contain key %q at %q", segment.key, parent)
+		}
+		value = v
+	}
+	return bytes.TrimSpace(value), nil
+}
+
+// joinPath returns the path of the segments in the path language
+func joinPath(segments []pathSegment) string {
+	var b strings.Builder
+	for i, s := range segments {
+		if i != 0 && !s.isIndex {
+			b.WriteByte('.')
+		}
+		b.WriteString(s.String())
+	}
+	return b.String()
+}
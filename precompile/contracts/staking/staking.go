@@ -0,0 +1,624 @@
+package staking
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of staking contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	DelegateMethod            = "delegate"
+	UndelegateMethod          = "undelegate"
+	RedelegateMethod          = "redelegate"
+	WithdrawRewardsMethod     = "withdrawRewards"
+	DelegationMethod          = "delegation"
+	UnbondingDelegationMethod = "unbondingDelegation"
+	PendingRewardsMethod      = "pendingRewards"
+
+	DelegateEvent        = "Delegate"
+	UndelegateEvent      = "Undelegate"
+	RedelegateEvent      = "Redelegate"
+	WithdrawRewardsEvent = "WithdrawRewards"
+)
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type Delegation struct {
+	Validator string
+	// Shares has 18 decimals
+	Shares *big.Int
+	Amount *big.Int
+	Denom  string
+}
+
+type UnbondingEntry struct {
+	CreationHeight int64
+	// CompletionTime is a unix timestamp in seconds
+	CompletionTime int64
+	InitialBalance *big.Int
+	Balance        *big.Int
+}
+
+type PrecompileExecutor struct {
+	evmKeeper    pcommon.EVMKeeper
+	stakingMsg   stakingtypes.MsgServer
+	stakingQuery stakingtypes.QueryServer
+	distrMsg     distrtypes.MsgServer
+	distrQuery   distrtypes.QueryServer
+}
+
+// NewContract returns a new staking stateful precompiled contract.
+//
+//	The mutating functions are executed through the staking and distribution msg servers with the
+//	cosmos address mapped to the EVM caller as delegator. Amounts are in the bond denom.
+func NewContract(evmKeeper pcommon.EVMKeeper, stakingMsg stakingtypes.MsgServer, stakingQuery stakingtypes.QueryServer, distrMsg distrtypes.MsgServer, distrQuery distrtypes.QueryServer) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:    evmKeeper,
+		stakingMsg:   stakingMsg,
+		stakingQuery: stakingQuery,
+		distrMsg:     distrMsg,
+		distrQuery:   distrQuery,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[DelegateMethod].ID,
+			executor.delegate,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[UndelegateMethod].ID,
+			executor.undelegate,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[RedelegateMethod].ID,
+			executor.redelegate,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[WithdrawRewardsMethod].ID,
+			executor.withdrawRewards,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[DelegationMethod].ID,
+			executor.delegation,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[UnbondingDelegationMethod].ID,
+			executor.unbondingDelegation,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[PendingRewardsMethod].ID,
+			executor.pendingRewards,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate staking precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) delegate(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[DelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call delegate from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall delegate")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	validator := args[0].(string)
+	amount := args[1].(*big.Int)
+
+	bondDenom, err := p.bondDenom(ctx)
+	if err != nil {
+		rerr = err
+		return
+	}
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := stakingtypes.NewMsgDelegate(delegatorCosmosAddr.String(), validator, sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)))
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.stakingMsg.Delegate(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[DelegateEvent], caller, validator, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) undelegate(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[UndelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call undelegate from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall undelegate")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	validator := args[0].(string)
+	amount := args[1].(*big.Int)
+
+	bondDenom, err := p.bondDenom(ctx)
+	if err != nil {
+		rerr = err
+		return
+	}
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := stakingtypes.NewMsgUndelegate(delegatorCosmosAddr.String(), validator, sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)))
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		resp, err := p.stakingMsg.Undelegate(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		completionTime := resp.CompletionTime.Unix()
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[UndelegateEvent], caller, validator, amount, completionTime); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(completionTime)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) redelegate(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[RedelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call redelegate from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall redelegate")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	srcValidator := args[0].(string)
+	dstValidator := args[1].(string)
+	amount := args[2].(*big.Int)
+
+	bondDenom, err := p.bondDenom(ctx)
+	if err != nil {
+		rerr = err
+		return
+	}
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := stakingtypes.NewMsgBeginRedelegate(delegatorCosmosAddr.String(), srcValidator, dstValidator, sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)))
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.stakingMsg.BeginRedelegate(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[RedelegateEvent], caller, srcValidator, dstValidator, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) withdrawRewards(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[WithdrawRewardsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call withdrawRewards from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall withdrawRewards")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	validator := args[0].(string)
+
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(delegatorCosmosAddr.String(), validator)
+
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		resp, err := p.distrMsg.WithdrawDelegatorReward(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[WithdrawRewardsEvent], caller, validator); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(toCoins(resp.Amount))
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) delegation(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying delegation using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DelegationMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	resp, err := p.stakingQuery.Delegation(ctx, &stakingtypes.QueryDelegationRequest{
+		DelegatorAddr: delegatorCosmosAddr.String(),
+		ValidatorAddr: args[1].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(Delegation{
+		Validator: resp.DelegationResponse.Delegation.ValidatorAddress,
+		Shares:    resp.DelegationResponse.Delegation.Shares.BigInt(),
+		Amount:    resp.DelegationResponse.Balance.Amount.BigInt(),
+		Denom:     resp.DelegationResponse.Balance.Denom,
+	})
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) unbondingDelegation(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying unbondingDelegation using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[UnbondingDelegationMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	resp, err := p.stakingQuery.UnbondingDelegation(ctx, &stakingtypes.QueryUnbondingDelegationRequest{
+		DelegatorAddr: delegatorCosmosAddr.String(),
+		ValidatorAddr: args[1].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	entries := make([]UnbondingEntry, 0, len(resp.Unbond.Entries))
+	for _, entry := range resp.Unbond.Entries {
+		entries = append(entries, UnbondingEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime.Unix(),
+			InitialBalance: entry.InitialBalance.BigInt(),
+			Balance:        entry.Balance.BigInt(),
+		})
+	}
+
+	ret, rerr = method.Outputs.Pack(entries)
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) pendingRewards(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying pendingRewards using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[PendingRewardsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	delegatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	resp, err := p.distrQuery.DelegationRewards(ctx, &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: delegatorCosmosAddr.String(),
+		ValidatorAddress: args[1].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	// rewards are truncated to the amount a withdrawal would pay out
+	rewards, _ := resp.Rewards.TruncateDecimal()
+
+	ret, rerr = method.Outputs.Pack(toCoins(rewards))
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) bondDenom(ctx sdk.Context) (string, error) {
+	resp, err := p.stakingQuery.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.Params.BondDenom, nil
+}
+
+func toCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, Coin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		})
+	}
+	return res
+}
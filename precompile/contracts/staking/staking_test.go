@@ -0,0 +1,242 @@
+package staking_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/staking"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// delegateCallState is the state of a precompile called with a delegatecall
+type delegateCallState struct {
+	*vm.EVM
+}
+
+func (delegateCallState) IsDelegateCall() bool {
+	return true
+}
+
+func TestStaking(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	delegatorAddr, delegatorEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, delegatorAddr, delegatorEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, delegatorAddr, coins))
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	validator := validators[0].GetOperator()
+
+	p := staking.NewContract(
+		tApp.EvmKeeper,
+		stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper),
+		stakingkeeper.NewQuerier(tApp.StakingKeeper),
+		distrkeeper.NewMsgServerImpl(tApp.DistrKeeper),
+		distrkeeper.NewQuerier(tApp.DistrKeeper),
+	)
+	var evm vm.EVM
+	newEVM := func(ctx sdk.Context) {
+		evm = vm.EVM{
+			StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		}
+	}
+	newEVM(ctx)
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := staking.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.StakingContractAddress, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+
+	// when delegated
+	_, err = run(delegatorEVMAddr, staking.DelegateMethod, false, validator, big.NewInt(100))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, delegatorAddr, bondDenom).Amount)
+	output, err := run(delegatorEVMAddr, staking.DelegationMethod, true, delegatorEVMAddr, validator)
+	require.NoError(t, err)
+	gotDelegation := abi.ConvertType(output[0], new(staking.Delegation)).(*staking.Delegation)
+	assert.Equal(t, validator, gotDelegation.Validator)
+	assert.Equal(t, big.NewInt(100), gotDelegation.Amount)
+	assert.Equal(t, bondDenom, gotDelegation.Denom)
+	assert.Len(t, evm.StateDB.(*statedb.StateDB).Logs(), 1)
+
+	// when rewards are allocated in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	newEVM(ctx)
+	val, err := tApp.StakingKeeper.GetValidator(ctx, mustValAddress(t, validator))
+	require.NoError(t, err)
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, val.GetTokens()))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, rewards))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, distrtypes.ModuleName, rewards))
+	require.NoError(t, tApp.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	// then
+	output, err = run(delegatorEVMAddr, staking.PendingRewardsMethod, true, delegatorEVMAddr, validator)
+	require.NoError(t, err)
+	gotRewards := *abi.ConvertType(output[0], new([]staking.Coin)).(*[]staking.Coin)
+	require.Len(t, gotRewards, 1)
+	assert.Equal(t, bondDenom, gotRewards[0].Denom)
+	assert.Positive(t, gotRewards[0].Amount.Sign())
+
+	// when withdrawn
+	output, err = run(delegatorEVMAddr, staking.WithdrawRewardsMethod, false, validator)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &gotRewards, abi.ConvertType(output[0], new([]staking.Coin)))
+	assert.Equal(t, sdkmath.NewInt(900).Add(sdkmath.NewIntFromBigInt(gotRewards[0].Amount)), bankKeeper.GetBalance(ctx, delegatorAddr, bondDenom).Amount)
+
+	// when undelegated
+	output, err = run(delegatorEVMAddr, staking.UndelegateMethod, false, validator, big.NewInt(40))
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, output, 1)
+	completionTime := output[0].(int64)
+	output, err = run(delegatorEVMAddr, staking.UnbondingDelegationMethod, true, delegatorEVMAddr, validator)
+	require.NoError(t, err)
+	gotEntries := *abi.ConvertType(output[0], new([]staking.UnbondingEntry)).(*[]staking.UnbondingEntry)
+	require.Len(t, gotEntries, 1)
+	assert.Equal(t, completionTime, gotEntries[0].CompletionTime)
+	assert.Equal(t, big.NewInt(40), gotEntries[0].Balance)
+
+	// when redelegated to an unknown validator
+	_, err = run(delegatorEVMAddr, staking.RedelegateMethod, false, validator, sdk.ValAddress(delegatorAddr).String(), big.NewInt(10))
+	require.Error(t, err)
+
+	// when called from staticcall
+	_, err = run(delegatorEVMAddr, staking.DelegateMethod, true, validator, big.NewInt(1))
+	require.Error(t, err)
+	_, err = run(delegatorEVMAddr, staking.WithdrawRewardsMethod, true, validator)
+	require.Error(t, err)
+
+	// when delegatecalled
+	balance := bankKeeper.GetBalance(ctx, delegatorAddr, bondDenom)
+	for methodName, args := range map[string][]interface{}{
+		staking.DelegateMethod:        {validator, big.NewInt(1)},
+		staking.UndelegateMethod:      {validator, big.NewInt(1)},
+		staking.RedelegateMethod:      {validator, validator, big.NewInt(1)},
+		staking.WithdrawRewardsMethod: {validator},
+	} {
+		method := staking.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		_, _, err = p.Run(delegateCallState{&evm}, delegatorEVMAddr, registry.StakingContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+		require.ErrorContains(t, err, "cannot delegatecall "+methodName)
+	}
+	assert.Equal(t, balance, bankKeeper.GetBalance(ctx, delegatorAddr, bondDenom))
+}
+
+func mustValAddress(t *testing.T, addr string) sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(addr)
+	require.NoError(t, err)
+	return valAddr
+}
+
+func TestDelegateCommit(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	// stake the EVM denom
+	stakingParams, err := tApp.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.BondDenom = appconfig.CosmosDenom
+	require.NoError(t, tApp.StakingKeeper.SetParams(ctx, stakingParams))
+	delegatorAddr, delegatorEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, delegatorAddr, delegatorEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1_000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, delegatorAddr, coins))
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	p := staking.NewContract(
+		tApp.EvmKeeper,
+		stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper),
+		stakingkeeper.NewQuerier(tApp.StakingKeeper),
+		distrkeeper.NewMsgServerImpl(tApp.DistrKeeper),
+		distrkeeper.NewQuerier(tApp.DistrKeeper),
+	)
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	// the EVM transaction bumps the delegator nonce so the delegator account is written back on commit
+	stateDB.SetNonce(delegatorEVMAddr, stateDB.GetNonce(delegatorEVMAddr)+1)
+	method := staking.ABI.Methods[staking.DelegateMethod]
+	input, err := method.Inputs.Pack(validators[0].GetOperator(), big.NewInt(100))
+	require.NoError(t, err)
+
+	// when
+	_, _, err = p.Run(&evm, delegatorEVMAddr, registry.StakingContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+
+	// then the delegation is kept once the EVM state is committed
+	assert.Equal(t, sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, delegatorAddr, appconfig.CosmosDenom).Amount)
+}
@@ -0,0 +1,806 @@
+package tokenfactory
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of tokenfactory contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	CreateDenomMethod       = "createDenom"
+	MintMethod              = "mint"
+	BurnMethod              = "burn"
+	ChangeAdminMethod       = "changeAdmin"
+	SetMetadataMethod       = "setMetadata"
+	ForceTransferMethod     = "forceTransfer"
+	AdminMethod             = "admin"
+	MetadataMethod          = "metadata"
+	DenomsFromCreatorMethod = "denomsFromCreator"
+
+	CreateDenomEvent   = "CreateDenom"
+	MintEvent          = "Mint"
+	BurnEvent          = "Burn"
+	ChangeAdminEvent   = "ChangeAdmin"
+	SetMetadataEvent   = "SetMetadata"
+	ForceTransferEvent = "ForceTransfer"
+)
+
+type DenomUnit struct {
+	Denom    string
+	Exponent uint32
+	Aliases  []string
+}
+
+type Metadata struct {
+	Description string
+	DenomUnits  []DenomUnit
+	Base        string
+	Display     string
+	Name        string
+	Symbol      string
+}
+
+type PrecompileExecutor struct {
+	evmKeeper          pcommon.EVMKeeper
+	bankKeeper         pcommon.BankKeeper
+	tokenFactoryKeeper pcommon.TokenFactoryKeeper
+	msgServer          tokenfactorytypes.MsgServer
+}
+
+// NewContract returns a new tokenfactory stateful precompiled contract.
+//
+//	The mutating functions are executed through the tokenfactory msg server with the cosmos address
+//	mapped to the EVM caller as sender, the same way the CosmWasm bindings execute them for contracts.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, tokenFactoryKeeper pcommon.TokenFactoryKeeper, msgServer tokenfactorytypes.MsgServer) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:          evmKeeper,
+		bankKeeper:         bankKeeper,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		msgServer:          msgServer,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[CreateDenomMethod].ID,
+			executor.createDenom,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[MintMethod].ID,
+			executor.mint,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[BurnMethod].ID,
+			executor.burn,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[ChangeAdminMethod].ID,
+			executor.changeAdmin,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[SetMetadataMethod].ID,
+			executor.setMetadata,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[ForceTransferMethod].ID,
+			executor.forceTransfer,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[AdminMethod].ID,
+			executor.admin,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[MetadataMethod].ID,
+			executor.metadata,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods[DenomsFromCreatorMethod].ID,
+			executor.denomsFromCreator,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate tokenfactory precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) createDenom(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[CreateDenomMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call createDenom from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall createDenom")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := tokenfactorytypes.NewMsgCreateDenom(senderCosmosAddr.String(), args[0].(string))
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	// the denom creation fee is paid out of the caller's balance
+	stateDB := accessibleState.GetStateDB()
+	ret, rerr = pcommon.RunInSnapshot(ctx, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+		resp, err := p.msgServer.CreateDenom(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, callingContract, ABI.Events[CreateDenomEvent], caller, resp.NewTokenDenom); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(resp.NewTokenDenom)
+	}))
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) mint(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[MintMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call mint from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall mint")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	amount := args[1].(*big.Int)
+	// mint to the caller when no recipient is given
+	toEvmAddr := args[2].(common.Address)
+	if toEvmAddr == (common.Address{}) {
+		toEvmAddr = caller
+	}
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	toCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, toEvmAddr)
+	msg := tokenfactorytypes.NewMsgMintTo(senderCosmosAddr.String(), sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)), toCosmosAddr.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.msgServer.Mint(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[MintEvent], toEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) burn(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[BurnMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call burn from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall burn")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	amount := args[1].(*big.Int)
+	coin := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	// burn from the caller when no address is given
+	fromEvmAddr := args[2].(common.Address)
+	msg := tokenfactorytypes.NewMsgBurn(senderCosmosAddr.String(), coin)
+	if fromEvmAddr == (common.Address{}) {
+		fromEvmAddr = caller
+	} else {
+		fromCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, fromEvmAddr)
+		msg = tokenfactorytypes.NewMsgBurnFrom(senderCosmosAddr.String(), coin, fromCosmosAddr.String())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.msgServer.Burn(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[BurnEvent], fromEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) changeAdmin(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[ChangeAdminMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call changeAdmin from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall changeAdmin")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	newAdminEvmAddr := args[1].(common.Address)
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	newAdminCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, newAdminEvmAddr)
+	msg := tokenfactorytypes.NewMsgChangeAdmin(senderCosmosAddr.String(), denom, newAdminCosmosAddr.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.msgServer.ChangeAdmin(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[ChangeAdminEvent], newAdminEvmAddr, denom); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) setMetadata(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[SetMetadataMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call setMetadata from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall setMetadata")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	metadata := abi.ConvertType(args[1], new(Metadata)).(*Metadata)
+	// bank uses the base field as key, fill it if missing
+	if metadata.Base == "" {
+		metadata.Base = denom
+	} else if metadata.Base != denom {
+		rerr = errors.New("base must be the same as denom")
+		return
+	}
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(senderCosmosAddr.String(), toSdkMetadata(*metadata))
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.msgServer.SetDenomMetadata(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[SetMetadataEvent], denom); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) forceTransfer(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[ForceTransferMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call forceTransfer from staticcall")
+		return
+	}
+	if pcommon.IsDelegateCall(accessibleState) {
+		rerr = errors.New("cannot delegatecall forceTransfer")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 4); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	amount := args[1].(*big.Int)
+	fromEvmAddr := args[2].(common.Address)
+	toEvmAddr := args[3].(common.Address)
+
+	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	fromCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, fromEvmAddr)
+	toCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, toEvmAddr)
+	msg := tokenfactorytypes.NewMsgForceTransfer(senderCosmosAddr.String(), sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)), fromCosmosAddr.String(), toCosmosAddr.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = pcommon.RunInSnapshot(ctx, func(ctx sdk.Context) ([]byte, error) {
+		if _, err := p.msgServer.ForceTransfer(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), callingContract, ABI.Events[ForceTransferEvent], fromEvmAddr, toEvmAddr, denom, amount); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	})
+	if rerr != nil {
+		return
+	}
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) admin(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying admin using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[AdminMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	authorityMetadata, err := p.tokenFactoryKeeper.GetAuthorityMetadata(ctx, args[0].(string))
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	// the zero address is returned for denoms without admin
+	var adminEvmAddr common.Address
+	if authorityMetadata.Admin != "" {
+		adminCosmosAddr, err := sdk.AccAddressFromBech32(authorityMetadata.Admin)
+		if err != nil {
+			rerr = err
+			return
+		}
+		adminEvmAddr = p.evmAddress(ctx, adminCosmosAddr)
+	}
+
+	ret, rerr = method.Outputs.Pack(adminEvmAddr)
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) metadata(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying metadata using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[MetadataMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	// empty metadata is returned for denoms without metadata
+	metadata, _ := p.bankKeeper.GetDenomMetaData(ctx, args[0].(string))
+
+	ret, rerr = method.Outputs.Pack(fromSdkMetadata(metadata))
+	remainingGas = suppliedGas
+	return
+}
+
+func (p PrecompileExecutor) denomsFromCreator(accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+
+	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
+	if rerr != nil {
+		return
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error querying denomsFromCreator using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DenomsFromCreatorMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	creatorCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	denoms := p.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creatorCosmosAddr.String())
+	if denoms == nil {
+		denoms = []string{}
+	}
+
+	ret, rerr = method.Outputs.Pack(denoms)
+	remainingGas = suppliedGas
+	return
+}
+
+// evmAddress returns the EVM address mapped to the cosmos address, or the EVM address with the same
+// bytes when there is no mapping, the reverse of the EVM keeper's GetCosmosAddressMapping.
+func (p PrecompileExecutor) evmAddress(ctx sdk.Context, cosmosAddr sdk.AccAddress) common.Address {
+	if evmAddr, err := p.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddr); err == nil {
+		return *evmAddr
+	}
+	return common.BytesToAddress(cosmosAddr)
+}
+
+func toSdkMetadata(metadata Metadata) banktypes.Metadata {
+	units := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		units = append(units, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+	return banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  units,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
+
+func fromSdkMetadata(metadata banktypes.Metadata) Metadata {
+	units := make([]DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		aliases := unit.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		units = append(units, DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  aliases,
+		})
+	}
+	return Metadata{
+		Description: metadata.Description,
+		DenomUnits:  units,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
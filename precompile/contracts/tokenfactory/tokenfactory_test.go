@@ -0,0 +1,233 @@
+package tokenfactory_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/tokenfactory"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// delegateCallState is the state of a precompile called with a delegatecall
+type delegateCallState struct {
+	*vm.EVM
+}
+
+func (delegateCallState) IsDelegateCall() bool {
+	return true
+}
+
+func TestTokenFactory(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	require.NoError(t, tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{EnabledCapabilities: tokenfactorytypes.AllCapabilities()}))
+	creatorAddr, creatorEVMAddr := MockAddressPair()
+	holderAddr, holderEVMAddr := MockAddressPair()
+	newAdminAddr, newAdminEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, creatorAddr, creatorEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, holderAddr, holderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, newAdminAddr, newAdminEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+
+	p := tokenfactory.NewContract(tApp.EvmKeeper, bankKeeper, tApp.TokenFactoryKeeper, tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper))
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	run := func(caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method := tokenfactory.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.TokenFactoryContractAddress, append(method.ID, input...), 10_000_000, readOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(res)
+	}
+	logs := func() int {
+		return len(evm.StateDB.(*statedb.StateDB).Logs())
+	}
+
+	// when created
+	output, err := run(creatorEVMAddr, tokenfactory.CreateDenomMethod, false, "token")
+	require.NoError(t, err)
+
+	// then
+	denom := "factory/" + creatorAddr.String() + "/token"
+	assert.Equal(t, []interface{}{denom}, output)
+	output, err = run(holderEVMAddr, tokenfactory.AdminMethod, true, denom)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{creatorEVMAddr}, output)
+	output, err = run(holderEVMAddr, tokenfactory.DenomsFromCreatorMethod, true, creatorEVMAddr)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]string{denom}}, output)
+	assert.Equal(t, 1, logs())
+
+	// when minted
+	_, err = run(creatorEVMAddr, tokenfactory.MintMethod, false, denom, big.NewInt(100), holderEVMAddr)
+	require.NoError(t, err)
+	_, err = run(creatorEVMAddr, tokenfactory.MintMethod, false, denom, big.NewInt(10), common.Address{})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, holderAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, creatorAddr, denom).Amount)
+	assert.Equal(t, 3, logs())
+
+	// when burned
+	_, err = run(creatorEVMAddr, tokenfactory.BurnMethod, false, denom, big.NewInt(30), holderEVMAddr)
+	require.NoError(t, err)
+	_, err = run(creatorEVMAddr, tokenfactory.BurnMethod, false, denom, big.NewInt(5), common.Address{})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(70), bankKeeper.GetBalance(ctx, holderAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(5), bankKeeper.GetBalance(ctx, creatorAddr, denom).Amount)
+
+	// when force transferred
+	_, err = run(creatorEVMAddr, tokenfactory.ForceTransferMethod, false, denom, big.NewInt(20), holderEVMAddr, creatorEVMAddr)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, sdkmath.NewInt(50), bankKeeper.GetBalance(ctx, holderAddr, denom).Amount)
+	assert.Equal(t, sdkmath.NewInt(25), bankKeeper.GetBalance(ctx, creatorAddr, denom).Amount)
+
+	// when metadata set
+	metadata := tokenfactory.Metadata{
+		DenomUnits: []tokenfactory.DenomUnit{{Denom: denom, Aliases: []string{}}, {Denom: "token", Exponent: 6, Aliases: []string{}}},
+		Display:    "token",
+		Name:       "Token",
+		Symbol:     "TKN",
+	}
+	_, err = run(creatorEVMAddr, tokenfactory.SetMetadataMethod, false, denom, metadata)
+	require.NoError(t, err)
+
+	// then
+	output, err = run(holderEVMAddr, tokenfactory.MetadataMethod, true, denom)
+	require.NoError(t, err)
+	metadata.Base = denom
+	assert.Equal(t, &metadata, abi.ConvertType(output[0], new(tokenfactory.Metadata)))
+
+	// when admin changed
+	_, err = run(creatorEVMAddr, tokenfactory.ChangeAdminMethod, false, denom, newAdminEVMAddr)
+	require.NoError(t, err)
+
+	// then the previous admin is rejected
+	output, err = run(holderEVMAddr, tokenfactory.AdminMethod, true, denom)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{newAdminEVMAddr}, output)
+	_, err = run(creatorEVMAddr, tokenfactory.MintMethod, false, denom, big.NewInt(1), common.Address{})
+	require.ErrorContains(t, err, tokenfactorytypes.ErrUnauthorized.Error())
+	_, err = run(newAdminEVMAddr, tokenfactory.MintMethod, false, denom, big.NewInt(1), common.Address{})
+	require.NoError(t, err)
+
+	// when called from staticcall
+	_, err = run(newAdminEVMAddr, tokenfactory.MintMethod, true, denom, big.NewInt(1), common.Address{})
+	require.Error(t, err)
+	_, err = run(holderEVMAddr, tokenfactory.CreateDenomMethod, true, "other")
+	require.Error(t, err)
+
+	// when delegatecalled
+	for methodName, args := range map[string][]interface{}{
+		tokenfactory.CreateDenomMethod:   {"other"},
+		tokenfactory.MintMethod:          {denom, big.NewInt(1), common.Address{}},
+		tokenfactory.BurnMethod:          {denom, big.NewInt(1), common.Address{}},
+		tokenfactory.ForceTransferMethod: {denom, big.NewInt(1), holderEVMAddr, newAdminEVMAddr},
+		tokenfactory.SetMetadataMethod:   {denom, metadata},
+		tokenfactory.ChangeAdminMethod:   {denom, holderEVMAddr},
+	} {
+		method := tokenfactory.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		require.NoError(t, err)
+		_, _, err = p.Run(delegateCallState{&evm}, newAdminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+		require.ErrorContains(t, err, "cannot delegatecall "+methodName)
+	}
+	output, err = run(holderEVMAddr, tokenfactory.AdminMethod, true, denom)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{newAdminEVMAddr}, output)
+	assert.Equal(t, sdkmath.NewInt(50), bankKeeper.GetBalance(ctx, holderAddr, denom).Amount)
+}
+
+func TestCreateDenomFee(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	require.NoError(t, tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{
+		DenomCreationFee:    sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(10))),
+		EnabledCapabilities: tokenfactorytypes.AllCapabilities(),
+	}))
+	creatorAddr, creatorEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, creatorAddr, creatorEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, creatorAddr, coins))
+
+	p := tokenfactory.NewContract(tApp.EvmKeeper, bankKeeper, tApp.TokenFactoryKeeper, tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper))
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	// the EVM transaction bumps the creator nonce so the creator account is written back on commit
+	stateDB.SetNonce(creatorEVMAddr, stateDB.GetNonce(creatorEVMAddr)+1)
+	method := tokenfactory.ABI.Methods[tokenfactory.CreateDenomMethod]
+	input, err := method.Inputs.Pack("fee")
+	require.NoError(t, err)
+
+	// when
+	_, _, err = p.Run(&evm, creatorEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, input...), 10_000_000, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+
+	// then the fee is kept once the EVM state is committed
+	assert.Equal(t, sdkmath.NewInt(90), bankKeeper.GetBalance(ctx, creatorAddr, appconfig.CosmosDenom).Amount)
+}
@@ -1,17 +1,23 @@
 package wasmd
 
 import (
-	"bytes"
 	_ "embed"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/precompile/contract"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 )
 
 // Singleton StatefulPrecompiledContract.
@@ -27,153 +33,234 @@ type PrecompileExecutor struct {
 	wasmdKeeper     pcommon.WasmdKeeper
 	wasmdViewKeeper pcommon.WasmdViewKeeper
 	evmKeeper       pcommon.EVMKeeper
+	wasmEventLogs   bool
 }
 
 func (p PrecompileExecutor) instantiateCosmWasm(
 	accessibleState contract.AccessibleState,
 	caller common.Address,
-	callingContract common.Address,
+	addr common.Address,
 	packedInput []byte,
 	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error instantiating cosmwasm using precompile: ", rerr.Error())
-			return
-		}
-	}()
-	if readOnly {
-		rerr = errors.New("cannot call instantiate from staticcall")
-		return
-	}
-
-	if !bytes.Equal(caller.Bytes(), callingContract.Bytes()) {
-		rerr = errors.New("cannot delegatecall instantiate")
-		return
-	}
-
+) ([]byte, uint64, error) {
 	method := ABI.Methods["instantiate"]
+	opts := pcommon.MethodOptions{Mutating: true, Payable: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		codeID := args[0].(uint64)
+		admin := args[1].(string)
+		msg := args[2].([]byte)
+		label := args[3].(string)
+
+		stateDB := accessibleState.GetStateDB()
+		deposit, err := p.deposit(stateDB, caller, addr, args[4], value)
+		if err != nil {
+			return pcommon.RevertReason(err.Error()), suppliedGas, vm.ErrExecutionReverted
+		}
 
-	res, err := method.Inputs.Unpack(packedInput)
-	if err != nil {
-		rerr = err
-		return
-	}
-
-	codeID := res[0].(uint64)
-	admin := res[1].(string)
-	msg := res[2].([]byte)
-	label := res[3].(string)
-	funds := res[4].([]byte)
-
-	// unmarshal funds
-	deposit := UnmarshalCosmWasmDeposit(funds)
-
-	creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
-
-	adminAddr, err := sdk.AccAddressFromBech32(admin)
-	if err != nil {
-		rerr = err
-		return
-	}
-
-	addr, data, err := p.wasmdKeeper.Instantiate(ctx, codeID, creator, adminAddr, msg, label, deposit)
-	if err != nil {
-		rerr = err
-		return
-	}
-
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
-
-	ret, rerr = method.Outputs.Pack(addr.String(), data)
+		creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
+		adminAddr, err := sdk.AccAddressFromBech32(admin)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	return
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+			em := sdk.NewEventManager()
+			contractAddr, data, err := p.wasmdKeeper.Instantiate(ctx.WithEventManager(em), codeID, creator, adminAddr, msg, label, deposit)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.emitEvents(ctx, stateDB, addr, em.Events()); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, addr, ABI.Events["Instantiated"], contractAddr.String(), caller, codeID); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(contractAddr.String(), data)
+		}))
+	})
 }
 
 func (p PrecompileExecutor) executeCosmWasm(
 	accessibleState contract.AccessibleState,
 	caller common.Address,
-	callingContract common.Address,
+	addr common.Address,
 	packedInput []byte,
 	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error executing cosmwasm using precompile: ", rerr.Error())
-			return
-		}
-	}()
-	if readOnly {
-		rerr = errors.New("cannot call execute from staticcall")
-		return
-	}
-
+) ([]byte, uint64, error) {
 	method := ABI.Methods["execute"]
+	opts := pcommon.MethodOptions{Mutating: true, Payable: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+		msg := args[1].([]byte)
+
+		stateDB := accessibleState.GetStateDB()
+		deposit, err := p.deposit(stateDB, caller, addr, args[2], value)
+		if err != nil {
+			return pcommon.RevertReason(err.Error()), suppliedGas, vm.ErrExecutionReverted
+		}
 
-	res, err := method.Inputs.Unpack(packedInput)
-	if err != nil {
-		rerr = err
-		return
-	}
-
-	contractAddress := res[0].(string)
-	msg := res[1].([]byte)
-	funds := res[2].([]byte)
+		senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
-	// unmarshal funds
-	deposit := UnmarshalCosmWasmDeposit(funds)
+		// addresses will be sent in Cosmos format
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+			em := sdk.NewEventManager()
+			exeRes, err := p.wasmdKeeper.Execute(ctx.WithEventManager(em), contractAddr, senderAddr, msg, deposit)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.emitEvents(ctx, stateDB, addr, em.Events()); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, addr, ABI.Events["Executed"], contractAddress, caller, crypto.Keccak256Hash(exeRes)); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(exeRes)
+		}))
+	})
+}
 
-	// addresses will be sent in Cosmos format
-	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
-	if err != nil {
-		rerr = err
-		return
-	}
+func (p PrecompileExecutor) instantiate2CosmWasm(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["instantiate2"]
+	opts := pcommon.MethodOptions{Mutating: true, Payable: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		codeID := args[0].(uint64)
+		admin := args[1].(string)
+		msg := args[2].([]byte)
+		label := args[3].(string)
+		salt := args[4].([]byte)
+		fixMsg := args[5].(bool)
+
+		stateDB := accessibleState.GetStateDB()
+		deposit, err := p.deposit(stateDB, caller, addr, args[6], value)
+		if err != nil {
+			return pcommon.RevertReason(err.Error()), suppliedGas, vm.ErrExecutionReverted
+		}
 
-	exeRes, err := p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, deposit)
+		creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
-	if err != nil {
-		rerr = err
-		return
-	}
+		// an empty admin instantiates the contract without admin
+		var adminAddr sdk.AccAddress
+		if admin != "" {
+			if adminAddr, err = sdk.AccAddressFromBech32(admin); err != nil {
+				return nil, 0, err
+			}
+		}
 
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+			em := sdk.NewEventManager()
+			contractAddr, data, err := p.wasmdKeeper.Instantiate2(ctx.WithEventManager(em), codeID, creator, adminAddr, msg, label, deposit, salt, fixMsg)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.emitEvents(ctx, stateDB, addr, em.Events()); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, addr, ABI.Events["Instantiated"], contractAddr.String(), caller, codeID); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(contractAddr.String(), data)
+		}))
+	})
+}
 
-	ret, rerr = method.Outputs.Pack(exeRes)
+func (p PrecompileExecutor) migrateCosmWasm(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["migrate"]
+	opts := pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+		newCodeID := args[1].(uint64)
+		msg := args[2].([]byte)
+
+		senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
+		stateDB := accessibleState.GetStateDB()
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, pcommon.SyncBalances(p.evmKeeper, stateDB, []common.Address{caller}, func(ctx sdk.Context) ([]byte, error) {
+			em := sdk.NewEventManager()
+			data, err := p.wasmdKeeper.Migrate(ctx.WithEventManager(em), contractAddr, senderAddr, newCodeID, msg)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.emitEvents(ctx, stateDB, addr, em.Events()); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, stateDB, addr, ABI.Events["Migrated"], contractAddress, caller, newCodeID); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(data)
+		}))
+	})
+}
 
-	return
+func (p PrecompileExecutor) updateAdmin(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["updateAdmin"]
+	opts := pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+		newAdmin := args[1].(string)
+
+		senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
+		newAdminAddr, err := sdk.AccAddressFromBech32(newAdmin)
+		if err != nil {
+			return nil, 0, err
+		}
 
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			if err := p.wasmdKeeper.UpdateContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), addr, ABI.Events["AdminUpdated"], contractAddress, caller, newAdmin); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(true)
+		})
+	})
 }
 
-func (p PrecompileExecutor) queryCosmWasm(
+func (p PrecompileExecutor) clearAdmin(
 	accessibleState contract.AccessibleState,
 	caller common.Address,
 	addr common.Address,
@@ -181,61 +268,177 @@ func (p PrecompileExecutor) queryCosmWasm(
 	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
+) ([]byte, uint64, error) {
+	method := ABI.Methods["clearAdmin"]
+	opts := pcommon.MethodOptions{Mutating: true, RejectDelegateCall: true}
+	return pcommon.RunMethod(accessibleState, method, opts, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
 
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+		senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
-	defer func() {
-		if err := recover(); err != nil {
-			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s", err)
-			fmt.Println("rerr: ", rerr)
-			ctx.Logger().Error("Error querying cosmwasm using precompile: ", rerr.Error())
-			return
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
 		}
-	}()
 
-	if value != nil && value.Sign() != 0 {
-		rerr = errors.New("sending funds to a non-payable function")
-		return
-	}
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			if err := p.wasmdKeeper.ClearContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+				return nil, err
+			}
+			if err := pcommon.EmitLog(ctx, accessibleState.GetStateDB(), addr, ABI.Events["AdminUpdated"], contractAddress, caller, ""); err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(true)
+		})
+	})
+}
 
-	method := ABI.Methods["query"]
+// Attribute is the ABI representation of a CosmWasm event attribute
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
 
-	res, err := method.Inputs.Unpack(packedInput)
-	if err != nil {
-		rerr = err
-		return
+// emitEvents emits the events of a CosmWasm call on the context. The events emitted by contracts are
+// translated into EVM logs as well when enabled.
+func (p PrecompileExecutor) emitEvents(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, events sdk.Events) error {
+	ctx.EventManager().EmitEvents(events)
+	if !p.wasmEventLogs {
+		return nil
 	}
+	for _, e := range events {
+		if e.Type != wasmtypes.WasmModuleEventType && !strings.HasPrefix(e.Type, wasmtypes.CustomContractEventPrefix) {
+			continue
+		}
+		attrs := make([]Attribute, len(e.Attributes))
+		for i, a := range e.Attributes {
+			attrs[i] = Attribute{Key: a.Key, Value: a.Value}
+		}
+		if err := pcommon.EmitLog(ctx, stateDB, addr, ABI.Events["CosmWasmEvent"], e.Type, attrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	contractAddress := res[0].(string)
-	req := res[1].([]byte)
-
-	// addresses will be sent in Cosmos format
-	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+// deposit returns the funds to send along with a CosmWasm call. The EVM value transferred to the
+// precompile address is given back to the caller in the EVM state and forwarded in the Cosmos denom
+// instead. The call is run with the caller's balance synced so that the EVM state matches the bank
+// balances when it is committed.
+func (p PrecompileExecutor) deposit(
+	stateDB contract.StateDB,
+	caller common.Address,
+	addr common.Address,
+	funds interface{},
+	value *big.Int,
+) (sdk.Coins, error) {
+	coins := *abi.ConvertType(funds, new([]Coin)).(*[]Coin)
+	deposit, err := ParseFunds(coins, nil)
 	if err != nil {
-		rerr = err
-		return
+		return nil, err
 	}
-
-	queryRes, err := p.wasmdViewKeeper.QuerySmart(ctx, contractAddr, req)
+	if amount := deposit.AmountOf(appconfig.CosmosDenom); amount.IsPositive() {
+		evmAmount := amount.Mul(evmkeeper.ConversionMultiplier).BigInt()
+		if stateDB.GetBalance(caller).Cmp(evmAmount) < 0 {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrapf("%s%s", amount, appconfig.CosmosDenom)
+		}
+	}
+	if value == nil || value.Sign() == 0 {
+		return deposit, nil
+	}
+	valueCoin, err := convertEVMValue(value)
 	if err != nil {
-		rerr = err
-		return
+		return nil, err
+	}
+	if stateDB.GetBalance(addr).Cmp(value) < 0 {
+		return nil, errors.New("value not transferred to the precompile")
 	}
+	stateDB.SubBalance(addr, value)
+	stateDB.AddBalance(caller, value)
+	return deposit.Add(valueCoin), nil
+}
 
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
+func (p PrecompileExecutor) queryCosmWasm(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["query"]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+		req := args[1].([]byte)
+
+		// addresses will be sent in Cosmos format
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	ret, rerr = method.Outputs.Pack(queryRes)
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			queryRes, err := p.wasmdViewKeeper.QuerySmart(ctx, contractAddr, req)
+			if err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(queryRes)
+		})
+	})
+}
 
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
+func (p PrecompileExecutor) rawQueryCosmWasm(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["rawQuery"]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+		key := args[1].([]byte)
+
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	return
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			return method.Outputs.Pack(p.wasmdViewKeeper.QueryRaw(ctx, contractAddr, key))
+		})
+	})
+}
 
+func (p PrecompileExecutor) contractInfo(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) ([]byte, uint64, error) {
+	method := ABI.Methods["contractInfo"]
+	return pcommon.RunMethod(accessibleState, method, pcommon.MethodOptions{}, packedInput, readOnly, value, func(ctx sdk.Context, args []interface{}) ([]byte, uint64, error) {
+		contractAddress := args[0].(string)
+
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return pcommon.RunWithGasLimit(ctx, suppliedGas, func(ctx sdk.Context) ([]byte, error) {
+			info := p.wasmdViewKeeper.GetContractInfo(ctx, contractAddr)
+			if info == nil {
+				return nil, wasmtypes.ErrNoSuchContractFn(contractAddress)
+			}
+			return method.Outputs.Pack(info.CodeID, info.Creator, info.Admin, info.Label)
+		})
+	})
 }
 
 // NewContract returns a new wasmd stateful precompiled contract.
@@ -244,13 +447,20 @@ func (p PrecompileExecutor) queryCosmWasm(
 //	The functions of this contract (once implemented), will be used to exercise and test the various aspects of
 //	the EVM such as gas usage, argument parsing, events, etc. The specific operations tested under this contract are
 //	still to be determined.
-func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContract {
-
+func NewContract(
+	wasmdKeeper pcommon.WasmdKeeper,
+	wasmdViewKeeper pcommon.WasmdViewKeeper,
+	evmKeeper pcommon.EVMKeeper,
+	opts ...Option,
+) contract.StatefulPrecompiledContract {
 	executor := &PrecompileExecutor{
 		wasmdKeeper:     wasmdKeeper,
 		wasmdViewKeeper: wasmdViewKeeper,
 		evmKeeper:       evmKeeper,
 	}
+	for _, o := range opts {
+		o.apply(executor)
+	}
 
 	functions := []*contract.StatefulPrecompileFunction{
 		contract.NewStatefulPrecompileFunction(
@@ -265,6 +475,30 @@ func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdV
 			ABI.Methods["query"].ID,
 			executor.queryCosmWasm,
 		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["instantiate2"].ID,
+			executor.instantiate2CosmWasm,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["migrate"].ID,
+			executor.migrateCosmWasm,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["updateAdmin"].ID,
+			executor.updateAdmin,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["clearAdmin"].ID,
+			executor.clearAdmin,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["rawQuery"].ID,
+			executor.rawQueryCosmWasm,
+		),
+		contract.NewStatefulPrecompileFunction(
+			ABI.Methods["contractInfo"].ID,
+			executor.contractInfo,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -276,13 +510,3 @@ func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdV
 
 	return precompile
 }
-
-func UnmarshalCosmWasmDeposit(coins []byte) sdk.Coins {
-	// unmarshal coins
-	var deposit sdk.Coins
-	err := json.Unmarshal(coins, &deposit)
-	if err != nil {
-		return sdk.NewCoins()
-	}
-	return deposit
-}
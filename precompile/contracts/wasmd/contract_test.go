@@ -5,25 +5,37 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"os"
 	"testing"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/CosmWasm/wasmd/precompile/contracts/wasmd"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/go-bip39"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/precompile/modules"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/assert"
@@ -42,6 +54,30 @@ func (m *MockWasmer) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, ca
 	return []byte("Execute"), nil
 }
 
+func (m *MockWasmer) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error) {
+	return m.Instantiate(ctx, codeID, creator, admin, initMsg, label, deposit)
+}
+
+func (m *MockWasmer) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error) {
+	return []byte("Migrate"), nil
+}
+
+func (m *MockWasmer) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error {
+	return nil
+}
+
+func (m *MockWasmer) ClearContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return nil
+}
+
+func (m *MockWasmer) QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	return []byte("QueryRaw")
+}
+
+func (m *MockWasmer) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return nil
+}
+
 func (m *MockWasmer) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	return []byte("QuerySmart"), nil
 }
@@ -75,23 +111,69 @@ func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.
 	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
 }
 
-func TestUnmarshalCosmWasmDeposit(t *testing.T) {
-	deposit := wasmd.UnmarshalCosmWasmDeposit([]byte("[]"))
-	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("foobar"))
-	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("{}"))
-	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("[{\"denom\":\"ukava\",\"amount\":\"10\"}, {\"denom\":\"orai\",\"amount\":\"100\"}]"))
-	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10)), sdk.NewCoin("orai", sdkmath.NewInt(100)))
-	for _, coin := range coins {
-		if coin.Denom == "orai" {
-			require.Equal(t, coin.Amount, sdkmath.NewInt(100))
-		} else if coin.Denom == "ukava" {
-			require.Equal(t, coin.Amount, sdkmath.NewInt(10))
-		} else {
-			panic("Wrong Unmarshal")
-		}
+func TestParseFunds(t *testing.T) {
+	oneOrai := new(big.Int).Mul(big.NewInt(1), evmkeeper.ConversionMultiplier.BigInt())
+	specs := map[string]struct {
+		funds      []wasmd.Coin
+		value      *big.Int
+		expDeposit sdk.Coins
+		expErr     bool
+	}{
+		"empty": {
+			expDeposit: sdk.NewCoins(),
+		},
+		"multiple denoms": {
+			funds:      []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(10)}, {Denom: "orai", Amount: big.NewInt(100)}},
+			expDeposit: sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10)), sdk.NewCoin("orai", sdkmath.NewInt(100))),
+		},
+		"value converted to cosmos denom": {
+			value:      new(big.Int).Mul(oneOrai, big.NewInt(5)),
+			expDeposit: sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(5))),
+		},
+		"value added to funds": {
+			funds:      []wasmd.Coin{{Denom: appconfig.CosmosDenom, Amount: big.NewInt(1)}},
+			value:      oneOrai,
+			expDeposit: sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(2))),
+		},
+		"value not convertible": {
+			value:  big.NewInt(1),
+			expErr: true,
+		},
+		"zero amount": {
+			funds:  []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(0)}},
+			expErr: true,
+		},
+		"negative amount": {
+			funds:  []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(-1)}},
+			expErr: true,
+		},
+		"nil amount": {
+			funds:  []wasmd.Coin{{Denom: "ukava"}},
+			expErr: true,
+		},
+		"amount overflows": {
+			funds:  []wasmd.Coin{{Denom: "ukava", Amount: new(big.Int).Lsh(big.NewInt(1), 256)}},
+			expErr: true,
+		},
+		"invalid denom": {
+			funds:  []wasmd.Coin{{Denom: "1", Amount: big.NewInt(1)}},
+			expErr: true,
+		},
+		"duplicate denoms": {
+			funds:  []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(1)}, {Denom: "ukava", Amount: big.NewInt(1)}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			deposit, err := wasmd.ParseFunds(spec.funds, spec.value)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expDeposit.String(), deposit.String())
+		})
 	}
 }
 
@@ -108,6 +190,9 @@ func TestExecuteAndQuery(t *testing.T) {
 	tApp := app.Setup(t)
 	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
 	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
 	mockAddr, mockEVMAddr := MockAddressPair()
 	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
 	sdk.RegisterDenom("ukava", sdkmath.LegacyNewDec(6))
@@ -133,7 +218,7 @@ func TestExecuteAndQuery(t *testing.T) {
 
 	instantiateMethod := wasmd.ABI.Methods["instantiate"]
 
-	args, err := instantiateMethod.Inputs.Pack(codeID, mockAddr.String(), []byte("{}"), "test", []byte("foo"))
+	args, err := instantiateMethod.Inputs.Pack(codeID, mockAddr.String(), []byte("{}"), "test", []wasmd.Coin{})
 	require.Nil(t, err)
 	res, suppliedGas, err := p.Contract.Run(&evm, registry.WasmdContractAddress, registry.WasmdContractAddress,
 		append(instantiateMethod.ID, args...),
@@ -151,8 +236,7 @@ func TestExecuteAndQuery(t *testing.T) {
 	err = tApp.GetBankKeeper().IsSendEnabledCoins(ctx, funds...)
 	require.Nil(t, err)
 
-	fundsBz, _ := json.Marshal(funds)
-	args, err = executeMethod.Inputs.Pack(cosmwasmAddr, []byte("{\"echo\":{\"message\":\"test msg\"}}"), fundsBz)
+	args, err = executeMethod.Inputs.Pack(cosmwasmAddr, []byte("{\"echo\":{\"message\":\"test msg\"}}"), []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(10)}})
 	require.Nil(t, err)
 
 	res, suppliedGas, err = p.Contract.Run(&evm, mockEVMAddr, registry.WasmdContractAddress,
@@ -187,3 +271,643 @@ func TestExecuteAndQuery(t *testing.T) {
 	response = rets[0].([]byte)
 	require.Equal(t, base64.StdEncoding.EncodeToString(response), "eyJtZXNzYWdlIjoicXVlcnkgdGVzdCJ9")
 }
+
+type gasConsumingWasmer struct {
+	MockWasmer
+	gas uint64
+}
+
+func (m *gasConsumingWasmer) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.gas, "execute")
+	return []byte("Execute"), nil
+}
+
+type mockEVMKeeper struct {
+	pcommon.EVMKeeper
+}
+
+func (m mockEVMKeeper) GetCosmosAddressMapping(ctx sdk.Context, evmAddress common.Address) sdk.AccAddress {
+	return evmAddress.Bytes()
+}
+
+func (m mockEVMKeeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+// mockStateKeeper loads every account of the StateDB as an empty account
+type mockStateKeeper struct {
+	statedb.Keeper
+}
+
+func (m mockStateKeeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	return nil
+}
+
+func TestExecuteGasAccounting(t *testing.T) {
+	contractAddr := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	executeMethod := wasmd.ABI.Methods["execute"]
+	args, err := executeMethod.Inputs.Pack(contractAddr.String(), []byte(`{}`), []wasmd.Coin{})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		multiplier   string
+		consume      uint64
+		suppliedGas  uint64
+		expRemaining uint64
+		expErr       error
+	}{
+		"charges gas used by the call": {
+			multiplier:   "1",
+			consume:      1_000,
+			suppliedGas:  10_000,
+			expRemaining: 9_000,
+		},
+		"charges converted gas": {
+			multiplier:   "0.1",
+			consume:      10_000,
+			suppliedGas:  10_000,
+			expRemaining: 9_000,
+		},
+		"out of gas reverts": {
+			multiplier:  "1",
+			consume:     10_001,
+			suppliedGas: 10_000,
+			expErr:      vm.ErrExecutionReverted,
+		},
+		"out of converted gas reverts": {
+			multiplier:  "0.1",
+			consume:     100_001,
+			suppliedGas: 10_000,
+			expErr:      vm.ErrExecutionReverted,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// parent context already consumed gas that must not be charged again
+			ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+			ctx.GasMeter().ConsumeGas(5_000, "testing")
+			evm := vm.EVM{StateDB: statedb.New(ctx, mockStateKeeper{}, statedb.NewEmptyTxConfig(common.Hash{}))}
+			params := wasmtypes.DefaultParams()
+			params.PrecompileGas = wasmtypes.PrecompileGasSchedule{
+				Default: &wasmtypes.PrecompileGasCost{SDKGasMultiplier: sdkmath.LegacyMustNewDecFromStr(spec.multiplier)},
+			}
+
+			wasmer := &gasConsumingWasmer{gas: spec.consume}
+			p := pcommon.WithGasSchedule(registry.WasmdContractAddress, wasmd.ABI, wasmd.NewContract(wasmer, wasmer, mockEVMKeeper{}), mockPrecompileGasKeeper{params: params})
+
+			// when
+			_, remaining, err := p.Run(&evm, common.Address{}, registry.WasmdContractAddress, append(executeMethod.ID, args...), spec.suppliedGas, false, nil)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				assert.Equal(t, uint64(0), remaining)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRemaining, remaining)
+		})
+	}
+}
+
+type mockPrecompileGasKeeper struct {
+	params wasmtypes.Params
+}
+
+func (m mockPrecompileGasKeeper) GetParams(context.Context) wasmtypes.Params {
+	return m.params
+}
+
+type eventEmittingWasmer struct {
+	MockWasmer
+}
+
+func (m *eventEmittingWasmer) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(wasmtypes.EventTypeExecute, sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddress.String())),
+		sdk.NewEvent(wasmtypes.WasmModuleEventType, sdk.NewAttribute("action", "foo")),
+		sdk.NewEvent(wasmtypes.CustomContractEventPrefix+"bar", sdk.NewAttribute("key", "value")),
+	})
+	return []byte("Execute"), nil
+}
+
+func TestExecuteLogs(t *testing.T) {
+	contractAddr := sdk.AccAddress(make([]byte, wasmtypes.ContractAddrLen))
+	caller := common.HexToAddress("0x1000000000000000000000000000000000000005")
+	executeMethod := wasmd.ABI.Methods["execute"]
+	args, err := executeMethod.Inputs.Pack(contractAddr.String(), []byte(`{}`), []wasmd.Coin{})
+	require.NoError(t, err)
+
+	executedTopics := []common.Hash{wasmd.ABI.Events["Executed"].ID, common.BytesToHash(caller.Bytes())}
+	wasmEventTopics := func(eventType string) []common.Hash {
+		return []common.Hash{wasmd.ABI.Events["CosmWasmEvent"].ID, crypto.Keccak256Hash([]byte(eventType))}
+	}
+	specs := map[string]struct {
+		opts      []wasmd.Option
+		expTopics [][]common.Hash
+	}{
+		"executed log only": {
+			expTopics: [][]common.Hash{executedTopics},
+		},
+		"with wasm event logs": {
+			opts: []wasmd.Option{wasmd.WithWasmEventLogs()},
+			expTopics: [][]common.Hash{
+				wasmEventTopics(wasmtypes.WasmModuleEventType),
+				wasmEventTopics(wasmtypes.CustomContractEventPrefix + "bar"),
+				executedTopics,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+			stateDB := statedb.New(ctx, mockStateKeeper{}, statedb.NewEmptyTxConfig(common.Hash{}))
+			evm := vm.EVM{StateDB: stateDB}
+			wasmer := &eventEmittingWasmer{}
+			p := wasmd.NewContract(wasmer, wasmer, mockEVMKeeper{}, spec.opts...)
+
+			// when
+			_, _, err := p.Run(&evm, caller, registry.WasmdContractAddress, append(executeMethod.ID, args...), 10_000_000, false, nil)
+
+			// then
+			require.NoError(t, err)
+			logs := stateDB.Logs()
+			require.Len(t, logs, len(spec.expTopics))
+			for i, exp := range spec.expTopics {
+				assert.Equal(t, registry.WasmdContractAddress, logs[i].Address)
+				assert.Equal(t, exp, logs[i].Topics)
+			}
+			// the executed log carries the contract and the hash of the response data
+			data, err := wasmd.ABI.Events["Executed"].Inputs.NonIndexed().Unpack(logs[len(logs)-1].Data)
+			require.NoError(t, err)
+			assert.Equal(t, []interface{}{contractAddr.String(), [32]byte(crypto.Keccak256Hash([]byte("Execute")))}, data)
+			// and the cosmos events are still emitted
+			assert.Len(t, stateDB.Ctx().EventManager().Events(), 3)
+		})
+	}
+}
+
+// tryCallCode returns the bytecode of a contract forwarding its calldata to the given precompile and
+// ignoring a failure, like `try precompile.call(msg.data) {} catch {}` in solidity.
+func tryCallCode(precompile common.Address) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, precompile.Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP), byte(vm.STOP))
+}
+
+func TestNestedRevert(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	mockAddr, _ := MockAddressPair()
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.NoError(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.NoError(t, err)
+	cosmwasmAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, mockAddr, []byte("{}"), "test", nil)
+	require.NoError(t, err)
+
+	// register a precompile bound to this app as the global registry keeps the first registration
+	precompileAddr := common.HexToAddress("0x9000000000000000000000000000000000001001")
+	_ = modules.RegisterModule(modules.Module{
+		Address:  precompileAddr,
+		Contract: wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper),
+	})
+
+	callerContract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	wrapperContract := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	amts := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)))
+	for _, c := range []common.Address{callerContract, wrapperContract} {
+		require.NoError(t, tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts))
+		require.NoError(t, tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, c), amts))
+	}
+
+	funds := []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(10)}}
+	executeMethod := wasmd.ABI.Methods["execute"]
+
+	specs := map[string]struct {
+		msg        []byte
+		viaWrapper bool
+		expBalance sdkmath.Int
+	}{
+		"precompile call succeeds": {
+			msg:        []byte(`{"echo":{"message":"test msg"}}`),
+			expBalance: sdkmath.NewInt(990),
+		},
+		"precompile call reverts after funds were sent": {
+			msg:        []byte(`{"unknown":{}}`),
+			expBalance: sdkmath.NewInt(1000),
+		},
+		"precompile call succeeds in a wrapper call that reverts": {
+			msg:        []byte(`{"echo":{"message":"test msg"}}`),
+			viaWrapper: true,
+			expBalance: sdkmath.NewInt(1000),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			payer := callerContract
+			stateDB.SetCode(callerContract, tryCallCode(precompileAddr))
+			if spec.viaWrapper {
+				// try wrapper.call(msg.data) {} catch {}, where the wrapper calls the precompile and reverts
+				payer = wrapperContract
+				stateDB.SetCode(callerContract, tryCallCode(wrapperContract))
+				stateDB.SetCode(wrapperContract, callAndRevertCode(precompileAddr))
+			}
+			payerCosmosAddr := tApp.EvmKeeper.GetCosmosAddressMapping(ctx, payer)
+			blockCtx := vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				BlockNumber: big.NewInt(ctx.BlockHeight()),
+				Time:        big.NewInt(ctx.BlockTime().Unix()),
+				Difficulty:  big.NewInt(0),
+			}
+			evm := pcommon.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, stateDB, params.TestChainConfig, vm.Config{}, nil)
+
+			args, err := executeMethod.Inputs.Pack(cosmwasmAddr.String(), spec.msg, funds)
+			require.NoError(t, err)
+
+			// when
+			_, _, err = evm.Call(vm.AccountRef(common.Address{}), callerContract, append(executeMethod.ID, args...), 10_000_000, big.NewInt(0))
+
+			// then the outer call succeeds and a reverted call frame leaves no cosmos state changes
+			require.NoError(t, err)
+			balance := tApp.GetBankKeeper().GetBalance(ctx, payerCosmosAddr, "ukava")
+			assert.Equal(t, spec.expBalance.String(), balance.Amount.String())
+			contractBalance := tApp.GetBankKeeper().GetBalance(ctx, cosmwasmAddr, "ukava")
+			assert.Equal(t, sdkmath.NewInt(1000).Sub(spec.expBalance).String(), contractBalance.Amount.String())
+		})
+	}
+}
+
+// callAndRevertCode returns the bytecode of a contract forwarding its calldata to the given precompile
+// and reverting afterwards, whatever the result of the call.
+func callAndRevertCode(precompile common.Address) []byte {
+	code := tryCallCode(precompile)
+	return append(code[:len(code)-1], byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT))
+}
+
+// tryDelegateCallCode returns the bytecode of a contract forwarding its calldata to the given precompile
+// with a delegatecall and ignoring a failure.
+func tryDelegateCallCode(precompile common.Address) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, precompile.Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.DELEGATECALL), byte(vm.POP), byte(vm.STOP))
+}
+
+func TestDelegateCall(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	mockAddr, _ := MockAddressPair()
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.NoError(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.NoError(t, err)
+	cosmwasmAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, mockAddr, []byte("{}"), "test", nil)
+	require.NoError(t, err)
+
+	// register a precompile bound to this app as the global registry keeps the first registration
+	precompileAddr := common.HexToAddress("0x9000000000000000000000000000000000001003")
+	_ = modules.RegisterModule(modules.Module{
+		Address:  precompileAddr,
+		Contract: wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper),
+	})
+
+	// both the account and the contract it calls can pay for the execution
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	callerContract := common.HexToAddress("0x1000000000000000000000000000000000000004")
+	amts := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)))
+	for _, c := range []common.Address{sender, callerContract} {
+		require.NoError(t, tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts))
+		require.NoError(t, tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, c), amts))
+	}
+
+	executeMethod := wasmd.ABI.Methods["execute"]
+	args, err := executeMethod.Inputs.Pack(cosmwasmAddr.String(), []byte(`{"echo":{"message":"test msg"}}`), []wasmd.Coin{{Denom: "ukava", Amount: big.NewInt(10)}})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		code             []byte
+		expBalance       sdkmath.Int
+		expSenderBalance sdkmath.Int
+	}{
+		"call executes on behalf of the contract": {
+			code:             tryCallCode(precompileAddr),
+			expBalance:       sdkmath.NewInt(990),
+			expSenderBalance: sdkmath.NewInt(1000),
+		},
+		"delegatecall rejected": {
+			code:             tryDelegateCallCode(precompileAddr),
+			expBalance:       sdkmath.NewInt(1000),
+			expSenderBalance: sdkmath.NewInt(1000),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			stateDB.SetCode(callerContract, spec.code)
+			blockCtx := vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				BlockNumber: big.NewInt(ctx.BlockHeight()),
+				Time:        big.NewInt(ctx.BlockTime().Unix()),
+				Difficulty:  big.NewInt(0),
+			}
+			evm := pcommon.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, stateDB, params.TestChainConfig, vm.Config{}, nil)
+
+			// when
+			_, _, err := evm.Call(vm.AccountRef(sender), callerContract, append(executeMethod.ID, args...), 10_000_000, big.NewInt(0))
+
+			// then a delegatecall does not execute the contract on behalf of the sender
+			require.NoError(t, err)
+			balance := tApp.GetBankKeeper().GetBalance(ctx, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, callerContract), "ukava")
+			assert.Equal(t, spec.expBalance.String(), balance.Amount.String())
+			senderBalance := tApp.GetBankKeeper().GetBalance(ctx, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, sender), "ukava")
+			assert.Equal(t, spec.expSenderBalance.String(), senderBalance.Amount.String())
+		})
+	}
+}
+
+func TestExecuteWithValue(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	mockAddr, _ := MockAddressPair()
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.NoError(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.NoError(t, err)
+	cosmwasmAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, mockAddr, []byte("{}"), "test", nil)
+	require.NoError(t, err)
+
+	// register a precompile bound to this app as the global registry keeps the first registration
+	precompileAddr := common.HexToAddress("0x9000000000000000000000000000000000001002")
+	_ = modules.RegisterModule(modules.Module{
+		Address:  precompileAddr,
+		Contract: wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper),
+	})
+
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	senderCosmosAddr := tApp.EvmKeeper.GetCosmosAddressMapping(ctx, sender)
+	amts := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts))
+	require.NoError(t, tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, senderCosmosAddr, amts))
+
+	oneOrai := evmkeeper.ConversionMultiplier.BigInt()
+	executeMethod := wasmd.ABI.Methods["execute"]
+
+	specs := map[string]struct {
+		funds      []wasmd.Coin
+		value      *big.Int
+		expBalance sdkmath.Int
+		expErr     error
+	}{
+		"value forwarded": {
+			funds:      []wasmd.Coin{},
+			value:      new(big.Int).Mul(oneOrai, big.NewInt(10)),
+			expBalance: sdkmath.NewInt(990),
+		},
+		"value forwarded with funds": {
+			funds:      []wasmd.Coin{{Denom: appconfig.CosmosDenom, Amount: big.NewInt(5)}},
+			value:      new(big.Int).Mul(oneOrai, big.NewInt(10)),
+			expBalance: sdkmath.NewInt(985),
+		},
+		"value not convertible": {
+			funds:      []wasmd.Coin{},
+			value:      new(big.Int).Add(oneOrai, big.NewInt(1)),
+			expBalance: sdkmath.NewInt(1000),
+			expErr:     vm.ErrExecutionReverted,
+		},
+		"insufficient funds": {
+			funds:      []wasmd.Coin{{Denom: appconfig.CosmosDenom, Amount: big.NewInt(1001)}},
+			value:      big.NewInt(0),
+			expBalance: sdkmath.NewInt(1000),
+			expErr:     vm.ErrExecutionReverted,
+		},
+		"malformed funds": {
+			funds:      []wasmd.Coin{{Denom: appconfig.CosmosDenom, Amount: big.NewInt(0)}},
+			value:      big.NewInt(0),
+			expBalance: sdkmath.NewInt(1000),
+			expErr:     vm.ErrExecutionReverted,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			blockCtx := vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				BlockNumber: big.NewInt(ctx.BlockHeight()),
+				Time:        big.NewInt(ctx.BlockTime().Unix()),
+				Difficulty:  big.NewInt(0),
+			}
+			evm := vm.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, stateDB, params.TestChainConfig, vm.Config{})
+
+			args, err := executeMethod.Inputs.Pack(cosmwasmAddr.String(), []byte(`{"echo":{"message":"test msg"}}`), spec.funds)
+			require.NoError(t, err)
+
+			// when
+			_, gasLeft, err := evm.Call(vm.AccountRef(sender), precompileAddr, append(executeMethod.ID, args...), 10_000_000, spec.value)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				assert.NotZero(t, gasLeft)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, stateDB.Commit())
+
+			// then the value is paid out of the sender's cosmos balance without minting or burning
+			balance := tApp.GetBankKeeper().GetBalance(ctx, senderCosmosAddr, appconfig.CosmosDenom)
+			assert.Equal(t, spec.expBalance.String(), balance.Amount.String())
+			contractBalance := tApp.GetBankKeeper().GetBalance(ctx, cosmwasmAddr, appconfig.CosmosDenom)
+			assert.Equal(t, sdkmath.NewInt(1000).Sub(spec.expBalance).String(), contractBalance.Amount.String())
+			assert.Equal(t, "0", tApp.EvmKeeper.GetBalance(ctx, precompileAddr).String())
+		})
+	}
+}
+
+func TestExecuteRefund(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+
+	precompileAddr := common.HexToAddress("0x9000000000000000000000000000000000001004")
+	_ = modules.RegisterModule(modules.Module{
+		Address:  precompileAddr,
+		Contract: wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper),
+	})
+
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000006")
+	senderCosmosAddr := tApp.EvmKeeper.GetCosmosAddressMapping(ctx, sender)
+	amts := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts))
+	require.NoError(t, tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, senderCosmosAddr, amts))
+
+	// the reflect contract is owned by the sender and sends the refund back
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, senderCosmosAddr, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, senderCosmosAddr, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	refundMsg, err := json.Marshal(testdata.ReflectHandleMsg{
+		Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{
+			Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: senderCosmosAddr.String(),
+				Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{{Denom: appconfig.CosmosDenom, Amount: "4"}},
+			}},
+		}}},
+	})
+	require.NoError(t, err)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        big.NewInt(ctx.BlockTime().Unix()),
+		Difficulty:  big.NewInt(0),
+	}
+	evm := vm.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, stateDB, params.TestChainConfig, vm.Config{})
+	// the EVM transaction bumps the sender nonce so the sender account is written back on commit
+	stateDB.SetNonce(sender, stateDB.GetNonce(sender)+1)
+	executeMethod := wasmd.ABI.Methods["execute"]
+	funds := []wasmd.Coin{{Denom: appconfig.CosmosDenom, Amount: big.NewInt(10)}}
+	args, err := executeMethod.Inputs.Pack(reflectAddr.String(), refundMsg, funds)
+	require.NoError(t, err)
+
+	// when
+	_, _, err = evm.Call(vm.AccountRef(sender), precompileAddr, append(executeMethod.ID, args...), 10_000_000, big.NewInt(0))
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+
+	// then the refund is kept once the EVM state is committed
+	balance := tApp.GetBankKeeper().GetBalance(ctx, senderCosmosAddr, appconfig.CosmosDenom)
+	assert.Equal(t, "994", balance.Amount.String())
+	contractBalance := tApp.GetBankKeeper().GetBalance(ctx, reflectAddr, appconfig.CosmosDenom)
+	assert.Equal(t, "6", contractBalance.Amount.String())
+}
+
+func TestContractManagement(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	senderAddr := tApp.EvmKeeper.GetCosmosAddressMapping(ctx, sender)
+	otherAddr, _ := MockAddressPair()
+
+	codeID, checksum, err := tApp.ContractKeeper.Create(ctx, senderAddr, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+
+	p := wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	call := func(t *testing.T, caller common.Address, method string, args ...interface{}) ([]interface{}, error) {
+		t.Helper()
+		m := wasmd.ABI.Methods[method]
+		input, err := m.Inputs.Pack(args...)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, caller, registry.WasmdContractAddress, append(m.ID, input...), 10_000_000, false, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		return m.Outputs.Unpack(res)
+	}
+
+	// instantiate2 creates the contract at the predictable address
+	initMsg := []byte(fmt.Sprintf(`{"verifier":%q,"beneficiary":%q}`, senderAddr.String(), otherAddr.String()))
+	salt := []byte("salt")
+	rets, err := call(t, sender, "instantiate2", codeID, senderAddr.String(), initMsg, "label", salt, false, []wasmd.Coin{})
+	require.NoError(t, err)
+	contractAddr := rets[0].(string)
+	assert.Equal(t, wasmkeeper.BuildContractAddressPredictable(checksum, senderAddr, salt, nil).String(), contractAddr)
+
+	// the same salt can not be used twice
+	_, err = call(t, sender, "instantiate2", codeID, senderAddr.String(), initMsg, "label", salt, false, []wasmd.Coin{})
+	require.Error(t, err)
+
+	rets, err = call(t, sender, "contractInfo", contractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{codeID, senderAddr.String(), senderAddr.String(), "label"}, rets)
+
+	rets, err = call(t, sender, "rawQuery", contractAddr, []byte("config"))
+	require.NoError(t, err)
+	assert.Contains(t, string(rets[0].([]byte)), otherAddr.String())
+
+	// only the admin can migrate
+	migrateMsg := []byte(fmt.Sprintf(`{"verifier":%q}`, otherAddr.String()))
+	_, err = call(t, common.HexToAddress("0x2000000000000000000000000000000000000003"), "migrate", contractAddr, codeID, migrateMsg)
+	require.Error(t, err)
+	_, err = call(t, sender, "migrate", contractAddr, codeID, migrateMsg)
+	require.NoError(t, err)
+	rets, err = call(t, sender, "rawQuery", contractAddr, []byte("config"))
+	require.NoError(t, err)
+	assert.Contains(t, string(rets[0].([]byte)), fmt.Sprintf(`"verifier":%q`, otherAddr.String()))
+
+	// admin updates are authorized against the caller
+	_, err = call(t, common.HexToAddress("0x2000000000000000000000000000000000000003"), "clearAdmin", contractAddr)
+	require.Error(t, err)
+	rets, err = call(t, sender, "updateAdmin", contractAddr, otherAddr.String())
+	require.NoError(t, err)
+	assert.Equal(t, true, rets[0])
+	rets, err = call(t, sender, "contractInfo", contractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, otherAddr.String(), rets[2])
+	_, err = call(t, sender, "clearAdmin", contractAddr)
+	require.Error(t, err)
+
+	// unknown contract
+	_, err = call(t, sender, "contractInfo", otherAddr.String())
+	require.Error(t, err)
+}
+
+func TestClearAdmin(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000004")
+	senderAddr := tApp.EvmKeeper.GetCosmosAddressMapping(ctx, sender)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, senderAddr, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg := []byte(fmt.Sprintf(`{"verifier":%q,"beneficiary":%q}`, senderAddr.String(), senderAddr.String()))
+	contractAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, senderAddr, senderAddr, initMsg, "label", nil)
+	require.NoError(t, err)
+
+	p := wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	method := wasmd.ABI.Methods["clearAdmin"]
+	input, err := method.Inputs.Pack(contractAddr.String())
+	require.NoError(t, err)
+
+	// when
+	_, _, err = p.Run(&evm, sender, registry.WasmdContractAddress, append(method.ID, input...), 10_000_000, false, big.NewInt(0))
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, tApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+}
@@ -0,0 +1,63 @@
+package wasmd
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+)
+
+// Coin is the ABI representation of the funds sent along with a CosmWasm call
+type Coin struct {
+	Denom  string   `json:"denom"`
+	Amount *big.Int `json:"amount"`
+}
+
+// ParseFunds converts the ABI encoded funds and the EVM value sent with the call into the deposit
+// for the contract. The value is denominated in the EVM denom and must convert into a whole amount
+// of the Cosmos denom. Malformed funds are rejected.
+func ParseFunds(funds []Coin, value *big.Int) (sdk.Coins, error) {
+	deposit := make(sdk.Coins, 0, len(funds)+1)
+	for i, c := range funds {
+		if c.Amount == nil || c.Amount.Sign() <= 0 {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("funds at %d: amount must be positive", i)
+		}
+		if c.Amount.BitLen() > sdkmath.MaxBitLen {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("funds at %d: amount overflows", i)
+		}
+		if err := sdk.ValidateDenom(c.Denom); err != nil {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("funds at %d: %s", i, err)
+		}
+		deposit = append(deposit, sdk.NewCoin(c.Denom, sdkmath.NewIntFromBigInt(c.Amount)))
+	}
+	deposit = deposit.Sort()
+	if err := deposit.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	if value == nil || value.Sign() == 0 {
+		return deposit, nil
+	}
+	valueCoin, err := convertEVMValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return deposit.Add(valueCoin), nil
+}
+
+// convertEVMValue converts the value in the EVM denom into the Cosmos denom
+func convertEVMValue(value *big.Int) (sdk.Coin, error) {
+	if value.Sign() < 0 || value.BitLen() > sdkmath.MaxBitLen {
+		return sdk.Coin{}, sdkerrors.ErrInvalidCoins.Wrap("value")
+	}
+	amount, rem := new(big.Int).QuoRem(value, evmkeeper.ConversionMultiplier.BigInt(), new(big.Int))
+	if rem.Sign() != 0 {
+		return sdk.Coin{}, sdkerrors.ErrInvalidCoins.Wrapf(
+			"value must be a multiple of %s%s", evmkeeper.ConversionMultiplier, appconfig.EvmDenom)
+	}
+	return sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewIntFromBigInt(amount)), nil
+}
@@ -0,0 +1,20 @@
+package wasmd
+
+// Option is an optional constructor parameter of the wasmd precompile
+type Option interface {
+	apply(*PrecompileExecutor)
+}
+
+type optsFn func(*PrecompileExecutor)
+
+func (f optsFn) apply(p *PrecompileExecutor) {
+	f(p)
+}
+
+// WithWasmEventLogs is an optional constructor parameter to translate the events emitted by
+// CosmWasm contracts during a precompile call into CosmWasmEvent EVM logs.
+func WithWasmEventLogs() Option {
+	return optsFn(func(p *PrecompileExecutor) {
+		p.wasmEventLogs = true
+	})
+}
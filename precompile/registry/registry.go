@@ -1,11 +1,27 @@
 package registry
 
 import (
+	"context"
+	"math/big"
+	"sync"
+
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/CosmWasm/wasmd/precompile/contracts/addr"
 	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
+	"github.com/CosmWasm/wasmd/precompile/contracts/gov"
+	"github.com/CosmWasm/wasmd/precompile/contracts/ibc"
 	"github.com/CosmWasm/wasmd/precompile/contracts/json"
+	"github.com/CosmWasm/wasmd/precompile/contracts/staking"
+	"github.com/CosmWasm/wasmd/precompile/contracts/tokenfactory"
 	"github.com/CosmWasm/wasmd/precompile/contracts/wasmd"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/ethereum/go-ethereum/precompile/modules"
@@ -17,28 +33,108 @@ var (
 	JsonContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000002")
 	AddrContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000003")
 	BankContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000004")
+
+	TokenFactoryContractAddress = common.HexToAddress("0x9000000000000000000000000000000000000005")
+	StakingContractAddress      = common.HexToAddress("0x9000000000000000000000000000000000000006")
+	GovContractAddress          = common.HexToAddress("0x9000000000000000000000000000000000000007")
+	IBCContractAddress          = common.HexToAddress("0x9000000000000000000000000000000000000008")
 )
 
 // init registers stateful precompile contracts with the global precompile registry
 // defined in kava-labs/go-ethereum/precompile/modules
-func InitializePrecompiles(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, accountKeeper pcommon.AccountKeeper) {
-	register(WasmdContractAddress, wasmd.NewContract(wasmdKeeper, wasmdViewKeeper, evmKeeper))
-	register(JsonContractAddress, json.NewContract())
-	register(AddrContractAddress, addr.NewContract(evmKeeper))
-	register(BankContractAddress, bank.NewContract(evmKeeper, bankKeeper, accountKeeper))
+func InitializePrecompiles(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, allowanceKeeper pcommon.BankAllowanceKeeper, tokenFactoryKeeper pcommon.TokenFactoryKeeper, tokenFactoryMsgServer tokenfactorytypes.MsgServer, stakingMsgServer stakingtypes.MsgServer, stakingQuerier stakingtypes.QueryServer, distrMsgServer distrtypes.MsgServer, distrQuerier distrtypes.QueryServer, cdc codec.Codec, govMsgServer govv1.MsgServer, govQuerier govv1.QueryServer, transferMsgServer transfertypes.MsgServer, transferQuerier transfertypes.QueryServer, accountKeeper pcommon.AccountKeeper, gasKeeper pcommon.PrecompileGasKeeper, wasmdOpts ...wasmd.Option) {
+	register(WasmdContractAddress, wasmd.ABI, gasKeeper, wasmd.NewContract(wasmdKeeper, wasmdViewKeeper, evmKeeper, wasmdOpts...))
+	register(JsonContractAddress, json.ABI, gasKeeper, json.NewContract())
+	register(AddrContractAddress, addr.ABI, gasKeeper, addr.NewContract(evmKeeper))
+	register(BankContractAddress, bank.ABI, gasKeeper, bank.NewContract(evmKeeper, bankKeeper, allowanceKeeper, accountKeeper))
+	register(TokenFactoryContractAddress, tokenfactory.ABI, gasKeeper, tokenfactory.NewContract(evmKeeper, bankKeeper, tokenFactoryKeeper, tokenFactoryMsgServer))
+	register(StakingContractAddress, staking.ABI, gasKeeper, staking.NewContract(evmKeeper, stakingMsgServer, stakingQuerier, distrMsgServer, distrQuerier))
+	register(GovContractAddress, gov.ABI, gasKeeper, gov.NewContract(evmKeeper, cdc, govMsgServer, govQuerier))
+	register(IBCContractAddress, ibc.ABI, gasKeeper, ibc.NewContract(evmKeeper, transferMsgServer, transferQuerier))
+
+}
+
+// RegisterBankPointers registers the ERC20 pointer contracts of all bank denoms with a pointer
+// registered in the wasm module. The registry is global and not persisted, so this must run once at
+// node start on the committed state.
+func RegisterBankPointers(ctx sdk.Context, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, allowanceKeeper pcommon.BankAllowanceKeeper, pointerKeeper pcommon.BankPointerKeeper, gasKeeper pcommon.PrecompileGasKeeper) {
+	registerBankPointers(ctx, pointerKeeper.IterateBankPointers, evmKeeper, bankKeeper, allowanceKeeper, pointerKeeper, gasKeeper)
+}
+
+// RegisterPendingBankPointers registers the ERC20 pointer contracts stored since the last call and
+// clears them from the pending pointers. It must run at begin block, for all nodes to have the same
+// precompiles. Pointers registered in a block can be called from the next block on.
+func RegisterPendingBankPointers(ctx sdk.Context, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, allowanceKeeper pcommon.BankAllowanceKeeper, pointerKeeper pcommon.PendingBankPointerKeeper, gasKeeper pcommon.PrecompileGasKeeper) {
+	registerBankPointers(ctx, pointerKeeper.IteratePendingBankPointers, evmKeeper, bankKeeper, allowanceKeeper, pointerKeeper, gasKeeper)
+	pointerKeeper.ClearPendingBankPointers(ctx)
+}
 
+func registerBankPointers(
+	ctx sdk.Context,
+	iterate func(ctx context.Context, cb func(denom string, pointer common.Address) bool),
+	evmKeeper pcommon.EVMKeeper,
+	bankKeeper pcommon.BankKeeper,
+	allowanceKeeper pcommon.BankAllowanceKeeper,
+	pointerKeeper pcommon.BankPointerKeeper,
+	gasKeeper pcommon.PrecompileGasKeeper,
+) {
+	var pointerContract contract.StatefulPrecompiledContract
+	iterate(ctx, func(_ string, pointer common.Address) bool {
+		if _, found := modules.GetPrecompileModuleByAddress(pointer); found {
+			return false
+		}
+		if pointerContract == nil {
+			pointerContract = bank.NewPointerContract(evmKeeper, bankKeeper, allowanceKeeper, pointerKeeper)
+		}
+		register(pointer, bank.PointerABI, gasKeeper, pointerContract)
+		return false
+	})
 }
 
 // register accepts a 0x address string and a stateful precompile contract constructor, instantiates the
 // precompile contract via the constructor, and registers it with the precompile module registry.
-func register(moduleAddress common.Address, contract contract.StatefulPrecompiledContract) {
+// The contract is charged according to the precompile gas schedule.
+func register(moduleAddress common.Address, contractABI abi.ABI, gasKeeper pcommon.PrecompileGasKeeper, contract contract.StatefulPrecompiledContract) {
+	contractsMu.Lock()
+	_, found := contracts[moduleAddress]
+	contracts[moduleAddress] = pcommon.WithGasSchedule(moduleAddress, contractABI, contract, gasKeeper)
+	contractsMu.Unlock()
 
-	// do not check found, allowing override
+	// the module registry rejects duplicate addresses, so overrides are resolved on each call
+	if found {
+		return
+	}
 	module := modules.Module{
 		Address:  moduleAddress,
-		Contract: contract,
+		Contract: registeredContract{address: moduleAddress},
 	}
 
 	modules.RegisterModule(module)
 
 }
+
+var (
+	contractsMu sync.RWMutex
+	// contracts are the last contracts registered by address
+	contracts = make(map[common.Address]contract.StatefulPrecompiledContract)
+)
+
+// registeredContract runs the last contract registered at its address
+type registeredContract struct {
+	address common.Address
+}
+
+func (c registeredContract) Run(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, remainingGas uint64, err error) {
+	contractsMu.RLock()
+	registered := contracts[c.address]
+	contractsMu.RUnlock()
+	return registered.Run(accessibleState, caller, addr, input, suppliedGas, readOnly, value)
+}
@@ -17,7 +17,7 @@ import (
 //     expected length, not missing 0's, etc.
 func TestRegisteredPrecompilesAddresses(t *testing.T) {
 
-	registry.InitializePrecompiles(nil, nil, nil, nil, nil)
+	registry.InitializePrecompiles(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// build list of 0x addresses that are registered
 	registeredModules := modules.RegisteredModules()
@@ -32,6 +32,10 @@ func TestRegisteredPrecompilesAddresses(t *testing.T) {
 		"0x9000000000000000000000000000000000000002", // noop
 		"0x9000000000000000000000000000000000000003", // noop
 		"0x9000000000000000000000000000000000000004", // noop
+		"0x9000000000000000000000000000000000000005", // noop
+		"0x9000000000000000000000000000000000000006", // noop
+		"0x9000000000000000000000000000000000000007", // noop
+		"0x9000000000000000000000000000000000000008", // noop
 	}
 
 	assert.Equal(t, expectedPrecompiles, registeredPrecompiles,
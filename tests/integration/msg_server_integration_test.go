@@ -14,8 +14,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	jsonprecompile "github.com/CosmWasm/wasmd/precompile/contracts/json"
+	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -1227,3 +1231,65 @@ func TestUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestCallEVM(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	vals, err := wasmApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := vals[0].GetConsAddr()
+	require.NoError(t, err)
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	ctx = ctx.WithBlockHeader(header)
+	evmParams := wasmApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, wasmApp.EvmKeeper.SetParams(ctx, evmParams))
+
+	method := jsonprecompile.ABI.Methods[jsonprecompile.ExtractAsBytesMethod]
+	args, err := method.Inputs.Pack([]byte(`{"key":"value"}`), "key")
+	require.NoError(t, err)
+	data := append(method.ID, args...)
+	sender := keeper.BuildContractAddressClassic(1, 1)
+
+	// when
+	bz, err := json.Marshal(types.EVMCustomMsg{EVM: &types.EVMMsg{Call: &types.EVMCall{
+		Contract: registry.JsonContractAddress.Hex(),
+		Data:     data,
+		GasLimit: 100_000,
+	}}})
+	require.NoError(t, err)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, rspData, _, err := keeper.NewEVMMessageHandler(&wasmApp.WasmKeeper).DispatchMsg(ctx, sender, "", wasmvmtypes.CosmosMsg{Custom: bz})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, rspData, 1)
+	var result types.EVMCallResponse
+	require.NoError(t, json.Unmarshal(rspData[0], &result))
+	out, err := method.Outputs.Unpack(result.Data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), out[0])
+	// the EVM charges at least the min gas multiplier share of the gas limit
+	assert.Equal(t, uint64(50_000), result.GasUsed)
+	assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, wasmApp.WasmKeeper.GetGasRegister().FromEVMGas(result.GasUsed))
+	// and the contract is mapped to its EVM address
+	evmAddr, err := wasmApp.EvmKeeper.GetEvmAddressMapping(ctx, sender)
+	require.NoError(t, err)
+	assert.Equal(t, common.BytesToAddress(sender), *evmAddr)
+	assert.Equal(t, sender, wasmApp.EvmKeeper.GetCosmosAddressMapping(ctx, *evmAddr))
+
+	// and the same call can be made as static call query
+	querier := keeper.EVMQuerier(&wasmApp.WasmKeeper, keeper.NoCustomQuerier)
+	bz, err = json.Marshal(types.EVMCustomQuery{EVM: &types.EVMQuery{StaticCall: &types.EVMStaticCall{
+		Contract: registry.JsonContractAddress.Hex(),
+		Data:     data,
+		GasLimit: 100_000,
+	}}})
+	require.NoError(t, err)
+	qRsp, err := querier(ctx, bz)
+	require.NoError(t, err)
+	var queryResult types.EVMCallResponse
+	require.NoError(t, json.Unmarshal(qRsp, &queryResult))
+	assert.Equal(t, result.Data, queryResult.Data)
+}
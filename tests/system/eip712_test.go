@@ -0,0 +1,55 @@
+//go:build system_test
+
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestEIP712SignedWasmExecute(t *testing.T) {
+	// Scenario:
+	// add an eth_secp256k1 key and fund it
+	// instantiate hackatom with the eth account as verifier
+	// sign the release msg as EIP-712 typed data and broadcast it
+	// the contract has released its funds to the beneficiary
+	sut.ResetChain(t)
+	sut.StartChain(t)
+
+	cli := NewWasmdCLI(t, sut, verbose)
+	out := cli.Keys("keys", "add", "eth-user", "--key-type=eth_secp256k1", "--coin-type=60", "--no-backup")
+	ethUserAddr := gjson.Get(out, "address").String()
+	require.NotEmpty(t, ethUserAddr, "got %q", out)
+	cli.FundAddress(ethUserAddr, "100000000stake")
+
+	t.Log("Instantiate hackatom with the eth account as verifier")
+	codeID := cli.WasmStore("./testdata/hackatom.wasm.gzip", "--from=node0", "--gas=1900000", "--fees=2stake")
+	beneficiary := randomBech32Addr()
+	initMsg := fmt.Sprintf(`{"verifier":%q, "beneficiary":%q}`, ethUserAddr, beneficiary)
+	contractAddr := cli.WasmInstantiate(codeID, initMsg, "--label=eip712", "--no-admin", "--from="+defaultSrcAddr, "--amount=100stake")
+	require.Equal(t, int64(100), cli.QueryBalance(contractAddr, "stake"))
+
+	t.Log("Sign the release msg as EIP-712 typed data")
+	unsignedTx, ok := cli.run(cli.withTXFlags("tx", "wasm", "execute", contractAddr, `{"release":{}}`, "--from=eth-user", "--fees=2stake", "--generate-only"))
+	require.True(t, ok, unsignedTx)
+	unsignedFile := filepath.Join(t.TempDir(), "unsigned.json")
+	require.NoError(t, os.WriteFile(unsignedFile, []byte(unsignedTx), 0o600))
+
+	signedTx, ok := cli.run(cli.withTXFlags("tx", "sign-eip712", unsignedFile, "--from=eth-user"))
+	require.True(t, ok, signedTx)
+	assert.Equal(t, "/ethermint.types.v1.ExtensionOptionsWeb3Tx", gjson.Get(signedTx, "body.extension_options.0.@type").String())
+	signedFile := filepath.Join(t.TempDir(), "signed.json")
+	require.NoError(t, os.WriteFile(signedFile, []byte(signedTx), 0o600))
+
+	rsp := cli.CustomCommand("tx", "broadcast", signedFile)
+	RequireTxSuccess(t, rsp)
+
+	assert.Equal(t, int64(0), cli.QueryBalance(contractAddr, "stake"))
+	assert.Equal(t, int64(100), cli.QueryBalance(beneficiary, "stake"))
+}
@@ -74,7 +74,7 @@ func NewSystemUnderTest(execBinary string, verbose bool, nodesCount int, blockTi
 	}
 
 	return &SystemUnderTest{
-		chainID:           "testing",
+		chainID:           "testing_9000-1", // EVM compatible chain id for EIP-712 signed txs
 		ExecBinary:        execBinary,
 		outputDir:         "./testnet",
 		blockTime:         blockTime,
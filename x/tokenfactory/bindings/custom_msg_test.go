@@ -2,18 +2,25 @@ package bindings_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/app"
+	tfbindings "github.com/CosmWasm/wasmd/x/tokenfactory/bindings"
 	bindings "github.com/CosmWasm/wasmd/x/tokenfactory/bindings/types"
+	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
 	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
 )
 
@@ -299,6 +306,137 @@ type ReflectMsgs struct {
 	Msgs []wasmvmtypes.CosmosMsg `json:"msgs"`
 }
 
+func TestBeforeSendHook(t *testing.T) {
+	creator := RandomAccountAddress()
+	engine := &sudoWasmEngine{}
+	osmosis, ctx := SetupCustomApp(t, creator, keeper.WithWasmEngineDecorator(func(old wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+		engine.WasmEngine = old
+		return engine
+	}))
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	mint := bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        math.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	require.NoError(t, executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{}))
+
+	// only the admin can set the hook
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(osmosis.TokenFactoryKeeper)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(reflect.String(), sdk.NewInt64Coin(sunDenom, 10)))
+	require.NoError(t, err)
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(lucky.String(), sunDenom, reflect.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the reflect contract has no sudo entry point, so every transfer of the denom is rejected
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(reflect.String(), sunDenom, reflect.String()))
+	require.NoError(t, err)
+
+	// the reflect contract does not know the query, so it is sent to the querier directly
+	querier := tfbindings.CustomQuerier(tfbindings.NewQueryPlugin(&osmosis.BankKeeper, &osmosis.TokenFactoryKeeper))
+	queryBz, err := json.Marshal(bindings.TokenFactoryQuery{Token: &bindings.TokenQuery{
+		BeforeSendHookAddress: &bindings.BeforeSendHookAddress{Denom: sunDenom},
+	}})
+	require.NoError(t, err)
+	resp := bindings.BeforeSendHookAddressResponse{}
+	resBz, err := querier(ctx, queryBz)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(resBz, &resp))
+	require.Equal(t, reflect.String(), resp.CosmwasmAddress)
+
+	// failed calls run on a cache context, like in a tx, so that their partial state changes are discarded
+	sun := sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 10))
+	cacheCtx, _ := ctx.CacheContext()
+	err = osmosis.BankKeeper.SendCoins(cacheCtx, lucky, creator, sun)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookFailed)
+	require.Error(t, executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{}))
+
+	// the hook also blocks minting and burning
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.Mint(cacheCtx, types.NewMsgMint(reflect.String(), sun[0]))
+	require.ErrorIs(t, err, types.ErrBeforeSendHookFailed)
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.Burn(cacheCtx, types.NewMsgBurn(reflect.String(), sun[0]))
+	require.ErrorIs(t, err, types.ErrBeforeSendHookFailed)
+
+	// other denoms are not affected
+	fundAccount(t, ctx, osmosis, lucky, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, osmosis.BankKeeper.SendCoins(ctx, lucky, creator, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	// a hook accepting the transfer receives it and lets it pass
+	var gotSudoMsg types.BlockBeforeSendSudoMsg
+	engine.sudoFn = func(sudoMsg []byte, _ uint64) (*wasmvmtypes.ContractResult, uint64, error) {
+		require.NoError(t, json.Unmarshal(sudoMsg, &gotSudoMsg))
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	acceptCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(t, osmosis.BankKeeper.SendCoins(acceptCtx, lucky, creator, sun))
+	require.Equal(t, sun[0], osmosis.BankKeeper.GetBalance(ctx, creator, sunDenom))
+	assert.Equal(t, types.BlockBeforeSendMsg{
+		From:   lucky.String(),
+		To:     creator.String(),
+		Amount: wasmvmtypes.Coin{Denom: sunDenom, Amount: "10"},
+	}, gotSudoMsg.BlockBeforeSend)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(reflect.String(), sun[0]))
+	require.NoError(t, err)
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(reflect.String(), sun[0]))
+	require.NoError(t, err)
+
+	// a hook looping until it runs out of gas is rejected and charged the gas limit of the hook only
+	engine.sudoFn = func(_ []byte, gasLimit uint64) (*wasmvmtypes.ContractResult, uint64, error) {
+		return nil, gasLimit, errors.New("Ran out of gas during contract execution")
+	}
+	loopCtx, _ := ctx.CacheContext()
+	loopCtx = loopCtx.WithGasMeter(storetypes.NewGasMeter(10 * types.BeforeSendHookGasLimit))
+	err = osmosis.BankKeeper.SendCoins(loopCtx, lucky, creator, sun)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookFailed)
+	require.ErrorContains(t, err, "out of gas")
+	gasUsed := loopCtx.GasMeter().GasConsumed()
+	assert.GreaterOrEqual(t, gasUsed, uint64(types.BeforeSendHookGasLimit))
+	// on top of the hook, only the costs of the transfer that an accepting hook also pays are charged
+	assert.LessOrEqual(t, gasUsed-types.BeforeSendHookGasLimit, acceptCtx.GasMeter().GasConsumed())
+	assert.Equal(t, sdk.NewInt64Coin(sunDenom, 90), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom))
+
+	// removing the hook allows transfers again
+	engine.sudoFn = nil
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(reflect.String(), sunDenom, ""))
+	require.NoError(t, err)
+	resBz, err = querier(ctx, queryBz)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(resBz, &resp))
+	require.Empty(t, resp.CosmwasmAddress)
+	require.NoError(t, osmosis.BankKeeper.SendCoins(ctx, lucky, creator, sun))
+	require.Equal(t, sun.Add(sun...)[0], osmosis.BankKeeper.GetBalance(ctx, creator, sunDenom))
+}
+
+// sudoWasmEngine answers sudo calls with sudoFn when set, so that any contract can act as before send hook
+type sudoWasmEngine struct {
+	wasmtypes.WasmEngine
+	sudoFn func(sudoMsg []byte, gasLimit uint64) (*wasmvmtypes.ContractResult, uint64, error)
+}
+
+func (e *sudoWasmEngine) Sudo(checksum wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	if e.sudoFn == nil {
+		return e.WasmEngine.Sudo(checksum, env, sudoMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+	}
+	return e.sudoFn(sudoMsg, gasLimit)
+}
+
 type ReflectSubMsgs struct {
 	Msgs []wasmvmtypes.SubMsg `json:"msgs"`
 }
@@ -18,8 +18,8 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 )
 
-func CreateTestInput(t *testing.T) (*app.WasmApp, sdk.Context) {
-	osmosis := app.Setup(t)
+func CreateTestInput(t *testing.T, opts ...keeper.Option) (*app.WasmApp, sdk.Context) {
+	osmosis := app.Setup(t, opts...)
 	ctx := osmosis.BaseApp.NewContext(false)
 	myTime := time.Unix(0, 1619700924259075000)
 	ctx = ctx.WithBlockTime(myTime)
@@ -74,8 +74,8 @@ func fundAccount(t *testing.T, ctx sdk.Context, tokenz *app.WasmApp, addr sdk.Ac
 	require.NoError(t, err)
 }
 
-func SetupCustomApp(t *testing.T, addr sdk.AccAddress) (*app.WasmApp, sdk.Context) {
-	tokenz, ctx := CreateTestInput(t)
+func SetupCustomApp(t *testing.T, addr sdk.AccAddress, opts ...keeper.Option) (*app.WasmApp, sdk.Context) {
+	tokenz, ctx := CreateTestInput(t, opts...)
 	wasmKeeper := tokenz.WasmKeeper
 
 	storeReflectCode(t, ctx, tokenz, addr)
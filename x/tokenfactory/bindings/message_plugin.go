@@ -8,7 +8,6 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -46,7 +45,7 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 			return nil, nil, nil, errorsmod.Wrap(err, "token factory msg")
 		}
 		if contractMsg.Token == nil {
-			return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "nil token field")
+			return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 		}
 		tokenMsg := contractMsg.Token
 
@@ -68,6 +67,12 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if tokenMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, tokenMsg.ForceTransfer)
 		}
+		if tokenMsg.GrantDenomRole != nil {
+			return m.grantDenomRole(ctx, contractAddr, tokenMsg.GrantDenomRole)
+		}
+		if tokenMsg.RevokeDenomRole != nil {
+			return m.revokeDenomRole(ctx, contractAddr, tokenMsg.RevokeDenomRole)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -279,13 +284,17 @@ func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
+	if !f.IsCapabilityEnabled(ctx, tokenfactorytypes.EnableSetMetadata) {
+		return tokenfactorytypes.ErrCapabilityNotEnabled
+	}
+
 	// ensure contract address is admin of denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if !auth.HasRole(contractAddr.String(), tokenfactorytypes.RoleMetadataManager) {
+		return wasmvmtypes.InvalidRequest{Err: "only admin or metadata manager can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -306,6 +315,62 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper,
 	return nil
 }
 
+// grantDenomRole grants a role of a denom.
+func (m *CustomMessenger) grantDenomRole(ctx sdk.Context, contractAddr sdk.AccAddress, grant *bindingstypes.GrantDenomRole) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformGrantDenomRole(m.tokenFactory, ctx, contractAddr, grant)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform grant denom role")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformGrantDenomRole grants a role of a denom after validating the grantDenomRole message.
+func PerformGrantDenomRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, grant *bindingstypes.GrantDenomRole) error {
+	if grant == nil {
+		return wasmvmtypes.InvalidRequest{Err: "grant denom role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgGrantDenomRole(contractAddr.String(), grant.Denom, grant.Role, grant.Address, grant.MintAllowance)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.GrantDenomRole(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "granting denom role from message")
+	}
+	return nil
+}
+
+// revokeDenomRole revokes a role of a denom.
+func (m *CustomMessenger) revokeDenomRole(ctx sdk.Context, contractAddr sdk.AccAddress, revoke *bindingstypes.RevokeDenomRole) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformRevokeDenomRole(m.tokenFactory, ctx, contractAddr, revoke)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform revoke denom role")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformRevokeDenomRole revokes a role of a denom after validating the revokeDenomRole message.
+func PerformRevokeDenomRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, revoke *bindingstypes.RevokeDenomRole) error {
+	if revoke == nil {
+		return wasmvmtypes.InvalidRequest{Err: "revoke denom role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRevokeDenomRole(contractAddr.String(), revoke.Denom, revoke.Role, revoke.Address)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.RevokeDenomRole(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "revoking denom role from message")
+	}
+	return nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
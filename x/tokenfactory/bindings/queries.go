@@ -2,6 +2,7 @@ package bindings
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -32,6 +33,33 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindingstyp
 	return &bindingstypes.AdminResponse{Admin: metadata.Admin}, nil
 }
 
+// GetDenomRoles is a query to get the denom admin and the addresses holding a role of the denom.
+func (qp QueryPlugin) GetDenomRoles(ctx sdk.Context, denom string) (*bindingstypes.DenomRolesResponse, error) {
+	metadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles for denom: %s", denom)
+	}
+	minters := make([]bindingstypes.DenomMinter, len(metadata.Minters))
+	for i, minter := range metadata.Minters {
+		minters[i] = bindingstypes.DenomMinter{Address: minter.Address, MintAllowance: minter.MintAllowance}
+	}
+	return &bindingstypes.DenomRolesResponse{
+		Admin:             metadata.Admin,
+		Minters:           minters,
+		Burners:           nonNilStrings(metadata.Burners),
+		MetadataManagers:  nonNilStrings(metadata.MetadataManagers),
+		ForceTransferrers: nonNilStrings(metadata.ForceTransferrers),
+	}, nil
+}
+
+// nonNilStrings returns an empty slice for nil, so that it is serialized as an empty JSON array
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
 func (qp QueryPlugin) GetDenomsByCreator(ctx sdk.Context, creator string) (*bindingstypes.DenomsByCreatorResponse, error) {
 	// TODO: validate creator address
 	denoms := qp.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator)
@@ -51,7 +79,22 @@ func (qp QueryPlugin) GetParams(ctx sdk.Context) (*bindingstypes.ParamsResponse,
 	params := qp.tokenFactoryKeeper.GetParams(ctx)
 	return &bindingstypes.ParamsResponse{
 		Params: bindingstypes.Params{
-			DenomCreationFee: ConvertSdkCoinsToWasmCoins(params.DenomCreationFee),
+			DenomCreationFee:    ConvertSdkCoinsToWasmCoins(params.DenomCreationFee),
+			EnabledCapabilities: params.EnabledCapabilities,
 		},
 	}, nil
 }
+
+func (qp QueryPlugin) GetBeforeSendHookAddress(ctx sdk.Context, denom string) (*bindingstypes.BeforeSendHookAddressResponse, error) {
+	cosmwasmAddress := qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, denom)
+	return &bindingstypes.BeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (qp QueryPlugin) GetDenomSupplyPolicy(ctx sdk.Context, denom string) (*bindingstypes.DenomSupplyPolicyResponse, error) {
+	policy, _ := qp.tokenFactoryKeeper.GetDenomSupplyPolicy(ctx, denom)
+	return &bindingstypes.DenomSupplyPolicyResponse{
+		MaxSupply:         policy.MaxSupply,
+		MintLimit:         policy.MintLimit,
+		MintWindowSeconds: uint64(policy.MintWindow / time.Second),
+	}, nil
+}
@@ -97,6 +97,45 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case tokenQuery.BeforeSendHookAddress != nil:
+			res, err := qp.GetBeforeSendHookAddress(ctx, tokenQuery.BeforeSendHookAddress.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookAddressResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case tokenQuery.DenomRoles != nil:
+			res, err := qp.GetDenomRoles(ctx, tokenQuery.DenomRoles.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomRolesResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case tokenQuery.DenomSupplyPolicy != nil:
+			res, err := qp.GetDenomSupplyPolicy(ctx, tokenQuery.DenomSupplyPolicy.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomSupplyPolicyResponse: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token query variant"}
 		}
@@ -25,6 +25,11 @@ type TokenMsg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can grant a role (minter, burner, metadata_manager or
+	/// force_transferrer) of a denom that they are the admin of.
+	GrantDenomRole *GrantDenomRole `json:"grant_denom_role,omitempty"`
+	/// Contracts can revoke a role of a denom that they are the admin of.
+	RevokeDenomRole *RevokeDenomRole `json:"revoke_denom_role,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -62,6 +67,21 @@ type SetMetadata struct {
 	Metadata Metadata `json:"metadata"`
 }
 
+// GrantDenomRole grants a role of a factory denom to an address.
+// The MintAllowance is only allowed for the minter role, an empty allowance means no limit.
+type GrantDenomRole struct {
+	Denom         string    `json:"denom"`
+	Role          string    `json:"role"`
+	Address       string    `json:"address"`
+	MintAllowance *math.Int `json:"mint_allowance,omitempty"`
+}
+
+type RevokeDenomRole struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
 type ForceTransfer struct {
 	Denom       string   `json:"denom"`
 	Amount      math.Int `json:"amount"`
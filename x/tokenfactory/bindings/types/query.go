@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/math"
+
 type TokenFactoryQuery struct {
 	Token *TokenQuery `json:"token,omitempty"`
 }
@@ -13,6 +15,12 @@ type TokenQuery struct {
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	/// Returns the CosmWasm contract called before each transfer of the denom, empty if none
+	BeforeSendHookAddress *BeforeSendHookAddress `json:"before_send_hook_address,omitempty"`
+	/// Returns the max supply and mint limit of the denom, zero values if none
+	DenomSupplyPolicy *DenomSupplyPolicy `json:"denom_supply_policy,omitempty"`
+	/// Returns the admin and the addresses holding a role of the denom
+	DenomRoles *DenomRoles `json:"denom_roles,omitempty"`
 }
 
 // query types
@@ -36,6 +44,18 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type BeforeSendHookAddress struct {
+	Denom string `json:"denom"`
+}
+
+type DenomSupplyPolicy struct {
+	Denom string `json:"denom"`
+}
+
+type DenomRoles struct {
+	Denom string `json:"denom"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -57,3 +77,26 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type BeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+type DenomRolesResponse struct {
+	Admin             string        `json:"admin"`
+	Minters           []DenomMinter `json:"minters"`
+	Burners           []string      `json:"burners"`
+	MetadataManagers  []string      `json:"metadata_managers"`
+	ForceTransferrers []string      `json:"force_transferrers"`
+}
+
+type DenomMinter struct {
+	Address       string    `json:"address"`
+	MintAllowance *math.Int `json:"mint_allowance,omitempty"`
+}
+
+type DenomSupplyPolicyResponse struct {
+	MaxSupply         math.Int `json:"max_supply"`
+	MintLimit         math.Int `json:"mint_limit"`
+	MintWindowSeconds uint64   `json:"mint_window_seconds"`
+}
@@ -33,5 +33,6 @@ type DenomUnit struct {
 }
 
 type Params struct {
-	DenomCreationFee []wasmvmtypes.Coin `json:"denom_creation_fee"`
+	DenomCreationFee    []wasmvmtypes.Coin `json:"denom_creation_fee"`
+	EnabledCapabilities []string           `json:"enabled_capabilities"`
 }
@@ -9,6 +9,7 @@ import (
 
 	wasmbinding "github.com/CosmWasm/wasmd/x/tokenfactory/bindings"
 	bindings "github.com/CosmWasm/wasmd/x/tokenfactory/bindings/types"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
 	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/require"
@@ -413,3 +414,229 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+func TestCapabilities(t *testing.T) {
+	creator := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, creator, tokenCreationFeeAmt)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+
+	lucky := RandomAccountAddress()
+	err = wasmbinding.PerformMint(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, &bindings.MintTokens{
+		Denom:         validDenomStr,
+		Amount:        math.NewInt(100),
+		MintToAddress: lucky.String(),
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		capability string
+		exec       func(ctx sdk.Context) error
+	}{
+		"burn from": {
+			capability: types.EnableBurnFrom,
+			exec: func(ctx sdk.Context) error {
+				return wasmbinding.PerformBurn(&tokenz.TokenFactoryKeeper, ctx, creator, &bindings.BurnTokens{
+					Denom:           validDenomStr,
+					Amount:          math.NewInt(1),
+					BurnFromAddress: lucky.String(),
+				})
+			},
+		},
+		"force transfer": {
+			capability: types.EnableForceTransfer,
+			exec: func(ctx sdk.Context) error {
+				return wasmbinding.PerformForceTransfer(&tokenz.TokenFactoryKeeper, ctx, creator, &bindings.ForceTransfer{
+					Denom:       validDenomStr,
+					Amount:      math.NewInt(1),
+					FromAddress: lucky.String(),
+					ToAddress:   creator.String(),
+				})
+			},
+		},
+		"set metadata": {
+			capability: types.EnableSetMetadata,
+			exec: func(ctx sdk.Context) error {
+				return wasmbinding.PerformSetMetadata(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, validDenomStr, bindings.Metadata{
+					Description: "moon token",
+					DenomUnits:  []bindings.DenomUnit{{Denom: validDenomStr}},
+					Display:     validDenomStr,
+					Name:        "Moon",
+					Symbol:      "MOON",
+				})
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			params := tokenz.TokenFactoryKeeper.GetParams(cacheCtx)
+			params.EnabledCapabilities = nil
+			for _, capability := range types.AllCapabilities() {
+				if capability != spec.capability {
+					params.EnabledCapabilities = append(params.EnabledCapabilities, capability)
+				}
+			}
+			require.NoError(t, tokenz.TokenFactoryKeeper.SetParams(cacheCtx, params))
+
+			// when disabled
+			gotErr := spec.exec(cacheCtx)
+			// then
+			require.ErrorIs(t, gotErr, types.ErrCapabilityNotEnabled)
+
+			// when enabled
+			params.EnabledCapabilities = append(params.EnabledCapabilities, spec.capability)
+			require.NoError(t, tokenz.TokenFactoryKeeper.SetParams(cacheCtx, params))
+			gotErr = spec.exec(cacheCtx)
+			// then
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestMintSupplyPolicy(t *testing.T) {
+	creator := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, creator, tokenCreationFeeAmt)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+
+	msgServer := keeper.NewMsgServerImpl(tokenz.TokenFactoryKeeper)
+	policy := types.NewDenomSupplyPolicy(math.NewInt(100), math.ZeroInt(), 0)
+	_, err = msgServer.SetDenomSupplyPolicy(ctx, types.NewMsgSetDenomSupplyPolicy(creator.String(), validDenomStr, policy))
+	require.NoError(t, err)
+
+	lucky := RandomAccountAddress()
+	mint := func(amount int64) error {
+		return wasmbinding.PerformMint(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, &bindings.MintTokens{
+			Denom:         validDenomStr,
+			Amount:        math.NewInt(amount),
+			MintToAddress: lucky.String(),
+		})
+	}
+
+	// when within the max supply
+	require.NoError(t, mint(100))
+	// then
+	require.Equal(t, math.NewInt(100), tokenz.BankKeeper.GetBalance(ctx, lucky, validDenomStr).Amount)
+
+	// when above the max supply
+	err = mint(1)
+	// then
+	require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+	require.Equal(t, math.NewInt(100), tokenz.BankKeeper.GetBalance(ctx, lucky, validDenomStr).Amount)
+}
+
+func TestDenomRoles(t *testing.T) {
+	creator := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, creator, tokenCreationFeeAmt)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+
+	minter := RandomAccountAddress()
+	manager := RandomAccountAddress()
+	mint := func(amount int64) error {
+		return wasmbinding.PerformMint(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, minter, &bindings.MintTokens{
+			Denom:         validDenomStr,
+			Amount:        math.NewInt(amount),
+			MintToAddress: minter.String(),
+		})
+	}
+	setMetadata := func() error {
+		return wasmbinding.PerformSetMetadata(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, manager, validDenomStr, bindings.Metadata{
+			DenomUnits: []bindings.DenomUnit{{Denom: validDenomStr}},
+			Display:    validDenomStr,
+			Name:       "Moon",
+			Symbol:     "MOON",
+		})
+	}
+	queryPlugin := wasmbinding.NewQueryPlugin(&tokenz.BankKeeper, &tokenz.TokenFactoryKeeper)
+
+	// without roles
+	require.ErrorIs(t, mint(1), types.ErrUnauthorized)
+	require.Error(t, setMetadata())
+	res, err := queryPlugin.GetDenomRoles(ctx, validDenomStr)
+	require.NoError(t, err)
+	require.Equal(t, &bindings.DenomRolesResponse{
+		Admin:             creator.String(),
+		Minters:           []bindings.DenomMinter{},
+		Burners:           []string{},
+		MetadataManagers:  []string{},
+		ForceTransferrers: []string{},
+	}, res)
+
+	// when granted
+	allowance := math.NewInt(10)
+	err = wasmbinding.PerformGrantDenomRole(&tokenz.TokenFactoryKeeper, ctx, creator, &bindings.GrantDenomRole{
+		Denom:         validDenomStr,
+		Role:          types.RoleMinter,
+		Address:       minter.String(),
+		MintAllowance: &allowance,
+	})
+	require.NoError(t, err)
+	err = wasmbinding.PerformGrantDenomRole(&tokenz.TokenFactoryKeeper, ctx, creator, &bindings.GrantDenomRole{
+		Denom:   validDenomStr,
+		Role:    types.RoleMetadataManager,
+		Address: manager.String(),
+	})
+	require.NoError(t, err)
+	// then
+	require.NoError(t, mint(4))
+	require.ErrorIs(t, mint(7), types.ErrMintAllowanceExceeded)
+	require.NoError(t, setMetadata())
+	remaining := math.NewInt(6)
+	res, err = queryPlugin.GetDenomRoles(ctx, validDenomStr)
+	require.NoError(t, err)
+	require.Equal(t, &bindings.DenomRolesResponse{
+		Admin:             creator.String(),
+		Minters:           []bindings.DenomMinter{{Address: minter.String(), MintAllowance: &remaining}},
+		Burners:           []string{},
+		MetadataManagers:  []string{manager.String()},
+		ForceTransferrers: []string{},
+	}, res)
+
+	// when granted by non admin
+	err = wasmbinding.PerformGrantDenomRole(&tokenz.TokenFactoryKeeper, ctx, minter, &bindings.GrantDenomRole{
+		Denom:   validDenomStr,
+		Role:    types.RoleMinter,
+		Address: minter.String(),
+	})
+	// then
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// when revoked
+	err = wasmbinding.PerformRevokeDenomRole(&tokenz.TokenFactoryKeeper, ctx, creator, &bindings.RevokeDenomRole{
+		Denom:   validDenomStr,
+		Role:    types.RoleMinter,
+		Address: minter.String(),
+	})
+	require.NoError(t, err)
+	// then
+	require.ErrorIs(t, mint(1), types.ErrUnauthorized)
+}
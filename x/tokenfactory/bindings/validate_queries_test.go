@@ -3,6 +3,9 @@ package bindings_test
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -10,6 +13,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	wasmbinding "github.com/CosmWasm/wasmd/x/tokenfactory/bindings"
+	bindings "github.com/CosmWasm/wasmd/x/tokenfactory/bindings/types"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -69,7 +75,7 @@ func TestDenomAdmin(t *testing.T) {
 	// set token creation fee to zero to make testing easier
 	tfParams := app.TokenFactoryKeeper.GetParams(ctx)
 	tfParams.DenomCreationFee = sdk.NewCoins()
-	app.TokenFactoryKeeper.SetParams(ctx, tfParams)
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
 
 	// create a subdenom via the token factory
 	admin := sdk.AccAddress([]byte("addr1_______________"))
@@ -113,3 +119,53 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestParams(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	tfParams := app.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.EnabledCapabilities = []string{types.EnableSetMetadata}
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(&app.BankKeeper, &app.TokenFactoryKeeper)
+
+	// when
+	resp, err := queryPlugin.GetParams(ctx)
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []string{types.EnableSetMetadata}, resp.Params.EnabledCapabilities)
+	require.Equal(t, wasmbinding.ConvertSdkCoinsToWasmCoins(tfParams.DenomCreationFee), resp.Params.DenomCreationFee)
+}
+
+func TestDenomSupplyPolicy(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	// set token creation fee to zero to make testing easier
+	tfParams := app.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	admin := sdk.AccAddress([]byte("addr1_______________"))
+	tfDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "subdenom")
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(&app.BankKeeper, &app.TokenFactoryKeeper)
+
+	// when no policy
+	resp, err := queryPlugin.GetDenomSupplyPolicy(ctx, tfDenom)
+	// then
+	require.NoError(t, err)
+	require.Equal(t, &bindings.DenomSupplyPolicyResponse{MaxSupply: math.ZeroInt(), MintLimit: math.ZeroInt()}, resp)
+
+	// when set
+	msgServer := keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	policy := types.NewDenomSupplyPolicy(math.NewInt(1_000), math.NewInt(10), time.Hour)
+	_, err = msgServer.SetDenomSupplyPolicy(ctx, types.NewMsgSetDenomSupplyPolicy(admin.String(), tfDenom, policy))
+	require.NoError(t, err)
+	resp, err = queryPlugin.GetDenomSupplyPolicy(ctx, tfDenom)
+	// then
+	require.NoError(t, err)
+	require.Equal(t, &bindings.DenomSupplyPolicyResponse{MaxSupply: math.NewInt(1_000), MintLimit: math.NewInt(10), MintWindowSeconds: 3_600}, resp)
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+const (
+	flagAuthority = "authority"
+	flagExpedite  = "expedite"
+)
+
+// DefaultGovAuthority is set to the gov module address.
+// Extension point for chains to overwrite the default
+var DefaultGovAuthority = sdk.AccAddress(address.Module("gov"))
+
+// NewSubmitUpdateParamsProposalCmd broadcast a gov proposal with a MsgUpdateParams
+func NewSubmitUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal [denom-creation-fee] [denom-creation-gas-consume] [enabled-capabilities] --title [text] --summary [text] --authority [address] [flags]",
+		Short: "Submit a proposal to update the x/tokenfactory params. All params must be supplied, the denom creation fee and the comma separated capabilities can be empty.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomCreationFee, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("denom creation fee: %w", err)
+			}
+			denomCreationGasConsume, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("denom creation gas consume: %w", err)
+			}
+			var enabledCapabilities []string
+			if args[2] != "" {
+				enabledCapabilities = strings.Split(args[2], ",")
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %w", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.NewMsgUpdateParams(authority, types.NewParams(denomCreationFee, denomCreationGasConsume, enabledCapabilities))
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+			depositArg, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			expedite, err := cmd.Flags().GetBool(flagExpedite)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", title, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "Summary of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(flagAuthority, DefaultGovAuthority.String(), "The address of the governance account. Default is the sdk gov module account")
+	cmd.Flags().Bool(flagExpedite, false, "Expedite proposals have shorter voting period but require higher voting threshold")
+	return cmd
+}
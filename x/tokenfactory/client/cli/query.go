@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdBeforeSendHookAddress(),
+		GetCmdDenomSupplyPolicy(),
 	)
 
 	return cmd
@@ -120,3 +122,61 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdBeforeSendHookAddress returns the before send hook contract address of a queried denom
+func GetCmdBeforeSendHookAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook-address [denom] [flags]",
+		Short: "Get the before send hook contract address for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomSupplyPolicy returns the supply policy of a queried denom
+func GetCmdDenomSupplyPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-supply-policy [denom] [flags]",
+		Short: "Get the max supply and mint limit for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomSupplyPolicy(cmd.Context(), &types.QueryDenomSupplyPolicyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
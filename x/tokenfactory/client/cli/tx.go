@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +19,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const flagMintAllowance = "mint-allowance"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +40,11 @@ func GetTxCmd() *cobra.Command {
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewSetDenomSupplyPolicyCmd(),
+		NewGrantDenomRoleCmd(),
+		NewRevokeDenomRoleCmd(),
+		NewSubmitUpdateParamsProposalCmd(),
 	)
 
 	return cmd
@@ -311,6 +321,172 @@ func NewChangeAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the CosmWasm contract called before each transfer of a factory-created denom, an empty address removes it. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			txf := factory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomSupplyPolicyCmd broadcast MsgSetDenomSupplyPolicy
+func NewSetDenomSupplyPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-supply-policy [denom] [max-supply] [mint-limit] [mint-window] [flags]",
+		Short: "Sets the max supply and the mint limit per mint window (e.g. 24h) of a factory-created denom, zero disables a limit. An existing policy can only be tightened. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			txf := factory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+			mintLimit, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid mint limit: %s", args[2])
+			}
+			mintWindow, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("mint window: %w", err)
+			}
+
+			msg := types.NewMsgSetDenomSupplyPolicy(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.NewDenomSupplyPolicy(maxSupply, mintLimit, mintWindow),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantDenomRoleCmd broadcast MsgGrantDenomRole
+func NewGrantDenomRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-denom-role [denom] [role] [address] [flags]",
+		Short: fmt.Sprintf("Grants a role (%s) of a factory-created denom to an address. Must have admin authority to do so.", strings.Join(types.AllDenomRoles(), ", ")),
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			txf := factory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowanceArg, err := cmd.Flags().GetString(flagMintAllowance)
+			if err != nil {
+				return err
+			}
+			var mintAllowance *math.Int
+			if allowanceArg != "" {
+				allowance, ok := math.NewIntFromString(allowanceArg)
+				if !ok {
+					return fmt.Errorf("invalid mint allowance: %s", allowanceArg)
+				}
+				mintAllowance = &allowance
+			}
+
+			msg := types.NewMsgGrantDenomRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				mintAllowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagMintAllowance, "", "The amount a minter can mint, unlimited when empty. Only allowed for the minter role")
+	return cmd
+}
+
+// NewRevokeDenomRoleCmd broadcast MsgRevokeDenomRole
+func NewRevokeDenomRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-denom-role [denom] [role] [address] [flags]",
+		Short: "Revokes a role of a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			txf := factory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeDenomRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
 func NewModifyDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -44,6 +46,59 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	}
 
 	metadata.Admin = admin
+	// nobody can revoke the roles of a renounced denom, so they are revoked with the admin
+	if admin == "" {
+		metadata = types.DenomAuthorityMetadata{}
+	}
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// grantDenomRole grants the role of the denom to the address
+func (k Keeper) grantDenomRole(ctx sdk.Context, denom, role, address string, mintAllowance *math.Int) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.GrantRole(role, address, mintAllowance); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// revokeDenomRole revokes the role of the denom from the address
+func (k Keeper) revokeDenomRole(ctx sdk.Context, denom, role, address string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.RevokeRole(role, address); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// spendMintAllowance deducts the amount from the mint allowance of a minter that is not the
+// admin. Minters without an allowance can mint an unlimited amount.
+func (k Keeper) spendMintAllowance(ctx sdk.Context, metadata types.DenomAuthorityMetadata, minter string, amount sdk.Coin) error {
+	if minter == metadata.Admin {
+		return nil
+	}
+
+	for i, m := range metadata.Minters {
+		if m.Address != minter || m.MintAllowance == nil {
+			continue
+		}
+		if amount.Amount.GT(*m.MintAllowance) {
+			return errorsmod.Wrapf(types.ErrMintAllowanceExceeded, "minting %s exceeds the mint allowance %s", amount.Amount, m.MintAllowance)
+		}
+		allowance := m.MintAllowance.Sub(amount.Amount)
+		metadata.Minters[i].MintAllowance = &allowance
+		return k.setAuthorityMetadata(ctx, amount.Denom, metadata)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+func TestDenomRoles(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	msgServer := keeper.NewMsgServerImpl(wasmApp.TokenFactoryKeeper)
+
+	// set token creation fee to zero to make testing easier
+	params := wasmApp.TokenFactoryKeeper.GetParams(ctx)
+	params.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, wasmApp.TokenFactoryKeeper.SetParams(ctx, params))
+
+	admin := sdk.AccAddress("admin_______________").String()
+	minter := sdk.AccAddress("minter______________").String()
+	burner := sdk.AccAddress("burner______________").String()
+	manager := sdk.AccAddress("manager_____________").String()
+	transferrer := sdk.AccAddress("transferrer_________").String()
+	denom, err := wasmApp.TokenFactoryKeeper.CreateDenom(ctx, admin, "stable")
+	require.NoError(t, err)
+
+	mint := func(sender string, amount int64) error {
+		_, err := msgServer.Mint(ctx, types.NewMsgMint(sender, sdk.NewInt64Coin(denom, amount)))
+		return err
+	}
+	burn := func(sender string, amount int64) error {
+		_, err := msgServer.Burn(ctx, types.NewMsgBurn(sender, sdk.NewInt64Coin(denom, amount)))
+		return err
+	}
+	setMetadata := func(sender string) error {
+		_, err := msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(sender, banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			Name:       "stable",
+			Symbol:     "STABLE",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		}))
+		return err
+	}
+	forceTransfer := func(sender string) error {
+		_, err := msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(sender, sdk.NewInt64Coin(denom, 1), minter, burner))
+		return err
+	}
+	grant := func(sender, role, address string, mintAllowance *math.Int) error {
+		_, err := msgServer.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(sender, denom, role, address, mintAllowance))
+		return err
+	}
+	revoke := func(sender, role, address string) error {
+		_, err := msgServer.RevokeDenomRole(ctx, types.NewMsgRevokeDenomRole(sender, denom, role, address))
+		return err
+	}
+
+	// without roles only the admin is authorized
+	require.ErrorIs(t, mint(minter, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, burn(burner, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, setMetadata(manager), types.ErrUnauthorized)
+	require.ErrorIs(t, forceTransfer(transferrer), types.ErrUnauthorized)
+
+	// when granted by non admin
+	err = grant(minter, types.RoleMinter, minter, nil)
+	// then
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// when granted by admin
+	allowance := math.NewInt(100)
+	require.NoError(t, grant(admin, types.RoleMinter, minter, &allowance))
+	require.NoError(t, grant(admin, types.RoleBurner, burner, nil))
+	require.NoError(t, grant(admin, types.RoleMetadataManager, manager, nil))
+	require.NoError(t, grant(admin, types.RoleForceTransferrer, transferrer, nil))
+	// then
+	remaining := math.NewInt(40)
+	require.NoError(t, mint(minter, 60))
+	res, err := wasmApp.TokenFactoryKeeper.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAuthorityMetadata{
+		Admin:             admin,
+		Minters:           []types.DenomMinter{{Address: minter, MintAllowance: &remaining}},
+		Burners:           []string{burner},
+		MetadataManagers:  []string{manager},
+		ForceTransferrers: []string{transferrer},
+	}, res.AuthorityMetadata)
+	require.ErrorIs(t, mint(minter, 41), types.ErrMintAllowanceExceeded)
+	require.NoError(t, mint(admin, 1_000))
+	require.NoError(t, setMetadata(manager))
+	require.NoError(t, forceTransfer(transferrer))
+	require.NoError(t, burn(burner, 1))
+	require.Equal(t, math.ZeroInt(), wasmApp.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(burner), denom).Amount)
+
+	// and each role holds a single permission
+	require.ErrorIs(t, burn(minter, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, mint(burner, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, forceTransfer(manager), types.ErrUnauthorized)
+	require.ErrorIs(t, setMetadata(transferrer), types.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(minter, denom, minter))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// and the roles are exported with the denom
+	genesis := wasmApp.TokenFactoryKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.FactoryDenoms, 1)
+	require.Equal(t, res.AuthorityMetadata.Minters[0].Address, genesis.FactoryDenoms[0].AuthorityMetadata.Minters[0].Address)
+	require.Equal(t, []string{burner}, genesis.FactoryDenoms[0].AuthorityMetadata.Burners)
+
+	// when granted again
+	require.NoError(t, grant(admin, types.RoleMinter, minter, nil))
+	// then the allowance is replaced
+	require.NoError(t, mint(minter, 1_000))
+
+	// when revoked
+	require.NoError(t, revoke(admin, types.RoleMinter, minter))
+	require.NoError(t, revoke(admin, types.RoleBurner, burner))
+	// then
+	require.ErrorIs(t, mint(minter, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, burn(burner, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, revoke(admin, types.RoleBurner, burner), types.ErrInvalidDenomRole)
+	require.ErrorIs(t, grant(admin, types.RoleBurner, burner, &allowance), types.ErrInvalidDenomRole)
+
+	// when the admin is renounced
+	require.NoError(t, grant(admin, types.RoleMinter, minter, nil))
+	require.NoError(t, grant(admin, types.RoleBurner, burner, nil))
+	require.NoError(t, grant(admin, types.RoleMetadataManager, manager, nil))
+	require.NoError(t, grant(admin, types.RoleForceTransferrer, transferrer, nil))
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, denom, ""))
+	require.NoError(t, err)
+	// then the roles are revoked as well
+	res, err = wasmApp.TokenFactoryKeeper.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAuthorityMetadata{}, res.AuthorityMetadata)
+	require.ErrorIs(t, mint(minter, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, burn(burner, 1), types.ErrUnauthorized)
+	require.ErrorIs(t, setMetadata(manager), types.ErrUnauthorized)
+	require.ErrorIs(t, forceTransfer(transferrer), types.ErrUnauthorized)
+	require.ErrorIs(t, grant(admin, types.RoleMinter, minter, nil), types.ErrUnauthorized)
+}
@@ -15,6 +15,11 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	err = k.applySupplyPolicy(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+// setBeforeSendHook binds the denom to the CosmWasm contract called before each of its transfers.
+// An empty address removes the hook.
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cosmwasmAddress); err != nil {
+		return err
+	}
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+	return nil
+}
+
+// GetBeforeSendHook returns the CosmWasm contract address of the before send hook of the denom,
+// or an empty string when the denom has none
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookAddressKey))
+	return string(bz)
+}
+
+// BeforeSendRestriction returns the bank send restriction that calls the before send hook contract
+// of every factory denom of the transfer, blocking the transfer when a contract errors.
+func (k Keeper) BeforeSendRestriction() banktypes.SendRestrictionFn {
+	return func(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+		ctx := sdk.UnwrapSDKContext(goCtx)
+		for _, coin := range amount {
+			if err := k.callBeforeSendHook(ctx, fromAddr, toAddr, coin); err != nil {
+				return nil, err
+			}
+		}
+		return toAddr, nil
+	}
+}
+
+// callBeforeSendHook sudo calls the before send hook contract of the coin denom, if any,
+// with at most BeforeSendHookGasLimit gas
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) (err error) {
+	cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
+	if cosmwasmAddress == "" {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := json.Marshal(types.BlockBeforeSendSudoMsg{
+		BlockBeforeSend: types.BlockBeforeSendMsg{
+			From:   fromAddr.String(),
+			To:     toAddr.String(),
+			Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the hook is limited to the gas left in the parent context, so that nested hooks can not exceed it
+	gasLimit := uint64(types.BeforeSendHookGasLimit)
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	defer func() {
+		r := recover()
+		// charge the gas used by the contract to the parent context
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "tokenfactory before send hook")
+		if r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = types.ErrBeforeSendHookFailed.Wrapf("denom %s: out of gas in location: %s", coin.Denom, oog.Descriptor)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(childCtx, contractAddr, msgBz); err != nil {
+		return types.ErrBeforeSendHookFailed.Wrapf("denom %s: %s", coin.Denom, err)
+	}
+	return nil
+}
@@ -13,7 +13,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if genState.Params.DenomCreationFee == nil {
 		genState.Params.DenomCreationFee = sdk.NewCoins()
 	}
-	k.SetParams(ctx, genState.Params)
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 
 	for _, genDenom := range genState.GetFactoryDenoms() {
 		creator, _, err := types.DeconstructDenom(genDenom.GetDenom())
@@ -28,6 +30,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.SupplyPolicy != nil {
+			err = k.setDenomSupplyPolicy(ctx, genDenom.GetDenom(), *genDenom.SupplyPolicy)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.MintWindow != nil {
+			k.setMintWindow(ctx, genDenom.GetDenom(), *genDenom.MintWindow)
+		}
 	}
 }
 
@@ -44,10 +55,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+		if policy, found := k.GetDenomSupplyPolicy(ctx, denom); found {
+			genDenom.SupplyPolicy = &policy
+		}
+		if window := k.getMintWindow(ctx, denom); !window.Start.IsZero() {
+			genDenom.MintWindow = &window
+		}
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
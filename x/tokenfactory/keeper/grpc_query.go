@@ -28,6 +28,18 @@ func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDeno
 	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: authorityMetadata}, nil
 }
 
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (k Keeper) DenomSupplyPolicy(ctx context.Context, req *types.QueryDenomSupplyPolicyRequest) (*types.QueryDenomSupplyPolicyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policy, _ := k.GetDenomSupplyPolicy(sdkCtx, req.GetDenom())
+	return &types.QueryDenomSupplyPolicyResponse{Policy: policy}, nil
+}
+
 func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	denoms := k.GetDenomsFromCreator(sdkCtx, req.GetCreator())
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/exported"
+	v2 "github.com/CosmWasm/wasmd/x/tokenfactory/migrations/v2"
+	v3 "github.com/CosmWasm/wasmd/x/tokenfactory/migrations/v3"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 migrates the x/tokenfactory module state from the consensus
+// version 1 to version 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace)
+}
+
+// Migrate2to3 migrates the x/tokenfactory module state from the consensus
+// version 2 to version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey)
+}
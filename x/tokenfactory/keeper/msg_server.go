@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
@@ -55,10 +57,15 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(msg.Sender, types.RoleMinter) {
 		return nil, types.ErrUnauthorized
 	}
 
+	err = server.Keeper.spendMintAllowance(ctx, authorityMetadata, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
@@ -87,13 +94,13 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(msg.Sender, types.RoleBurner) {
 		return nil, types.ErrUnauthorized
 	}
 
 	if msg.BurnFromAddress == "" {
 		msg.BurnFromAddress = msg.Sender
-	} else if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableBurnFrom) {
+	} else if !server.Keeper.IsCapabilityEnabled(ctx, types.EnableBurnFrom) {
 		return nil, types.ErrCapabilityNotEnabled
 	}
 
@@ -116,7 +123,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableForceTransfer) {
+	if !server.Keeper.IsCapabilityEnabled(ctx, types.EnableForceTransfer) {
 		return nil, types.ErrCapabilityNotEnabled
 	}
 
@@ -125,7 +132,7 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(msg.Sender, types.RoleForceTransferrer) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -176,7 +183,7 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableSetMetadata) {
+	if !server.Keeper.IsCapabilityEnabled(ctx, types.EnableSetMetadata) {
 		return nil, types.ErrCapabilityNotEnabled
 	}
 
@@ -191,7 +198,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(msg.Sender, types.RoleMetadataManager) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -207,3 +214,141 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.GetCosmwasmAddress()),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) SetDenomSupplyPolicy(goCtx context.Context, msg *types.MsgSetDenomSupplyPolicy) (*types.MsgSetDenomSupplyPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomSupplyPolicy(ctx, msg.Denom, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomSupplyPolicy,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.Policy.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeMintLimit, msg.Policy.MintLimit.String()),
+			sdk.NewAttribute(types.AttributeMintWindow, msg.Policy.MintWindow.String()),
+		),
+	})
+
+	return &types.MsgSetDenomSupplyPolicyResponse{}, nil
+}
+
+func (server msgServer) GrantDenomRole(goCtx context.Context, msg *types.MsgGrantDenomRole) (*types.MsgGrantDenomRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.grantDenomRole(ctx, msg.Denom, msg.Role, msg.Address, msg.MintAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		sdk.NewAttribute(types.AttributeRole, msg.GetRole()),
+		sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+	}
+	if msg.MintAllowance != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMintAllowance, msg.MintAllowance.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgGrantDenomRole, attributes...),
+	})
+
+	return &types.MsgGrantDenomRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeDenomRole(goCtx context.Context, msg *types.MsgRevokeDenomRole) (*types.MsgRevokeDenomRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeDenomRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeDenomRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.GetRole()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+		),
+	})
+
+	return &types.MsgRevokeDenomRoleResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := server.Keeper.GetAuthority()
+	if authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.Keeper.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
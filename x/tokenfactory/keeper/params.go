@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,11 +10,37 @@ import (
 
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return params
+	}
+	if err := proto.Unmarshal(bz, &params); err != nil {
+		panic(err)
+	}
 	return params
 }
 
 // SetParams sets the total set of params.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.ParamsKey), bz)
+	return nil
+}
+
+// IsCapabilityEnabled returns true when the capability is enabled in the params.
+func (k Keeper) IsCapabilityEnabled(ctx sdk.Context, capability string) bool {
+	return types.IsCapabilityEnabled(k.GetParams(ctx).EnabledCapabilities, capability)
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	msgServer := keeper.NewMsgServerImpl(wasmApp.TokenFactoryKeeper)
+
+	authority := wasmApp.TokenFactoryKeeper.GetAuthority()
+	newParams := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 1_000, []string{types.EnableBurnFrom})
+
+	// when
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.AccAddress("other").String(), newParams))
+	// then
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.NotEqual(t, newParams, wasmApp.TokenFactoryKeeper.GetParams(ctx))
+
+	// when
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, newParams))
+	// then
+	require.NoError(t, err)
+	require.Equal(t, newParams, wasmApp.TokenFactoryKeeper.GetParams(ctx))
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+// GetDenomSupplyPolicy returns the supply policy of the denom. An empty policy without limits
+// is returned when the denom has none.
+func (k Keeper) GetDenomSupplyPolicy(ctx sdk.Context, denom string) (types.DenomSupplyPolicy, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomSupplyPolicyKey))
+	if bz == nil {
+		return types.NewDenomSupplyPolicy(math.ZeroInt(), math.ZeroInt(), 0), false
+	}
+
+	var policy types.DenomSupplyPolicy
+	if err := proto.Unmarshal(bz, &policy); err != nil {
+		panic(err)
+	}
+	return policy, true
+}
+
+// setDenomSupplyPolicy stores the supply policy of the denom. An existing policy can only be
+// tightened and the max supply can not be below the current supply.
+func (k Keeper) setDenomSupplyPolicy(ctx sdk.Context, denom string, policy types.DenomSupplyPolicy) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if current, found := k.GetDenomSupplyPolicy(ctx, denom); found {
+		if err := policy.ValidateTightening(current); err != nil {
+			return err
+		}
+	}
+	if supply := k.bankKeeper.GetSupply(ctx, denom); policy.MaxSupply.IsPositive() && supply.Amount.GT(policy.MaxSupply) {
+		return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "current supply %s is above the max supply %s", supply.Amount, policy.MaxSupply)
+	}
+
+	bz, err := proto.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomSupplyPolicyKey), bz)
+	return nil
+}
+
+// getMintWindow returns the mint window of the denom, which is empty when nothing was minted
+// under a mint limit yet
+func (k Keeper) getMintWindow(ctx sdk.Context, denom string) types.MintWindow {
+	window := types.MintWindow{Minted: math.ZeroInt()}
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMintWindowKey))
+	if bz == nil {
+		return window
+	}
+	if err := proto.Unmarshal(bz, &window); err != nil {
+		panic(err)
+	}
+	return window
+}
+
+func (k Keeper) setMintWindow(ctx sdk.Context, denom string, window types.MintWindow) {
+	bz, err := proto.Marshal(&window)
+	if err != nil {
+		panic(err)
+	}
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMintWindowKey), bz)
+}
+
+// applySupplyPolicy checks the amount to mint against the supply policy of the denom and
+// tracks the amount minted in the current mint window
+func (k Keeper) applySupplyPolicy(ctx sdk.Context, amount sdk.Coin) error {
+	policy, found := k.GetDenomSupplyPolicy(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	if policy.MaxSupply.IsPositive() {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+		if supply.Amount.Add(amount.Amount).GT(policy.MaxSupply) {
+			return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "supply %s plus %s is above the max supply %s", supply.Amount, amount.Amount, policy.MaxSupply)
+		}
+	}
+
+	if policy.MintLimit.IsPositive() {
+		window := k.getMintWindow(ctx, amount.Denom)
+		if window.Start.IsZero() || !ctx.BlockTime().Before(window.Start.Add(policy.MintWindow)) {
+			window = types.MintWindow{Start: ctx.BlockTime(), Minted: math.ZeroInt()}
+		}
+		window.Minted = window.Minted.Add(amount.Amount)
+		if window.Minted.GT(policy.MintLimit) {
+			return errorsmod.Wrapf(types.ErrMintLimitExceeded, "minting %s would exceed the mint limit %s per %s", amount.Amount, policy.MintLimit, policy.MintWindow)
+		}
+		k.setMintWindow(ctx, amount.Denom, window)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+func TestDenomSupplyPolicy(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(wasmApp.TokenFactoryKeeper)
+
+	// set token creation fee to zero to make testing easier
+	params := wasmApp.TokenFactoryKeeper.GetParams(ctx)
+	params.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, wasmApp.TokenFactoryKeeper.SetParams(ctx, params))
+
+	admin := sdk.AccAddress("admin_______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	denom, err := wasmApp.TokenFactoryKeeper.CreateDenom(ctx, admin, "stable")
+	require.NoError(t, err)
+
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, amount)))
+		return err
+	}
+	setPolicy := func(sender string, maxSupply, mintLimit int64, mintWindow time.Duration) error {
+		policy := types.NewDenomSupplyPolicy(math.NewInt(maxSupply), math.NewInt(mintLimit), mintWindow)
+		_, err := msgServer.SetDenomSupplyPolicy(ctx, types.NewMsgSetDenomSupplyPolicy(sender, denom, policy))
+		return err
+	}
+
+	// no policy
+	policy, found := wasmApp.TokenFactoryKeeper.GetDenomSupplyPolicy(ctx, denom)
+	require.False(t, found)
+	require.True(t, policy.MaxSupply.IsZero())
+	require.NoError(t, mint(ctx, 100))
+
+	// when set by non admin
+	err = setPolicy(other, 1_000, 300, time.Hour)
+	// then
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// when max supply is below the current supply
+	err = setPolicy(admin, 99, 300, time.Hour)
+	// then
+	require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+
+	// when set by admin
+	require.NoError(t, setPolicy(admin, 1_000, 300, time.Hour))
+	// then
+	policy, found = wasmApp.TokenFactoryKeeper.GetDenomSupplyPolicy(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, types.NewDenomSupplyPolicy(math.NewInt(1_000), math.NewInt(300), time.Hour), policy)
+	res, err := wasmApp.TokenFactoryKeeper.DenomSupplyPolicy(ctx, &types.QueryDenomSupplyPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Policy)
+
+	// then minting is limited per window
+	require.NoError(t, mint(ctx, 200))
+	require.NoError(t, mint(ctx, 100))
+	require.ErrorIs(t, mint(ctx, 1), types.ErrMintLimitExceeded)
+	require.ErrorIs(t, mint(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour-time.Second)), 1), types.ErrMintLimitExceeded)
+
+	// and the limit resets with the next window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, mint(ctx, 300))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, mint(ctx, 300))
+
+	// and the supply is capped
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.ErrorIs(t, mint(ctx, 1), types.ErrMaxSupplyExceeded)
+	require.Equal(t, math.NewInt(1_000), wasmApp.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// when loosened
+	for name, spec := range map[string]struct {
+		maxSupply, mintLimit int64
+		mintWindow           time.Duration
+	}{
+		"higher max supply":   {maxSupply: 1_001, mintLimit: 300, mintWindow: time.Hour},
+		"no max supply":       {maxSupply: 0, mintLimit: 300, mintWindow: time.Hour},
+		"higher mint limit":   {maxSupply: 1_000, mintLimit: 301, mintWindow: time.Hour},
+		"no mint limit":       {maxSupply: 1_000, mintLimit: 0, mintWindow: 0},
+		"shorter mint window": {maxSupply: 1_000, mintLimit: 300, mintWindow: time.Minute},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := setPolicy(admin, spec.maxSupply, spec.mintLimit, spec.mintWindow)
+			// then
+			require.ErrorIs(t, err, types.ErrSupplyPolicyLoosened)
+		})
+	}
+
+	// when tightened
+	require.NoError(t, setPolicy(admin, 1_000, 100, 2*time.Hour))
+	// then
+	policy, _ = wasmApp.TokenFactoryKeeper.GetDenomSupplyPolicy(ctx, denom)
+	require.Equal(t, types.NewDenomSupplyPolicy(math.NewInt(1_000), math.NewInt(100), 2*time.Hour), policy)
+
+	// and the policy is exported with the denom
+	genesis := wasmApp.TokenFactoryKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.FactoryDenoms, 1)
+	require.Equal(t, &policy, genesis.FactoryDenoms[0].SupplyPolicy)
+	// with the current mint window
+	window := types.MintWindow{Start: ctx.BlockTime().Add(-time.Hour), Minted: math.NewInt(300)}
+	require.Equal(t, &window, genesis.FactoryDenoms[0].MintWindow)
+
+	// when imported
+	importedApp := app.Setup(t)
+	importedCtx := importedApp.BaseApp.NewContext(false).WithBlockTime(ctx.BlockTime())
+	importedApp.TokenFactoryKeeper.InitGenesis(importedCtx, *genesis)
+	// then the mint window is restored
+	require.Equal(t, genesis, importedApp.TokenFactoryKeeper.ExportGenesis(importedCtx))
+	_, err = keeper.NewMsgServerImpl(importedApp.TokenFactoryKeeper).Mint(importedCtx.WithBlockTime(window.Start.Add(time.Minute)), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+}
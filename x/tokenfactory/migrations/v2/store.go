@@ -0,0 +1,30 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/exported"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 1 to
+// version 2. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params module and stores them directly into the x/tokenfactory
+// module state.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace) error {
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set([]byte(types.ParamsKey), bz)
+	return nil
+}
@@ -0,0 +1,72 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	v2 "github.com/CosmWasm/wasmd/x/tokenfactory/migrations/v2"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModuleBasic{})
+	var (
+		tokenfactoryStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+		paramsStoreKey       = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+		paramsTStoreKey      = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	)
+	specs := map[string]struct {
+		src types.Params
+	}{
+		"defaults": {
+			src: types.DefaultParams(),
+		},
+		"multiple fee coins": {
+			src: types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("uatom", 2)), 1, nil),
+		},
+		"no fee": {
+			src: types.NewParams(sdk.NewCoins(), 0, nil),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			paramsKeeper := paramskeeper.NewKeeper(cfg.Codec, cfg.Amino, paramsStoreKey, paramsTStoreKey)
+			ctx := testutil.DefaultContextWithKeys(
+				map[string]*storetypes.KVStoreKey{
+					paramstypes.StoreKey: paramsStoreKey,
+					types.StoreKey:       tokenfactoryStoreKey,
+				},
+				map[string]*storetypes.TransientStoreKey{
+					paramstypes.TStoreKey: paramsTStoreKey,
+				},
+				nil,
+			)
+
+			// register legacy parameters
+			params := spec.src
+			subspace := paramsKeeper.Subspace(types.ModuleName)
+			subspace.WithKeyTable(types.ParamKeyTable())
+			subspace.SetParamSet(ctx, &params)
+
+			// when
+			require.NoError(t, v2.MigrateStore(ctx, tokenfactoryStoreKey, subspace))
+
+			k := keeper.NewKeeper(tokenfactoryStoreKey, nil, nil, nil, nil, "")
+			res := k.GetParams(ctx)
+			assert.Equal(t, params.DenomCreationGasConsume, res.DenomCreationGasConsume)
+			assert.True(t, params.DenomCreationFee.Equal(res.DenomCreationFee))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 2 to
+// version 3. Specifically, it enables all capabilities in the params, as they were
+// all enabled by the app before the capabilities became a governance parameter.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	var currParams types.Params
+	if bz := store.Get([]byte(types.ParamsKey)); bz != nil {
+		if err := proto.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.EnabledCapabilities = types.AllCapabilities()
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.ParamsKey), bz)
+	return nil
+}
@@ -0,0 +1,42 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	v3 "github.com/CosmWasm/wasmd/x/tokenfactory/migrations/v3"
+	"github.com/CosmWasm/wasmd/x/tokenfactory/types"
+)
+
+func TestMigrate(t *testing.T) {
+	tokenfactoryStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(tokenfactoryStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	// params stored by the previous version, without capabilities
+	src := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 1, nil)
+	bz, err := proto.Marshal(&src)
+	require.NoError(t, err)
+	ctx.KVStore(tokenfactoryStoreKey).Set([]byte(types.ParamsKey), bz)
+
+	// when
+	require.NoError(t, v3.MigrateStore(ctx, tokenfactoryStoreKey))
+
+	// then
+	k := keeper.NewKeeper(tokenfactoryStoreKey, nil, nil, nil, nil, "")
+	res := k.GetParams(ctx)
+	assert.Equal(t, src.DenomCreationGasConsume, res.DenomCreationGasConsume)
+	assert.True(t, src.DenomCreationFee.Equal(res.DenomCreationFee))
+	assert.Equal(t, types.AllCapabilities(), res.EnabledCapabilities)
+	for _, capability := range types.AllCapabilities() {
+		assert.True(t, k.IsCapabilityEnabled(ctx, capability))
+	}
+}
@@ -1,9 +1,28 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	RoleMinter           = "minter"
+	RoleBurner           = "burner"
+	RoleMetadataManager  = "metadata_manager"
+	RoleForceTransferrer = "force_transferrer"
+)
+
+// AllDenomRoles returns all roles that can be granted by the admin of a denom.
+func AllDenomRoles() []string {
+	return []string{
+		RoleMinter,
+		RoleBurner,
+		RoleMetadataManager,
+		RoleForceTransferrer,
+	}
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
@@ -11,5 +30,132 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	seenMinters := make(map[string]struct{}, len(metadata.Minters))
+	for _, minter := range metadata.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "invalid minter address (%s)", err)
+		}
+		if _, found := seenMinters[minter.Address]; found {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate minter: %s", minter.Address)
+		}
+		seenMinters[minter.Address] = struct{}{}
+		if minter.MintAllowance != nil && minter.MintAllowance.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "negative mint allowance of minter %s", minter.Address)
+		}
+	}
+
+	for _, role := range []string{RoleBurner, RoleMetadataManager, RoleForceTransferrer} {
+		members := *metadata.roleMembers(role)
+		seen := make(map[string]struct{}, len(members))
+		for _, member := range members {
+			if _, err := sdk.AccAddressFromBech32(member); err != nil {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "invalid %s address (%s)", role, err)
+			}
+			if _, found := seen[member]; found {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate %s: %s", role, member)
+			}
+			seen[member] = struct{}{}
+		}
+	}
 	return nil
 }
+
+// HasRole returns true when the address is the admin of the denom or holds the role
+func (metadata DenomAuthorityMetadata) HasRole(address, role string) bool {
+	if address == "" {
+		return false
+	}
+	if address == metadata.Admin {
+		return true
+	}
+
+	if role == RoleMinter {
+		_, found := metadata.minterIndex(address)
+		return found
+	}
+	members := metadata.roleMembers(role)
+	return members != nil && indexOf(*members, address) >= 0
+}
+
+// GrantRole grants the role to the address. A mint allowance is only allowed for the
+// minter role, it replaces the allowance of an existing minter.
+func (metadata *DenomAuthorityMetadata) GrantRole(role, address string, mintAllowance *math.Int) error {
+	if role == RoleMinter {
+		minter := DenomMinter{Address: address, MintAllowance: mintAllowance}
+		if i, found := metadata.minterIndex(address); found {
+			metadata.Minters[i] = minter
+		} else {
+			metadata.Minters = append(metadata.Minters, minter)
+		}
+		return nil
+	}
+
+	members := metadata.roleMembers(role)
+	if members == nil {
+		return errorsmod.Wrapf(ErrInvalidDenomRole, "unknown role: %s", role)
+	}
+	if mintAllowance != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomRole, "mint allowance is only allowed for the %s role", RoleMinter)
+	}
+	if indexOf(*members, address) < 0 {
+		*members = append(*members, address)
+	}
+	return nil
+}
+
+// RevokeRole revokes the role from the address
+func (metadata *DenomAuthorityMetadata) RevokeRole(role, address string) error {
+	if role == RoleMinter {
+		i, found := metadata.minterIndex(address)
+		if !found {
+			return errorsmod.Wrapf(ErrInvalidDenomRole, "%s is not a %s", address, role)
+		}
+		metadata.Minters = append(metadata.Minters[:i], metadata.Minters[i+1:]...)
+		return nil
+	}
+
+	members := metadata.roleMembers(role)
+	if members == nil {
+		return errorsmod.Wrapf(ErrInvalidDenomRole, "unknown role: %s", role)
+	}
+	i := indexOf(*members, address)
+	if i < 0 {
+		return errorsmod.Wrapf(ErrInvalidDenomRole, "%s is not a %s", address, role)
+	}
+	*members = append((*members)[:i], (*members)[i+1:]...)
+	return nil
+}
+
+func (metadata DenomAuthorityMetadata) minterIndex(address string) (int, bool) {
+	for i, minter := range metadata.Minters {
+		if minter.Address == address {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// roleMembers returns the addresses holding a role other than the minter role, or nil for
+// an unknown role
+func (metadata *DenomAuthorityMetadata) roleMembers(role string) *[]string {
+	switch role {
+	case RoleBurner:
+		return &metadata.Burners
+	case RoleMetadataManager:
+		return &metadata.MetadataManagers
+	case RoleForceTransferrer:
+		return &metadata.ForceTransferrers
+	default:
+		return nil
+	}
+}
+
+func indexOf(addresses []string, address string) int {
+	for i, v := range addresses {
+		if v == address {
+			return i
+		}
+	}
+	return -1
+}
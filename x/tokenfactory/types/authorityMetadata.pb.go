@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,11 +26,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin holds every permission and
+// grants the roles, each of which gives a single permission to other addresses.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid cosmwasm address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// minters can mint the denom, optionally up to a mint allowance
+	Minters []DenomMinter `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters" yaml:"minters"`
+	// burners can burn the denom
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// metadata_managers can set the bank metadata of the denom
+	MetadataManagers []string `protobuf:"bytes,4,rep,name=metadata_managers,json=metadataManagers,proto3" json:"metadata_managers,omitempty" yaml:"metadata_managers"`
+	// force_transferrers can force transfer the denom
+	ForceTransferrers []string `protobuf:"bytes,5,rep,name=force_transferrers,json=forceTransferrers,proto3" json:"force_transferrers,omitempty" yaml:"force_transferrers"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +81,85 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []DenomMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManagers() []string {
+	if m != nil {
+		return m.MetadataManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferrers() []string {
+	if m != nil {
+		return m.ForceTransferrers
+	}
+	return nil
+}
+
+// DenomMinter is an address holding the minter role of a denom.
+type DenomMinter struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// mint_allowance is the amount the minter can still mint, unlimited when
+	// empty
+	MintAllowance *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_allowance,json=mintAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"mint_allowance,omitempty" yaml:"mint_allowance"`
+}
+
+func (m *DenomMinter) Reset()         { *m = DenomMinter{} }
+func (m *DenomMinter) String() string { return proto.CompactTextString(m) }
+func (*DenomMinter) ProtoMessage()    {}
+func (*DenomMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52db82570ee68a0a, []int{1}
+}
+func (m *DenomMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMinter.Merge(m, src)
+}
+func (m *DenomMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMinter proto.InternalMessageInfo
+
+func (m *DenomMinter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmwasm.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomMinter)(nil), "cosmwasm.tokenfactory.v1beta1.DenomMinter")
 }
 
 func init() {
@@ -81,22 +167,36 @@ func init() {
 }
 
 var fileDescriptor_52db82570ee68a0a = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4d, 0xce, 0x2f, 0xce,
-	0x2d, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x85, 0x69, 0xd3, 0x43, 0xd6, 0xa6, 0x07, 0xd5, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0xc9, 0x81, 0x34, 0xe5, 0x17, 0xeb, 0x27,
-	0x25, 0x16, 0xa7, 0xc2, 0x6d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9,
-	0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xa2, 0x5b, 0x2a, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99,
-	0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e,
-	0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93,
-	0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x3b, 0xe7, 0x17, 0xe7, 0x86, 0x83, 0x3c, 0x0e, 0xf2,
-	0x46, 0x8a, 0x7e, 0x05, 0x6a, 0x00, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x66,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xaf, 0x20, 0x19, 0x26, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x49, 0x6b, 0xe9, 0x56, 0x4b, 0x3b, 0xd8, 0x12, 0x8b, 0xdd, 0x09, 0x73, 0x90,
+	0x20, 0x32, 0x4b, 0x15, 0x3d, 0xf4, 0xd6, 0xe8, 0xc1, 0x82, 0xb9, 0x2c, 0x82, 0x20, 0x4a, 0x78,
+	0xbb, 0x3b, 0x49, 0x96, 0x66, 0x66, 0xca, 0xcc, 0xc4, 0x9a, 0x6f, 0xe1, 0x47, 0xe8, 0xc7, 0xc9,
+	0xb1, 0x47, 0xf1, 0xb0, 0x48, 0x72, 0x11, 0xbc, 0xed, 0x27, 0x90, 0x99, 0xdd, 0x35, 0xb1, 0x42,
+	0x6f, 0xbb, 0xff, 0xf7, 0xff, 0xfd, 0xdf, 0xcc, 0x9b, 0xe7, 0xbf, 0x4c, 0xa4, 0xe6, 0x57, 0xa0,
+	0x79, 0x68, 0xe4, 0x05, 0x13, 0x43, 0x48, 0x8c, 0x54, 0xb3, 0xf0, 0xcb, 0x49, 0xcc, 0x0c, 0x9c,
+	0x84, 0x30, 0x35, 0x63, 0xa9, 0x32, 0x33, 0xeb, 0x33, 0x03, 0x29, 0x18, 0xa0, 0x97, 0x4a, 0x1a,
+	0x89, 0x8e, 0x6b, 0x8c, 0xae, 0x63, 0xb4, 0xc2, 0x8e, 0x1e, 0x8e, 0xe4, 0x48, 0x3a, 0x67, 0x68,
+	0xbf, 0x4a, 0xe8, 0x28, 0xb0, 0x90, 0xd4, 0x61, 0x0c, 0x9a, 0xfd, 0xed, 0x90, 0xc8, 0x4c, 0x94,
+	0x75, 0xf2, 0xbb, 0xe9, 0x1f, 0xbe, 0x61, 0x42, 0xf2, 0xb3, 0xdb, 0x5d, 0xd1, 0x13, 0x7f, 0x13,
+	0x52, 0x9e, 0x89, 0xb6, 0xd7, 0xf1, 0xba, 0xdb, 0xbd, 0xbd, 0x22, 0xc7, 0xf7, 0x67, 0xc0, 0x27,
+	0xa7, 0xc4, 0xc9, 0x24, 0x2a, 0xcb, 0xe8, 0x93, 0xbf, 0xc5, 0x33, 0x61, 0x98, 0xd2, 0xed, 0x66,
+	0xa7, 0xd5, 0xdd, 0x79, 0xfe, 0x94, 0xde, 0x79, 0x52, 0xea, 0xfa, 0xf5, 0x1d, 0xd2, 0x3b, 0x9c,
+	0xe7, 0xb8, 0x51, 0xe4, 0x78, 0xb7, 0x4c, 0xae, 0x82, 0x48, 0x54, 0x47, 0xa2, 0x67, 0xfe, 0x56,
+	0x3c, 0x55, 0xc2, 0xa6, 0xb7, 0x3a, 0xad, 0xee, 0x76, 0x0f, 0xad, 0xdc, 0x55, 0x81, 0x44, 0xb5,
+	0x05, 0x9d, 0xfb, 0xfb, 0xbc, 0x3a, 0xff, 0x80, 0x83, 0x80, 0x91, 0xe5, 0x36, 0x1c, 0xf7, 0xb8,
+	0xc8, 0x71, 0xbb, 0xea, 0x72, 0xdb, 0x42, 0xa2, 0xbd, 0x5a, 0xeb, 0x57, 0x12, 0x7a, 0xe7, 0xa3,
+	0xa1, 0x54, 0x09, 0x1b, 0x18, 0x05, 0x42, 0x0f, 0x99, 0x52, 0x36, 0x6b, 0xd3, 0x65, 0x1d, 0x17,
+	0x39, 0x7e, 0x54, 0x66, 0xfd, 0xef, 0x21, 0xd1, 0xbe, 0x13, 0xdf, 0xaf, 0x69, 0xa7, 0x1b, 0xbf,
+	0xae, 0xb1, 0x47, 0xae, 0x3d, 0x7f, 0x67, 0xed, 0xf6, 0xf6, 0x72, 0x90, 0xa6, 0x8a, 0x69, 0x5d,
+	0x0d, 0x79, 0xed, 0x72, 0x55, 0x81, 0x44, 0xb5, 0x05, 0x7d, 0xf6, 0x77, 0xed, 0x54, 0x06, 0x30,
+	0x99, 0xc8, 0x2b, 0x10, 0x09, 0x6b, 0x37, 0x1d, 0xf4, 0x6a, 0x9e, 0x63, 0xef, 0x47, 0x8e, 0x0f,
+	0xca, 0xb7, 0xd6, 0xe9, 0x05, 0xcd, 0x64, 0xc8, 0xc1, 0x8c, 0xe9, 0xb9, 0x30, 0x45, 0x8e, 0x0f,
+	0x56, 0xc3, 0x5d, 0xc1, 0x24, 0x7a, 0x60, 0x85, 0xb3, 0xfa, 0xbf, 0x3c, 0x62, 0xef, 0xed, 0x7c,
+	0x11, 0x78, 0x37, 0x8b, 0xc0, 0xfb, 0xb9, 0x08, 0xbc, 0x6f, 0xcb, 0xa0, 0x71, 0xb3, 0x0c, 0x1a,
+	0xdf, 0x97, 0x41, 0xe3, 0x23, 0x1d, 0x65, 0x66, 0x3c, 0x8d, 0x69, 0x22, 0x79, 0xf8, 0x5a, 0x6a,
+	0xfe, 0xc1, 0x6e, 0xb0, 0x7d, 0xe5, 0x34, 0xfc, 0xfa, 0xef, 0x26, 0x9b, 0xd9, 0x25, 0xd3, 0xf1,
+	0x3d, 0xb7, 0x61, 0x2f, 0xfe, 0x0c, 0x00, 0x58, 0x48, 0x9f, 0x75, 0xef, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +221,69 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if !this.Minters[i].Equal(&that1.Minters[i]) {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.MetadataManagers) != len(that1.MetadataManagers) {
+		return false
+	}
+	for i := range this.MetadataManagers {
+		if this.MetadataManagers[i] != that1.MetadataManagers[i] {
+			return false
+		}
+	}
+	if len(this.ForceTransferrers) != len(that1.ForceTransferrers) {
+		return false
+	}
+	for i := range this.ForceTransferrers {
+		if this.ForceTransferrers[i] != that1.ForceTransferrers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomMinter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMinter)
+	if !ok {
+		that2, ok := that.(DenomMinter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if that1.MintAllowance == nil {
+		if this.MintAllowance != nil {
+			return false
+		}
+	} else if !this.MintAllowance.Equal(*that1.MintAllowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +306,47 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForceTransferrers) > 0 {
+		for iNdEx := len(m.ForceTransferrers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceTransferrers[iNdEx])
+			copy(dAtA[i:], m.ForceTransferrers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ForceTransferrers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for iNdEx := len(m.MetadataManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataManagers[iNdEx])
+			copy(dAtA[i:], m.MetadataManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +357,48 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintAllowance != nil {
+		{
+			size := m.MintAllowance.Size()
+			i -= size
+			if _, err := m.MintAllowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +420,47 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for _, s := range m.MetadataManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for _, s := range m.ForceTransferrers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -244,6 +531,254 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, DenomMinter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManagers = append(m.MetadataManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferrers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceTransferrers = append(m.ForceTransferrers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MintAllowance = &v
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
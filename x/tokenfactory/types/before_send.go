@@ -0,0 +1,20 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// BeforeSendHookGasLimit is the maximum gas a before send hook contract can consume for a single coin transfer
+const BeforeSendHookGasLimit = 500_000
+
+// BlockBeforeSendSudoMsg is the sudo message sent to the before send hook contract of a denom
+// for every transfer of it. The transfer is blocked when the contract returns an error.
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BlockBeforeSendMsg `json:"block_before_send"`
+}
+
+type BlockBeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
@@ -1,16 +1,23 @@
 package types
 
+import "fmt"
+
 const (
 	EnableSetMetadata   = "enable_metadata"
 	EnableForceTransfer = "enable_force_transfer"
 	EnableBurnFrom      = "enable_burn_from"
 )
 
-func IsCapabilityEnabled(enabledCapabilities []string, capability string) bool {
-	if len(enabledCapabilities) == 0 {
-		return true
+// AllCapabilities returns all capabilities that can be enabled in the params.
+func AllCapabilities() []string {
+	return []string{
+		EnableBurnFrom,
+		EnableForceTransfer,
+		EnableSetMetadata,
 	}
+}
 
+func IsCapabilityEnabled(enabledCapabilities []string, capability string) bool {
 	for _, v := range enabledCapabilities {
 		if v == capability {
 			return true
@@ -19,3 +26,23 @@ func IsCapabilityEnabled(enabledCapabilities []string, capability string) bool {
 
 	return false
 }
+
+func validateEnabledCapabilities(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, capability := range v {
+		if !IsCapabilityEnabled(AllCapabilities(), capability) {
+			return fmt.Errorf("unknown capability: %s", capability)
+		}
+		if _, found := seen[capability]; found {
+			return fmt.Errorf("duplicate capability: %s", capability)
+		}
+		seen[capability] = struct{}{}
+	}
+
+	return nil
+}
@@ -16,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, "osmosis/tokenfactory/burn", nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-bef-send-hook", nil)
+	cdc.RegisterConcrete(&MsgSetDenomSupplyPolicy{}, "osmosis/tokenfactory/set-supply-policy", nil)
+	cdc.RegisterConcrete(&MsgGrantDenomRole{}, "osmosis/tokenfactory/grant-denom-role", nil)
+	cdc.RegisterConcrete(&MsgRevokeDenomRole{}, "osmosis/tokenfactory/revoke-denom-role", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "osmosis/tokenfactory/update-params", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +31,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurn{},
 		// &MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetBeforeSendHook{},
+		&MsgSetDenomSupplyPolicy{},
+		&MsgGrantDenomRole{},
+		&MsgRevokeDenomRole{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -20,4 +20,12 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
+	ErrBeforeSendHookFailed     = errorsmod.Register(ModuleName, 12, "before send hook rejected the transfer")
+	ErrInvalidAuthority         = errorsmod.Register(ModuleName, 13, "invalid authority")
+	ErrInvalidSupplyPolicy      = errorsmod.Register(ModuleName, 14, "invalid supply policy")
+	ErrSupplyPolicyLoosened     = errorsmod.Register(ModuleName, 15, "supply policy can only be tightened")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 16, "max supply exceeded")
+	ErrMintLimitExceeded        = errorsmod.Register(ModuleName, 17, "mint limit exceeded")
+	ErrInvalidDenomRole         = errorsmod.Register(ModuleName, 18, "invalid denom role")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 19, "mint allowance exceeded")
 )
@@ -15,4 +15,11 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeBeforeSendHook      = "before_send_hook"
+	AttributeMaxSupply           = "max_supply"
+	AttributeMintLimit           = "mint_limit"
+	AttributeMintWindow          = "mint_window"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeMintAllowance       = "mint_allowance"
 )
@@ -45,6 +45,20 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+
+		if denom.SupplyPolicy != nil {
+			if err := denom.SupplyPolicy.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if denom.MintWindow != nil && (denom.MintWindow.Minted.IsNil() || denom.MintWindow.Minted.IsNegative()) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid minted amount of the mint window of denom: %s", denom.GetDenom())
+		}
 	}
 
 	return nil
@@ -83,6 +83,10 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// supply_policy is empty when the denom has no supply policy
+	SupplyPolicy *DenomSupplyPolicy `protobuf:"bytes,3,opt,name=supply_policy,json=supplyPolicy,proto3" json:"supply_policy,omitempty" yaml:"supply_policy"`
+	// mint_window is empty when nothing was minted under a mint limit yet
+	MintWindow *MintWindow `protobuf:"bytes,4,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window,omitempty" yaml:"mint_window"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +136,20 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetSupplyPolicy() *DenomSupplyPolicy {
+	if m != nil {
+		return m.SupplyPolicy
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMintWindow() *MintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "cosmwasm.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +160,36 @@ func init() {
 }
 
 var fileDescriptor_b333539769138b3e = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xce, 0x2f, 0xce,
-	0x2d, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x29, 0xd6, 0x43, 0x56, 0xac, 0x07,
-	0x55, 0x2c, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0x99,
-	0xe2, 0xb7, 0x21, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31,
-	0x25, 0xb1, 0x24, 0x11, 0xaa, 0x4d, 0x0b, 0xbf, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xbb,
-	0x94, 0x8e, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0x5c, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xcc,
-	0xc5, 0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xd7, 0xe5, 0x7a,
-	0x01, 0x60, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x0a, 0x15, 0x72, 0xf1,
-	0x41, 0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b,
-	0x69, 0x13, 0x30, 0x0c, 0xea, 0x12, 0x17, 0x90, 0x1e, 0x27, 0x59, 0x90, 0x91, 0x9f, 0xee, 0xc9,
-	0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x1a, 0xa8, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81,
-	0xf0, 0x91, 0x3c, 0x02, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x83, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x3b, 0x23,
-	0x97, 0x10, 0x3c, 0x24, 0xe3, 0x73, 0xa1, 0x41, 0x29, 0xc1, 0x04, 0xf6, 0xbd, 0x29, 0x01, 0x07,
-	0x83, 0xad, 0x72, 0x44, 0x8f, 0x07, 0x27, 0x45, 0xa8, 0xd3, 0x25, 0x21, 0x16, 0x62, 0x1a, 0xaf,
-	0x14, 0x24, 0x88, 0x11, 0x7b, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x79, 0x9c, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0xbe, 0x73, 0x7e, 0x71, 0x6e, 0x38, 0x28, 0x8a, 0x41, 0x6e, 0x4b, 0xd1, 0xaf,
-	0x40, 0x8d, 0xea, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x14, 0x1b, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x38, 0xec, 0xbf, 0xde, 0xa9, 0x02, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xcf, 0x35, 0xa1, 0x12, 0x97, 0x14, 0xc1, 0xa9, 0x20, 0x13, 0xa9, 0x76, 0xb1, 0x04, 0x2a,
+	0x54, 0xb2, 0xdb, 0xa2, 0x2e, 0xdd, 0x70, 0x2b, 0xc1, 0x52, 0xa9, 0x72, 0x87, 0x4a, 0x2c, 0xd1,
+	0xd9, 0x3e, 0x5c, 0x8b, 0x9c, 0xcf, 0xf8, 0x2e, 0x04, 0x3f, 0x01, 0x2b, 0x3b, 0x0b, 0x4f, 0x83,
+	0x3a, 0x76, 0x64, 0xb2, 0x50, 0xb2, 0x30, 0xfb, 0x09, 0x90, 0xef, 0x8e, 0xc8, 0x21, 0x92, 0xd3,
+	0x2d, 0xf9, 0xfc, 0xfb, 0xf7, 0xdd, 0xef, 0x83, 0xfb, 0x21, 0xe3, 0x74, 0x8a, 0x39, 0x75, 0x05,
+	0xfb, 0x48, 0xd2, 0x0f, 0x38, 0x14, 0x2c, 0x2f, 0xdc, 0xcf, 0x87, 0x01, 0x11, 0xf8, 0xd0, 0x8d,
+	0x49, 0x4a, 0x78, 0xc2, 0x9d, 0x2c, 0x67, 0x82, 0xa1, 0x9d, 0x7f, 0x60, 0xa7, 0x09, 0x76, 0x34,
+	0x78, 0xb8, 0x1d, 0xb3, 0x98, 0x49, 0xa4, 0x5b, 0xff, 0x52, 0xa4, 0xe1, 0x71, 0xbb, 0x03, 0x9e,
+	0x88, 0x6b, 0x96, 0x27, 0xa2, 0x38, 0x27, 0x02, 0x47, 0x58, 0x60, 0x4d, 0x7b, 0xd5, 0x4e, 0xcb,
+	0x70, 0x8e, 0xa9, 0xce, 0x35, 0x3c, 0x68, 0xc7, 0xf2, 0x49, 0x96, 0x8d, 0x8b, 0x0b, 0x36, 0x4e,
+	0xc2, 0x42, 0x31, 0xec, 0x9f, 0x00, 0x0e, 0xde, 0xaa, 0xdd, 0x2e, 0x05, 0x16, 0x04, 0x9d, 0xc2,
+	0x4d, 0x25, 0x69, 0x80, 0x5d, 0xb0, 0xd7, 0x3f, 0x7a, 0xee, 0xb4, 0xee, 0xea, 0x5c, 0x48, 0xb0,
+	0xd7, 0xbb, 0x29, 0xad, 0x8e, 0xaf, 0xa9, 0xe8, 0x13, 0x7c, 0xa0, 0x71, 0xa3, 0x88, 0xa4, 0x8c,
+	0x72, 0x63, 0x63, 0xb7, 0xbb, 0xd7, 0x3f, 0xda, 0x5f, 0x23, 0xa6, 0x93, 0x9c, 0xd5, 0x1c, 0x6f,
+	0xa7, 0x96, 0xac, 0x4a, 0xeb, 0x71, 0x81, 0xe9, 0xf8, 0xc4, 0x5e, 0x16, 0xb4, 0xfd, 0x2d, 0x3d,
+	0x38, 0x53, 0xff, 0xbf, 0x77, 0x17, 0x8b, 0xc8, 0x09, 0x7a, 0x01, 0xef, 0x49, 0xa8, 0xdc, 0xe3,
+	0xbe, 0xf7, 0xb0, 0x2a, 0xad, 0x81, 0x52, 0x92, 0x63, 0xdb, 0x57, 0x9f, 0xd1, 0x57, 0x00, 0xd1,
+	0xe2, 0xed, 0x47, 0x54, 0x3f, 0xbe, 0xb1, 0x21, 0xb7, 0x3f, 0x5e, 0x13, 0x58, 0x5a, 0xbd, 0xf9,
+	0xbf, 0x39, 0xef, 0x99, 0x8e, 0xfe, 0x54, 0x19, 0xae, 0xca, 0xdb, 0xfe, 0xa3, 0x95, 0xbe, 0x11,
+	0x83, 0x5b, 0xaa, 0xa1, 0x51, 0x26, 0x2b, 0x32, 0xba, 0x32, 0xc3, 0xc1, 0x5d, 0x32, 0x5c, 0x36,
+	0xaa, 0xf5, 0x8c, 0xaa, 0xb4, 0xb6, 0x95, 0xf5, 0x92, 0xa0, 0xed, 0x0f, 0x9a, 0x27, 0x80, 0x02,
+	0xd8, 0xa7, 0x49, 0x2a, 0x46, 0xd3, 0x24, 0x8d, 0xd8, 0xd4, 0xe8, 0x49, 0xbb, 0x97, 0x6b, 0xec,
+	0xce, 0x93, 0x54, 0x5c, 0x49, 0x82, 0xf7, 0xa4, 0x2a, 0x2d, 0xa4, 0x7c, 0x1a, 0x3a, 0xb6, 0x0f,
+	0xe9, 0x02, 0x73, 0xd2, 0xfb, 0xf3, 0xc3, 0x02, 0xde, 0xbb, 0x9b, 0x99, 0x09, 0x6e, 0x67, 0x26,
+	0xf8, 0x3d, 0x33, 0xc1, 0xb7, 0xb9, 0xd9, 0xb9, 0x9d, 0x9b, 0x9d, 0x5f, 0x73, 0xb3, 0xf3, 0xde,
+	0x89, 0x13, 0x71, 0x3d, 0x09, 0x9c, 0x90, 0x51, 0xf7, 0x94, 0x71, 0x7a, 0x55, 0x5f, 0x6f, 0xed,
+	0x1e, 0xb9, 0x5f, 0x96, 0xaf, 0x58, 0x14, 0x19, 0xe1, 0xc1, 0xa6, 0xbc, 0xdb, 0xd7, 0x7f, 0x07,
+	0x00, 0xec, 0x05, 0x75, 0x0a, 0xb0, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +217,12 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.SupplyPolicy.Equal(that1.SupplyPolicy) {
+		return false
+	}
+	if !this.MintWindow.Equal(that1.MintWindow) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +292,30 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintWindow != nil {
+		{
+			size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SupplyPolicy != nil {
+		{
+			size, err := m.SupplyPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +376,14 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.SupplyPolicy != nil {
+		l = m.SupplyPolicy.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MintWindow != nil {
+		l = m.MintWindow.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +604,78 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupplyPolicy == nil {
+				m.SupplyPolicy = &DenomSupplyPolicy{}
+			}
+			if err := m.SupplyPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintWindow == nil {
+				m.MintWindow = &MintWindow{}
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
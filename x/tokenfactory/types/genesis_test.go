@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/stretchr/testify/require"
 
@@ -33,6 +36,128 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid supply policy",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						SupplyPolicy: &types.DenomSupplyPolicy{
+							MaxSupply:  math.NewInt(21_000_000),
+							MintLimit:  math.NewInt(1_000),
+							MintWindow: time.Hour,
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid supply policy",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						SupplyPolicy: &types.DenomSupplyPolicy{
+							MaxSupply: math.NewInt(21_000_000),
+							MintLimit: math.NewInt(1_000),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid mint window",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MintWindow: &types.MintWindow{
+							Start:  time.Unix(1_700_000_000, 0).UTC(),
+							Minted: math.NewInt(1_000),
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid mint window",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MintWindow: &types.MintWindow{
+							Start:  time.Unix(1_700_000_000, 0).UTC(),
+							Minted: math.NewInt(-1),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:   "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Minters: []types.DenomMinter{{Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"}},
+							Burners: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate minter",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Minters: []types.DenomMinter{
+								{Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+								{Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid metadata manager address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:            "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							MetadataManagers: []string{"invalid"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "different admin from creator",
 			genState: &types.GenesisState{
@@ -29,6 +29,10 @@ var (
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
+	BeforeSendHookAddressKey  = "beforesendhook"
+	DenomSupplyPolicyKey      = "supplypolicy"
+	DenomMintWindowKey        = "mintwindow"
+	ParamsKey                 = "params"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -10,12 +10,17 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom          = "create_denom"
+	TypeMsgMint                 = "tf_mint"
+	TypeMsgBurn                 = "tf_burn"
+	TypeMsgForceTransfer        = "force_transfer"
+	TypeMsgChangeAdmin          = "change_admin"
+	TypeMsgSetDenomMetadata     = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook    = "set_before_send_hook"
+	TypeMsgSetDenomSupplyPolicy = "set_denom_supply_policy"
+	TypeMsgGrantDenomRole       = "grant_denom_role"
+	TypeMsgRevokeDenomRole      = "revoke_denom_role"
+	TypeMsgUpdateParams         = "update_params"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -277,3 +282,205 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set or, with an empty contract address, remove the before send hook of a denom
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomSupplyPolicy{}
+
+// NewMsgSetDenomSupplyPolicy creates a message to set or tighten the supply policy of a denom
+func NewMsgSetDenomSupplyPolicy(sender, denom string, policy DenomSupplyPolicy) *MsgSetDenomSupplyPolicy {
+	return &MsgSetDenomSupplyPolicy{
+		Sender: sender,
+		Denom:  denom,
+		Policy: policy,
+	}
+}
+
+func (m MsgSetDenomSupplyPolicy) Route() string { return RouterKey }
+func (m MsgSetDenomSupplyPolicy) Type() string  { return TypeMsgSetDenomSupplyPolicy }
+func (m MsgSetDenomSupplyPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return m.Policy.Validate()
+}
+
+func (m MsgSetDenomSupplyPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomSupplyPolicy) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantDenomRole{}
+
+// NewMsgGrantDenomRole creates a message to grant a role of a denom to an address. The mint
+// allowance is only allowed for the minter role, nil for no limit.
+func NewMsgGrantDenomRole(sender, denom, role, address string, mintAllowance *math.Int) *MsgGrantDenomRole {
+	return &MsgGrantDenomRole{
+		Sender:        sender,
+		Denom:         denom,
+		Role:          role,
+		Address:       address,
+		MintAllowance: mintAllowance,
+	}
+}
+
+func (m MsgGrantDenomRole) Route() string { return RouterKey }
+func (m MsgGrantDenomRole) Type() string  { return TypeMsgGrantDenomRole }
+func (m MsgGrantDenomRole) ValidateBasic() error {
+	if err := validateDenomRoleMsg(m.Sender, m.Denom, m.Role, m.Address); err != nil {
+		return err
+	}
+
+	if m.MintAllowance != nil {
+		if m.Role != RoleMinter {
+			return errorsmod.Wrapf(ErrInvalidDenomRole, "mint allowance is only allowed for the %s role", RoleMinter)
+		}
+		if m.MintAllowance.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidDenomRole, "mint allowance must not be negative")
+		}
+	}
+
+	return nil
+}
+
+func (m MsgGrantDenomRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantDenomRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeDenomRole{}
+
+// NewMsgRevokeDenomRole creates a message to revoke a role of a denom from an address
+func NewMsgRevokeDenomRole(sender, denom, role, address string) *MsgRevokeDenomRole {
+	return &MsgRevokeDenomRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgRevokeDenomRole) Route() string { return RouterKey }
+func (m MsgRevokeDenomRole) Type() string  { return TypeMsgRevokeDenomRole }
+func (m MsgRevokeDenomRole) ValidateBasic() error {
+	return validateDenomRoleMsg(m.Sender, m.Denom, m.Role, m.Address)
+}
+
+func (m MsgRevokeDenomRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeDenomRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateDenomRoleMsg(sender, denom, role, address string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range AllDenomRoles() {
+		if v == role {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(ErrInvalidDenomRole, "unknown role: %s", role)
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a message to update the module params through governance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m MsgUpdateParams) Route() string { return RouterKey }
+func (m MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
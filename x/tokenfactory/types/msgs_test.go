@@ -3,6 +3,7 @@ package types_test
 import (
 	fmt "fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -450,3 +451,466 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty cosmwasm address removes the hook",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid cosmwasm address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgUpdateParams tests if valid/invalid update params messages are properly validated/invalidated
+func TestMsgSetDenomSupplyPolicy(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setDenomSupplyPolicy message
+	baseMsg := types.NewMsgSetDenomSupplyPolicy(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.NewDenomSupplyPolicy(math.NewInt(1_000_000), math.NewInt(1_000), time.Hour),
+	)
+
+	// validate setDenomSupplyPolicy message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_denom_supply_policy")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetDenomSupplyPolicy
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "no limits",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Policy = types.NewDenomSupplyPolicy(math.ZeroInt(), math.ZeroInt(), 0)
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative max supply",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Policy.MaxSupply = math.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unset max supply",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Policy.MaxSupply = math.Int{}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "mint limit without window",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Policy.MintWindow = 0
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "mint window without limit",
+			msg: func() *types.MsgSetDenomSupplyPolicy {
+				msg := *baseMsg
+				msg.Policy.MintLimit = math.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgGrantDenomRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	allowance := math.NewInt(1_000)
+
+	// make a proper grantDenomRole message
+	baseMsg := types.NewMsgGrantDenomRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleMinter,
+		addr2.String(),
+		&allowance,
+	)
+
+	// validate grantDenomRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "grant_denom_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	negativeAllowance := math.NewInt(-1)
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgGrantDenomRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "minter without allowance",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.MintAllowance = nil
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "burner",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Role = types.RoleBurner
+				msg.MintAllowance = nil
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "allowance for non minter role",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Role = types.RoleBurner
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.MintAllowance = &negativeAllowance
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Role = "owner"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Address = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgGrantDenomRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRevokeDenomRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper revokeDenomRole message
+	baseMsg := types.NewMsgRevokeDenomRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleForceTransferrer,
+		addr2.String(),
+	)
+
+	// validate revokeDenomRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "revoke_denom_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRevokeDenomRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRevokeDenomRole {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgRevokeDenomRole {
+				msg := *baseMsg
+				msg.Role = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgRevokeDenomRole {
+				msg := *baseMsg
+				msg.Address = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper updateParams message
+	baseMsg := types.NewMsgUpdateParams(addr1.String(), types.DefaultParams())
+
+	// validate updateParams message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "update_params")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty denom creation fee",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				msg.Params = types.NewParams(sdk.NewCoins(), 0, nil)
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty authority",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				msg.Authority = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom creation fee",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				msg.Params = types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}, 0, nil)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown capability",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				msg.Params.EnabledCapabilities = []string{types.EnableBurnFrom, "enable_everything"}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate capability",
+			msg: func() *types.MsgUpdateParams {
+				msg := *baseMsg
+				msg.Params.EnabledCapabilities = []string{types.EnableBurnFrom, types.EnableBurnFrom}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -13,15 +13,18 @@ var (
 	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
 )
 
-// ParamTable for gamm module.
+// ParamKeyTable returns the key table of the params formerly managed by x/params.
+//
+// Deprecated: the params are stored in the module store, the key table is only used for migration.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins, denomCreationGasFee uint64) Params {
+func NewParams(denomCreationFee sdk.Coins, denomCreationGasFee uint64, enabledCapabilities []string) Params {
 	return Params{
 		DenomCreationFee:        denomCreationFee,
 		DenomCreationGasConsume: denomCreationGasFee,
+		EnabledCapabilities:     enabledCapabilities,
 	}
 }
 
@@ -29,17 +32,22 @@ func DefaultParams() Params {
 	return Params{
 		DenomCreationFee:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)), // 10 TOKEN
 		DenomCreationGasConsume: 2_000_000,
+		EnabledCapabilities:     AllCapabilities(),
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	err := validateDenomCreationFee(p.DenomCreationFee)
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
 
-	return err
+	return validateEnabledCapabilities(p.EnabledCapabilities)
 }
 
 // Implements params.ParamSet.
+//
+// Deprecated: the params are stored in the module store, the param set is only used for migration.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
@@ -31,6 +31,9 @@ type Params struct {
 	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
 	// https://github.com/CosmWasm/wasmd/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// enabled_capabilities lists the optional features (burn from, force
+	// transfer, set metadata) denom admins are allowed to use
+	EnabledCapabilities []string `protobuf:"bytes,3,rep,name=enabled_capabilities,json=enabledCapabilities,proto3" json:"enabled_capabilities,omitempty" yaml:"enabled_capabilities"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +83,13 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnabledCapabilities() []string {
+	if m != nil {
+		return m.EnabledCapabilities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmwasm.tokenfactory.v1beta1.Params")
 }
@@ -89,30 +99,32 @@ func init() {
 }
 
 var fileDescriptor_c2e403a2e90cdef7 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xde, 0x4b, 0x17, 0xb9, 0x9b, 0x4b, 0x10, 0x6c, 0x0b, 0x26, 0x35, 0xab, 0x2a,
-	0x38, 0x43, 0x15, 0x37, 0x2e, 0x1b, 0x50, 0x37, 0x05, 0xe9, 0x46, 0x70, 0x13, 0x4e, 0x92, 0x69,
-	0x1a, 0xea, 0xe4, 0x94, 0xcc, 0x54, 0xcd, 0x23, 0xb8, 0x73, 0xe5, 0x43, 0xf8, 0x24, 0x5d, 0x76,
-	0xe9, 0x2a, 0x4a, 0xfb, 0x06, 0x7d, 0x02, 0x49, 0x32, 0x95, 0x56, 0xc5, 0x55, 0x72, 0x38, 0xff,
-	0xff, 0x9d, 0xff, 0xcc, 0x31, 0x0e, 0x03, 0x14, 0xfc, 0x1e, 0x04, 0xa7, 0x12, 0xc7, 0x2c, 0x19,
-	0x42, 0x20, 0x31, 0xcd, 0xe8, 0x5d, 0xd7, 0x67, 0x12, 0xba, 0x74, 0x02, 0x29, 0x70, 0x41, 0x26,
-	0x29, 0x4a, 0x34, 0xf7, 0xd6, 0x5a, 0xb2, 0xa9, 0x25, 0x4a, 0xdb, 0xda, 0x89, 0x30, 0xc2, 0x52,
-	0x49, 0x8b, 0xbf, 0xca, 0xd4, 0x3a, 0xfd, 0x7d, 0x00, 0x4c, 0xe5, 0x08, 0xd3, 0x58, 0x66, 0x7d,
-	0x26, 0x21, 0x04, 0x09, 0xca, 0xd6, 0x2c, 0x6c, 0x28, 0xbc, 0x8a, 0x57, 0x15, 0xaa, 0x65, 0x55,
-	0x15, 0xf5, 0x41, 0xb0, 0x4f, 0x4e, 0x80, 0x71, 0x52, 0xf5, 0x9d, 0xc7, 0x9a, 0x51, 0xbf, 0x2a,
-	0x73, 0x9b, 0xcf, 0xba, 0x61, 0x86, 0x2c, 0x41, 0xee, 0x05, 0x29, 0x03, 0x19, 0x63, 0xe2, 0x0d,
-	0x19, 0x6b, 0xe8, 0xed, 0x3f, 0x9d, 0x7f, 0xc7, 0x4d, 0xa2, 0xb0, 0x05, 0x68, 0xbd, 0x05, 0x71,
-	0x31, 0x4e, 0x7a, 0xfd, 0x59, 0x6e, 0x6b, 0xab, 0xdc, 0x6e, 0x66, 0xc0, 0x6f, 0xcf, 0x9c, 0xef,
-	0x08, 0xe7, 0xe5, 0xcd, 0xee, 0x44, 0xb1, 0x1c, 0x4d, 0x7d, 0x12, 0x20, 0x57, 0x01, 0xd5, 0xe7,
-	0x48, 0x84, 0x63, 0x2a, 0xb3, 0x09, 0x13, 0x25, 0x4d, 0x0c, 0xfe, 0x97, 0x00, 0x57, 0xf9, 0xcf,
-	0x19, 0x33, 0x87, 0x46, 0xeb, 0x0b, 0x34, 0x02, 0xe1, 0x05, 0x98, 0x88, 0x29, 0x67, 0x8d, 0x5a,
-	0x5b, 0xef, 0xfc, 0xed, 0x1d, 0xcc, 0x72, 0x5b, 0x5f, 0xe5, 0xf6, 0xfe, 0x8f, 0x21, 0x36, 0xf4,
-	0xce, 0x60, 0x77, 0x6b, 0xc0, 0x05, 0x08, 0xb7, 0xea, 0xf4, 0x2e, 0x67, 0x0b, 0x4b, 0x9f, 0x2f,
-	0x2c, 0xfd, 0x7d, 0x61, 0xe9, 0x4f, 0x4b, 0x4b, 0x9b, 0x2f, 0x2d, 0xed, 0x75, 0x69, 0x69, 0x37,
-	0x64, 0x23, 0xbd, 0x8b, 0x82, 0x5f, 0x17, 0x27, 0x2a, 0xee, 0x14, 0xd2, 0x87, 0xed, 0x53, 0x95,
-	0x9b, 0xf8, 0xf5, 0xf2, 0x71, 0x4f, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x82, 0x06, 0x45, 0x70,
-	0x31, 0x02, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0xae, 0x93, 0x40,
+	0x14, 0x86, 0xa1, 0x35, 0x4d, 0xc4, 0x8d, 0xc1, 0x26, 0xb6, 0x35, 0x42, 0x65, 0x85, 0x26, 0x42,
+	0xaa, 0x71, 0xe3, 0x12, 0x12, 0x75, 0xd3, 0xc4, 0xb0, 0x31, 0x71, 0x43, 0x0e, 0x30, 0xa5, 0x93,
+	0x76, 0x38, 0x84, 0x99, 0xaa, 0xbc, 0x85, 0x2b, 0x1f, 0xc2, 0x27, 0xe9, 0xb2, 0x4b, 0x37, 0x72,
+	0x6f, 0xda, 0x37, 0xe8, 0x13, 0xdc, 0x00, 0xd3, 0x1b, 0x7a, 0x6f, 0x73, 0x57, 0x30, 0x73, 0xfe,
+	0xff, 0x3b, 0x67, 0xce, 0xaf, 0xbd, 0x89, 0x91, 0xb3, 0x9f, 0xc0, 0x99, 0x2b, 0x70, 0x45, 0xb2,
+	0x05, 0xc4, 0x02, 0x8b, 0xd2, 0xfd, 0x31, 0x8b, 0x88, 0x80, 0x99, 0x9b, 0x43, 0x01, 0x8c, 0x3b,
+	0x79, 0x81, 0x02, 0xf5, 0x97, 0x27, 0xad, 0xd3, 0xd5, 0x3a, 0x52, 0x3b, 0x19, 0xa6, 0x98, 0x62,
+	0xa3, 0x74, 0xeb, 0xbf, 0xd6, 0x34, 0xf9, 0xf0, 0x70, 0x03, 0xd8, 0x88, 0x25, 0x16, 0x54, 0x94,
+	0x73, 0x22, 0x20, 0x01, 0x01, 0xd2, 0x36, 0xae, 0x6d, 0xc8, 0xc3, 0x96, 0xd7, 0x1e, 0x64, 0xc9,
+	0x68, 0x4f, 0x6e, 0x04, 0x9c, 0xdc, 0x72, 0x62, 0xa4, 0x59, 0x5b, 0xb7, 0xfe, 0xf7, 0xb4, 0xc1,
+	0xd7, 0x66, 0x6e, 0xfd, 0x8f, 0xaa, 0xe9, 0x09, 0xc9, 0x90, 0x85, 0x71, 0x41, 0x40, 0x50, 0xcc,
+	0xc2, 0x05, 0x21, 0x23, 0x75, 0xda, 0xb7, 0x9f, 0xbc, 0x1b, 0x3b, 0x12, 0x5b, 0x83, 0x4e, 0xaf,
+	0x70, 0x7c, 0xa4, 0x99, 0x37, 0xdf, 0x56, 0xa6, 0x72, 0xac, 0xcc, 0x71, 0x09, 0x6c, 0xfd, 0xd1,
+	0xba, 0x8f, 0xb0, 0xfe, 0x5e, 0x99, 0x76, 0x4a, 0xc5, 0x72, 0x13, 0x39, 0x31, 0x32, 0x39, 0xa0,
+	0xfc, 0xbc, 0xe5, 0xc9, 0xca, 0x15, 0x65, 0x4e, 0x78, 0x43, 0xe3, 0xc1, 0xd3, 0x06, 0xe0, 0x4b,
+	0xff, 0x27, 0x42, 0xf4, 0x85, 0x36, 0xb9, 0x03, 0x4d, 0x81, 0x87, 0x31, 0x66, 0x7c, 0xc3, 0xc8,
+	0xa8, 0x37, 0x55, 0xed, 0x47, 0xde, 0xeb, 0x6d, 0x65, 0xaa, 0xc7, 0xca, 0x7c, 0x75, 0x71, 0x88,
+	0x8e, 0xde, 0x0a, 0x9e, 0x9f, 0x35, 0xf8, 0x0c, 0xdc, 0x6f, 0x2b, 0x7a, 0xa0, 0x0d, 0x49, 0x06,
+	0xd1, 0x9a, 0x24, 0x61, 0x0c, 0x39, 0x44, 0x74, 0x4d, 0x05, 0x25, 0x7c, 0xd4, 0x9f, 0xf6, 0xed,
+	0xc7, 0x9e, 0x79, 0xac, 0xcc, 0x17, 0x2d, 0xfd, 0x92, 0xca, 0x0a, 0x9e, 0xc9, 0x6b, 0xbf, 0x73,
+	0xeb, 0x7d, 0xd9, 0xee, 0x0d, 0x75, 0xb7, 0x37, 0xd4, 0xeb, 0xbd, 0xa1, 0xfe, 0x3e, 0x18, 0xca,
+	0xee, 0x60, 0x28, 0xff, 0x0e, 0x86, 0xf2, 0xdd, 0xe9, 0x6c, 0xc4, 0x47, 0xce, 0xbe, 0xd5, 0xb1,
+	0xd7, 0xd9, 0x27, 0xee, 0xaf, 0xf3, 0xf8, 0x9b, 0xed, 0x44, 0x83, 0x26, 0xb0, 0xf7, 0x37, 0x03,
+	0x00, 0xd8, 0xbf, 0x5e, 0x8a, 0x85, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -135,6 +147,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EnabledCapabilities) > 0 {
+		for iNdEx := len(m.EnabledCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnabledCapabilities[iNdEx])
+			copy(dAtA[i:], m.EnabledCapabilities[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.EnabledCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -183,6 +204,12 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if len(m.EnabledCapabilities) > 0 {
+		for _, s := range m.EnabledCapabilities {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -274,6 +301,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledCapabilities = append(m.EnabledCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -297,6 +297,190 @@ func (m *QueryDenomsFromCreatorResponse) GetDenoms() []string {
 	return nil
 }
 
+// QueryBeforeSendHookAddressRequest defines the request structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Reset()         { *m = QueryBeforeSendHookAddressRequest{} }
+func (m *QueryBeforeSendHookAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressRequest) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8606ce711f56ea6, []int{6}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryBeforeSendHookAddressResponse defines the response structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `protobuf:"bytes,1,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Reset()         { *m = QueryBeforeSendHookAddressResponse{} }
+func (m *QueryBeforeSendHookAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressResponse) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8606ce711f56ea6, []int{7}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
+	if m != nil {
+		return m.CosmwasmAddress
+	}
+	return ""
+}
+
+// QueryDenomSupplyPolicyRequest defines the request structure for the
+// DenomSupplyPolicy gRPC query.
+type QueryDenomSupplyPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomSupplyPolicyRequest) Reset()         { *m = QueryDenomSupplyPolicyRequest{} }
+func (m *QueryDenomSupplyPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyPolicyRequest) ProtoMessage()    {}
+func (*QueryDenomSupplyPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8606ce711f56ea6, []int{8}
+}
+func (m *QueryDenomSupplyPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyPolicyRequest.Merge(m, src)
+}
+func (m *QueryDenomSupplyPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomSupplyPolicyResponse defines the response structure for the
+// DenomSupplyPolicy gRPC query. The policy is empty when the denom has none.
+type QueryDenomSupplyPolicyResponse struct {
+	Policy DenomSupplyPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *QueryDenomSupplyPolicyResponse) Reset()         { *m = QueryDenomSupplyPolicyResponse{} }
+func (m *QueryDenomSupplyPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyPolicyResponse) ProtoMessage()    {}
+func (*QueryDenomSupplyPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8606ce711f56ea6, []int{9}
+}
+func (m *QueryDenomSupplyPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyPolicyResponse.Merge(m, src)
+}
+func (m *QueryDenomSupplyPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyPolicyResponse) GetPolicy() DenomSupplyPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DenomSupplyPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmwasm.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmwasm.tokenfactory.v1beta1.QueryParamsResponse")
@@ -304,6 +488,10 @@ func init() {
 	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "cosmwasm.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
 	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "cosmwasm.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "cosmwasm.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
+	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "cosmwasm.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
+	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "cosmwasm.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
+	proto.RegisterType((*QueryDenomSupplyPolicyRequest)(nil), "cosmwasm.tokenfactory.v1beta1.QueryDenomSupplyPolicyRequest")
+	proto.RegisterType((*QueryDenomSupplyPolicyResponse)(nil), "cosmwasm.tokenfactory.v1beta1.QueryDenomSupplyPolicyResponse")
 }
 
 func init() {
@@ -311,43 +499,55 @@ func init() {
 }
 
 var fileDescriptor_d8606ce711f56ea6 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x6a, 0x1b, 0xe9, 0xf8, 0x07, 0x33, 0x16, 0xd1, 0x60, 0x37, 0x3a, 0x52, 0x6d, 0x45,
-	0x77, 0x48, 0xa4, 0x17, 0xa9, 0x62, 0x37, 0x45, 0x0a, 0x5a, 0xd0, 0xbd, 0x08, 0xbd, 0x84, 0x49,
-	0x32, 0xdd, 0x06, 0xbb, 0xfb, 0xb6, 0x3b, 0x13, 0x35, 0x94, 0x5e, 0xbc, 0x78, 0x15, 0xbc, 0xfb,
-	0x15, 0xfc, 0x1a, 0x3d, 0x16, 0x04, 0xf1, 0x14, 0x24, 0xf1, 0xe2, 0xc1, 0x4b, 0x3e, 0x81, 0xec,
-	0xcc, 0xa4, 0xa6, 0x6e, 0x9a, 0x44, 0x7b, 0xca, 0x32, 0xef, 0xf7, 0x7e, 0x7f, 0xde, 0x7b, 0x04,
-	0x2d, 0xd6, 0x40, 0x04, 0x6f, 0x98, 0x08, 0xa8, 0x84, 0x57, 0x3c, 0xdc, 0x64, 0x35, 0x09, 0x71,
-	0x8b, 0xbe, 0x2e, 0x56, 0xb9, 0x64, 0x45, 0xba, 0xd3, 0xe4, 0x71, 0xcb, 0x89, 0x62, 0x90, 0x80,
-	0xe7, 0xfa, 0x50, 0x67, 0x10, 0xea, 0x18, 0x68, 0x7e, 0xd6, 0x07, 0x1f, 0x14, 0x92, 0x26, 0x5f,
-	0xba, 0x29, 0x7f, 0xcd, 0x07, 0xf0, 0xb7, 0x39, 0x65, 0x51, 0x83, 0xb2, 0x30, 0x04, 0xc9, 0x64,
-	0x03, 0x42, 0x61, 0xaa, 0x77, 0x12, 0x4a, 0x10, 0xb4, 0xca, 0x04, 0xd7, 0x5a, 0x87, 0xca, 0x11,
-	0xf3, 0x1b, 0xa1, 0x02, 0x1b, 0xec, 0xd2, 0x68, 0xa7, 0xac, 0x29, 0xb7, 0x20, 0x6e, 0xc8, 0xd6,
-	0x3a, 0x97, 0xac, 0xce, 0x24, 0x1b, 0x94, 0x38, 0xbe, 0x2d, 0x62, 0x31, 0x0b, 0x8c, 0x1d, 0x32,
-	0x8b, 0xf0, 0x8b, 0xc4, 0xc4, 0x73, 0xf5, 0xe8, 0xf1, 0x9d, 0x26, 0x17, 0x92, 0x6c, 0xa0, 0x4b,
-	0x47, 0x5e, 0x45, 0x04, 0xa1, 0xe0, 0xb8, 0x8c, 0xb2, 0xba, 0xf9, 0x8a, 0x75, 0xdd, 0x5a, 0x38,
-	0x5b, 0x9a, 0x77, 0x46, 0xce, 0xc7, 0xd1, 0xed, 0xee, 0xd4, 0x7e, 0xbb, 0x90, 0xf1, 0x4c, 0x2b,
-	0x79, 0x86, 0x88, 0xe2, 0x5e, 0xe5, 0x21, 0x04, 0x2b, 0x7f, 0x47, 0x30, 0x0e, 0xf0, 0x2d, 0x34,
-	0x5d, 0x4f, 0x00, 0x4a, 0x69, 0xc6, 0xbd, 0xd8, 0x6b, 0x17, 0xce, 0xb5, 0x58, 0xb0, 0xfd, 0x80,
-	0xa8, 0x67, 0xe2, 0xe9, 0x32, 0xf9, 0x6c, 0xa1, 0x9b, 0x23, 0xe9, 0x8c, 0xf5, 0xf7, 0x16, 0xc2,
-	0x87, 0xf3, 0xaa, 0x04, 0xa6, 0x6c, 0x72, 0x2c, 0x8d, 0xc9, 0x31, 0x9c, 0xdb, 0xbd, 0x91, 0xe4,
-	0xea, 0xb5, 0x0b, 0x57, 0xb5, 0xb1, 0x34, 0x3d, 0xf1, 0x72, 0xa9, 0x1d, 0x91, 0x75, 0x34, 0xf7,
-	0xc7, 0xb0, 0x78, 0x12, 0x43, 0x50, 0x8e, 0x39, 0x93, 0x10, 0xf7, 0xa3, 0xdf, 0x45, 0x67, 0x6a,
-	0xfa, 0xc5, 0x84, 0xc7, 0xbd, 0x76, 0xe1, 0x82, 0xd6, 0x30, 0x05, 0xe2, 0xf5, 0x21, 0xe4, 0x29,
-	0xb2, 0x8f, 0xa3, 0x33, 0xd1, 0x17, 0x51, 0x56, 0xcd, 0x2a, 0xd9, 0xda, 0xe9, 0x85, 0x19, 0x37,
-	0xd7, 0x6b, 0x17, 0xce, 0x0f, 0xcc, 0x52, 0x10, 0xcf, 0x00, 0x4a, 0xbf, 0xa6, 0xd0, 0xb4, 0x62,
-	0xc3, 0x9f, 0x2c, 0x94, 0xd5, 0xeb, 0xc3, 0xc5, 0x31, 0xd3, 0x49, 0xdf, 0x4f, 0xbe, 0xf4, 0x2f,
-	0x2d, 0xda, 0x26, 0xb9, 0xf7, 0xee, 0xcb, 0x8f, 0x8f, 0xa7, 0x6e, 0xe3, 0x79, 0x3a, 0xc9, 0xf9,
-	0xe2, 0x9f, 0x16, 0xba, 0x3c, 0x7c, 0x2f, 0x78, 0x65, 0x12, 0xf5, 0x91, 0xe7, 0x97, 0x77, 0x4f,
-	0x42, 0x61, 0x02, 0xad, 0xa9, 0x40, 0x2e, 0x7e, 0x3c, 0x26, 0x90, 0x9e, 0x3d, 0xdd, 0x55, 0xbf,
-	0x7b, 0x34, 0x7d, 0x46, 0xf8, 0xab, 0x85, 0x72, 0xa9, 0xfd, 0xe2, 0xe5, 0x89, 0x3d, 0x0e, 0xb9,
-	0xb2, 0xfc, 0xc3, 0xff, 0xec, 0x36, 0xe1, 0x56, 0x55, 0xb8, 0x47, 0x78, 0x79, 0xa2, 0x70, 0x95,
-	0xcd, 0x18, 0x82, 0x8a, 0x39, 0x59, 0xba, 0x6b, 0x3e, 0xf6, 0xdc, 0xb5, 0xfd, 0x8e, 0x6d, 0x1d,
-	0x74, 0x6c, 0xeb, 0x7b, 0xc7, 0xb6, 0x3e, 0x74, 0xed, 0xcc, 0x41, 0xd7, 0xce, 0x7c, 0xeb, 0xda,
-	0x99, 0x0d, 0xc7, 0x6f, 0xc8, 0xad, 0x66, 0xd5, 0xa9, 0x41, 0x40, 0xcb, 0x20, 0x82, 0x97, 0x89,
-	0x42, 0x22, 0x53, 0xa7, 0x6f, 0x8f, 0x2a, 0xc9, 0x56, 0xc4, 0x45, 0x35, 0xab, 0xfe, 0xce, 0xee,
-	0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x49, 0xa8, 0xa8, 0xdd, 0x05, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xaa, 0x54, 0x19, 0xff, 0xc1, 0x08, 0xfe, 0xa9, 0xb2, 0x95, 0x31, 0x28, 0x18, 0xed,
+	0x02, 0x86, 0x8b, 0x02, 0xa1, 0x5b, 0x82, 0x24, 0x48, 0x82, 0xcb, 0xc1, 0x84, 0xcb, 0x66, 0xda,
+	0x0e, 0xa5, 0xa1, 0xbb, 0xb3, 0xec, 0x6c, 0xd5, 0x86, 0x70, 0xd0, 0x8b, 0x57, 0x13, 0xef, 0x7e,
+	0x02, 0x13, 0xbf, 0x06, 0x47, 0x12, 0xa3, 0xf1, 0xd4, 0x18, 0xe0, 0xe2, 0xb5, 0x9f, 0xc0, 0xec,
+	0xcc, 0x2b, 0x16, 0x5a, 0x96, 0x16, 0x4e, 0x6c, 0x66, 0xde, 0xfb, 0xfd, 0x79, 0x6f, 0xde, 0xa3,
+	0x68, 0x24, 0xc7, 0x85, 0xf3, 0x8e, 0x0a, 0xc7, 0x08, 0xf8, 0x3a, 0x73, 0x57, 0x69, 0x2e, 0xe0,
+	0x7e, 0xc5, 0x78, 0x3b, 0x96, 0x65, 0x01, 0x1d, 0x33, 0x36, 0xca, 0xcc, 0xaf, 0xa4, 0x3c, 0x9f,
+	0x07, 0x1c, 0x0f, 0xd4, 0x43, 0x53, 0x8d, 0xa1, 0x29, 0x08, 0x4d, 0xf4, 0x15, 0x78, 0x81, 0xcb,
+	0x48, 0x23, 0xfc, 0x52, 0x49, 0x89, 0x7b, 0x05, 0xce, 0x0b, 0x25, 0x66, 0x50, 0xaf, 0x68, 0x50,
+	0xd7, 0xe5, 0x01, 0x0d, 0x8a, 0xdc, 0x15, 0x70, 0xfb, 0x38, 0x84, 0xe4, 0xc2, 0xc8, 0x52, 0xc1,
+	0x14, 0xd7, 0x01, 0xb3, 0x47, 0x0b, 0x45, 0x57, 0x06, 0x43, 0xec, 0x44, 0xb4, 0x52, 0x5a, 0x0e,
+	0xd6, 0xb8, 0x5f, 0x0c, 0x2a, 0x8b, 0x2c, 0xa0, 0x79, 0x1a, 0xd0, 0x46, 0x8a, 0xe3, 0xd3, 0x3c,
+	0xea, 0x53, 0xa7, 0x2e, 0x67, 0x34, 0x3a, 0x56, 0x94, 0x3d, 0xaf, 0x54, 0x59, 0xe2, 0xa5, 0x62,
+	0x0e, 0x6a, 0x42, 0xfa, 0x10, 0x7e, 0x1d, 0xca, 0x5e, 0x92, 0x30, 0x16, 0xdb, 0x28, 0x33, 0x11,
+	0x90, 0x15, 0x74, 0xe3, 0xd0, 0xa9, 0xf0, 0xb8, 0x2b, 0x18, 0xce, 0xa0, 0xb8, 0xa2, 0xbb, 0xad,
+	0xdd, 0xd7, 0x86, 0x2f, 0x8f, 0x0f, 0xa5, 0x22, 0x2b, 0x9a, 0x52, 0xe9, 0xe6, 0x85, 0xed, 0x6a,
+	0x32, 0x66, 0x41, 0x2a, 0x79, 0x85, 0x88, 0xc4, 0x9e, 0x65, 0x2e, 0x77, 0xd2, 0x47, 0x4d, 0x83,
+	0x02, 0xfc, 0x10, 0x75, 0xe5, 0xc3, 0x00, 0xc9, 0xd4, 0x6d, 0xf6, 0xd4, 0xaa, 0xc9, 0x2b, 0x15,
+	0xea, 0x94, 0x9e, 0x13, 0x79, 0x4c, 0x2c, 0x75, 0x4d, 0xbe, 0x6b, 0xe8, 0x41, 0x24, 0x1c, 0x48,
+	0xff, 0xa4, 0x21, 0x7c, 0x50, 0x61, 0xdb, 0x81, 0x6b, 0xf0, 0x31, 0x71, 0x82, 0x8f, 0xd6, 0xd8,
+	0xe6, 0x60, 0xe8, 0xab, 0x56, 0x4d, 0xde, 0x51, 0xc2, 0x9a, 0xe1, 0x89, 0xd5, 0xdb, 0xd4, 0x55,
+	0xb2, 0x88, 0x06, 0xfe, 0x0b, 0x16, 0x73, 0x3e, 0x77, 0x32, 0x3e, 0xa3, 0x01, 0xf7, 0xeb, 0xd6,
+	0x9f, 0xa0, 0x8b, 0x39, 0x75, 0x02, 0xe6, 0x71, 0xad, 0x9a, 0xbc, 0xa6, 0x38, 0xe0, 0x82, 0x58,
+	0xf5, 0x10, 0xb2, 0x80, 0xf4, 0xe3, 0xe0, 0xc0, 0xfa, 0x08, 0x8a, 0xcb, 0x5a, 0x85, 0x5d, 0x3b,
+	0x3f, 0xdc, 0x6d, 0xf6, 0xd6, 0xaa, 0xc9, 0xab, 0x0d, 0xb5, 0x14, 0xc4, 0x82, 0x00, 0xb2, 0x80,
+	0x06, 0x25, 0x98, 0xc9, 0x56, 0xb9, 0xcf, 0x96, 0x99, 0x9b, 0x9f, 0xe7, 0x7c, 0x3d, 0x9d, 0xcf,
+	0xfb, 0x4c, 0x88, 0x4e, 0x5b, 0x53, 0x42, 0x24, 0x0a, 0x0c, 0xd4, 0xcd, 0xa1, 0x9e, 0x7a, 0xf1,
+	0x6d, 0xaa, 0xee, 0x00, 0xf8, 0x6e, 0xad, 0x9a, 0xbc, 0x05, 0xb6, 0x8f, 0x44, 0x10, 0xeb, 0x7a,
+	0xfd, 0x08, 0xf0, 0xc8, 0xcb, 0xc6, 0xb2, 0x2e, 0x37, 0x3c, 0xf4, 0x4e, 0x65, 0x7f, 0xd0, 0x90,
+	0x7e, 0x1c, 0x12, 0x68, 0xb6, 0x51, 0xdc, 0x93, 0x27, 0xf0, 0x7e, 0x46, 0xdb, 0x79, 0x3f, 0x8d,
+	0x48, 0x66, 0x3f, 0x3c, 0x1d, 0xe8, 0x83, 0x42, 0x23, 0x16, 0xc0, 0x8e, 0x7f, 0xbb, 0x84, 0xba,
+	0xa4, 0x06, 0xfc, 0x55, 0x43, 0x71, 0x35, 0x46, 0x78, 0xec, 0x04, 0x96, 0xe6, 0x39, 0x4e, 0x8c,
+	0x77, 0x92, 0xa2, 0xcc, 0x91, 0xa7, 0x1f, 0x7f, 0xec, 0x7f, 0x39, 0xf7, 0x08, 0x0f, 0x19, 0xed,
+	0x2c, 0x1e, 0xfc, 0x57, 0x43, 0x37, 0x5b, 0xcf, 0x07, 0x4e, 0xb7, 0xc3, 0x1e, 0xb9, 0x06, 0x12,
+	0xe6, 0x59, 0x20, 0xc0, 0xd0, 0xbc, 0x34, 0x64, 0xe2, 0x99, 0x13, 0x0c, 0xa9, 0x19, 0x30, 0x36,
+	0xe5, 0xdf, 0x2d, 0xa3, 0x79, 0x9c, 0xf1, 0x2f, 0x0d, 0xf5, 0x36, 0xcd, 0x19, 0x9e, 0x6c, 0x5b,
+	0x63, 0x8b, 0x69, 0x4f, 0x4c, 0x9d, 0x32, 0x1b, 0xcc, 0xcd, 0x4a, 0x73, 0xd3, 0x78, 0xb2, 0x2d,
+	0x73, 0xf6, 0xaa, 0xcf, 0x1d, 0x1b, 0x56, 0x87, 0xb1, 0x09, 0x1f, 0x5b, 0x78, 0x5f, 0x43, 0xfd,
+	0x2d, 0xc7, 0x14, 0xcf, 0xb4, 0x23, 0x2f, 0x6a, 0x5d, 0x24, 0xd2, 0x67, 0x40, 0x00, 0x93, 0x73,
+	0xd2, 0xe4, 0x0c, 0x9e, 0xee, 0xac, 0x83, 0x59, 0x09, 0x6a, 0x0b, 0xe6, 0xe6, 0xed, 0x35, 0xce,
+	0xd7, 0xf1, 0xcf, 0x7a, 0xff, 0x1a, 0x67, 0xb1, 0x83, 0xfe, 0xb5, 0x58, 0x2b, 0x89, 0xa9, 0x53,
+	0x66, 0x83, 0xb5, 0x8c, 0xb4, 0x36, 0x85, 0x5f, 0x74, 0x66, 0x4d, 0xfd, 0x27, 0xb7, 0xd5, 0xba,
+	0x30, 0xe7, 0xb7, 0x77, 0x75, 0x6d, 0x67, 0x57, 0xd7, 0xfe, 0xec, 0xea, 0xda, 0xe7, 0x3d, 0x3d,
+	0xb6, 0xb3, 0xa7, 0xc7, 0x7e, 0xef, 0xe9, 0xb1, 0x95, 0x54, 0xa1, 0x18, 0xac, 0x95, 0xb3, 0xa9,
+	0x1c, 0x77, 0x8c, 0x0c, 0x17, 0xce, 0x9b, 0x90, 0x20, 0x64, 0xc9, 0x1b, 0xef, 0x0f, 0x13, 0x05,
+	0x15, 0x8f, 0x89, 0x6c, 0x5c, 0xfe, 0x2a, 0x78, 0xf6, 0x6f, 0x00, 0x30, 0x27, 0x41, 0x07, 0x56,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -371,6 +571,12 @@ type QueryClient interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for
+	// getting the address registered for the before send hook.
+	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomSupplyPolicy defines a gRPC query method for fetching the supply
+	// policy of a particular denom.
+	DenomSupplyPolicy(ctx context.Context, in *QueryDenomSupplyPolicyRequest, opts ...grpc.CallOption) (*QueryDenomSupplyPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -408,6 +614,24 @@ func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFrom
 	return out, nil
 }
 
+func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error) {
+	out := new(QueryBeforeSendHookAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.tokenfactory.v1beta1.Query/BeforeSendHookAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomSupplyPolicy(ctx context.Context, in *QueryDenomSupplyPolicyRequest, opts ...grpc.CallOption) (*QueryDenomSupplyPolicyResponse, error) {
+	out := new(QueryDenomSupplyPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.tokenfactory.v1beta1.Query/DenomSupplyPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -419,6 +643,12 @@ type QueryServer interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for
+	// getting the address registered for the before send hook.
+	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomSupplyPolicy defines a gRPC query method for fetching the supply
+	// policy of a particular denom.
+	DenomSupplyPolicy(context.Context, *QueryDenomSupplyPolicyRequest) (*QueryDenomSupplyPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -434,6 +664,12 @@ func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req
 func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
 }
+func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
+}
+func (*UnimplementedQueryServer) DenomSupplyPolicy(ctx context.Context, req *QueryDenomSupplyPolicyRequest) (*QueryDenomSupplyPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSupplyPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -493,6 +729,42 @@ func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.tokenfactory.v1beta1.Query/BeforeSendHookAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, req.(*QueryBeforeSendHookAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomSupplyPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSupplyPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSupplyPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.tokenfactory.v1beta1.Query/DenomSupplyPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSupplyPolicy(ctx, req.(*QueryDenomSupplyPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -509,6 +781,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsFromCreator",
 			Handler:    _Query_DenomsFromCreator_Handler,
 		},
+		{
+			MethodName: "BeforeSendHookAddress",
+			Handler:    _Query_BeforeSendHookAddress_Handler,
+		},
+		{
+			MethodName: "DenomSupplyPolicy",
+			Handler:    _Query_DenomSupplyPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmwasm/tokenfactory/v1beta1/query.proto",
@@ -695,6 +975,129 @@ func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBeforeSendHookAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmwasmAddress) > 0 {
+		i -= len(m.CosmwasmAddress)
+		copy(dAtA[i:], m.CosmwasmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmwasmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -775,16 +1178,199 @@ func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -807,12 +1393,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -834,7 +1452,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -857,15 +1475,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -892,7 +1510,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -917,7 +1535,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,15 +1558,15 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -976,7 +1594,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -999,7 +1617,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,17 +1640,17 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1042,24 +1660,105 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1082,7 +1781,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,15 +1804,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1141,7 +1840,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1164,7 +1863,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomSupplyPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +1886,15 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomSupplyPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomSupplyPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,7 +1922,90 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSupplyPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSupplyPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSupplyPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
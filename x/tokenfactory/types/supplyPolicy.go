@@ -0,0 +1,49 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewDenomSupplyPolicy creates a supply policy, zero values disable the respective limit
+func NewDenomSupplyPolicy(maxSupply, mintLimit math.Int, mintWindow time.Duration) DenomSupplyPolicy {
+	return DenomSupplyPolicy{
+		MaxSupply:  maxSupply,
+		MintLimit:  mintLimit,
+		MintWindow: mintWindow,
+	}
+}
+
+func (policy DenomSupplyPolicy) Validate() error {
+	if policy.MaxSupply.IsNil() || policy.MaxSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "max supply must not be negative")
+	}
+	if policy.MintLimit.IsNil() || policy.MintLimit.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint limit must not be negative")
+	}
+	if policy.MintWindow < 0 {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint window must not be negative")
+	}
+	if policy.MintLimit.IsPositive() != (policy.MintWindow > 0) {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint limit and mint window must be set together")
+	}
+	return nil
+}
+
+// ValidateTightening returns an error when the policy loosens any limit of the current policy
+func (policy DenomSupplyPolicy) ValidateTightening(current DenomSupplyPolicy) error {
+	if current.MaxSupply.IsPositive() &&
+		(!policy.MaxSupply.IsPositive() || policy.MaxSupply.GT(current.MaxSupply)) {
+		return errorsmod.Wrapf(ErrSupplyPolicyLoosened, "max supply must be positive and at most %s", current.MaxSupply)
+	}
+	if current.MintLimit.IsPositive() &&
+		(!policy.MintLimit.IsPositive() || policy.MintLimit.GT(current.MintLimit)) {
+		return errorsmod.Wrapf(ErrSupplyPolicyLoosened, "mint limit must be positive and at most %s", current.MintLimit)
+	}
+	if policy.MintWindow < current.MintWindow {
+		return errorsmod.Wrapf(ErrSupplyPolicyLoosened, "mint window must be at least %s", current.MintWindow)
+	}
+	return nil
+}
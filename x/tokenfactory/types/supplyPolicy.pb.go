@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/tokenfactory/v1beta1/supplyPolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomSupplyPolicy specifies the limits the admin of a token factory denom
+// commits to when minting. Once set, the policy can only be tightened.
+type DenomSupplyPolicy struct {
+	// max_supply is the maximum total supply of the denom, zero for no cap
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// mint_limit is the maximum amount minted within one mint window, zero for
+	// no limit
+	MintLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_limit,json=mintLimit,proto3,customtype=cosmossdk.io/math.Int" json:"mint_limit" yaml:"mint_limit"`
+	// mint_window is the duration of a mint window, required with a mint limit
+	MintWindow time.Duration `protobuf:"bytes,3,opt,name=mint_window,json=mintWindow,proto3,stdduration" json:"mint_window" yaml:"mint_window"`
+}
+
+func (m *DenomSupplyPolicy) Reset()         { *m = DenomSupplyPolicy{} }
+func (m *DenomSupplyPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomSupplyPolicy) ProtoMessage()    {}
+func (*DenomSupplyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17a4e86671530515, []int{0}
+}
+func (m *DenomSupplyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSupplyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSupplyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSupplyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSupplyPolicy.Merge(m, src)
+}
+func (m *DenomSupplyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSupplyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSupplyPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSupplyPolicy proto.InternalMessageInfo
+
+func (m *DenomSupplyPolicy) GetMintWindow() time.Duration {
+	if m != nil {
+		return m.MintWindow
+	}
+	return 0
+}
+
+// MintWindow tracks the amount of a denom minted in the current mint window.
+type MintWindow struct {
+	Start  time.Time             `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted" yaml:"minted"`
+}
+
+func (m *MintWindow) Reset()         { *m = MintWindow{} }
+func (m *MintWindow) String() string { return proto.CompactTextString(m) }
+func (*MintWindow) ProtoMessage()    {}
+func (*MintWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17a4e86671530515, []int{1}
+}
+func (m *MintWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintWindow.Merge(m, src)
+}
+func (m *MintWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintWindow proto.InternalMessageInfo
+
+func (m *MintWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DenomSupplyPolicy)(nil), "cosmwasm.tokenfactory.v1beta1.DenomSupplyPolicy")
+	proto.RegisterType((*MintWindow)(nil), "cosmwasm.tokenfactory.v1beta1.MintWindow")
+}
+
+func init() {
+	proto.RegisterFile("cosmwasm/tokenfactory/v1beta1/supplyPolicy.proto", fileDescriptor_17a4e86671530515)
+}
+
+var fileDescriptor_17a4e86671530515 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0x02, 0x95, 0xea, 0xc0, 0xd0, 0x13, 0x48, 0x21, 0x12, 0xbe, 0xea, 0xa6, 0x4e,
+	0x36, 0x0d, 0x5b, 0xc7, 0x50, 0x21, 0x40, 0x20, 0x41, 0x40, 0xaa, 0xd4, 0xa5, 0x72, 0x72, 0xee,
+	0xd5, 0xea, 0xf9, 0xde, 0x29, 0x7e, 0x47, 0x72, 0xdf, 0xa2, 0x23, 0x12, 0x0b, 0x13, 0x9f, 0xa5,
+	0x63, 0x47, 0xc4, 0x70, 0xa0, 0x64, 0x61, 0xee, 0x27, 0x40, 0xb6, 0x2f, 0x6d, 0x80, 0x01, 0xb1,
+	0xd9, 0x7e, 0xfe, 0xfd, 0xff, 0xff, 0xf7, 0xf4, 0xe8, 0xe3, 0x09, 0x58, 0x33, 0x93, 0xd6, 0x08,
+	0x84, 0x33, 0x55, 0x9c, 0xc8, 0x09, 0xc2, 0xb4, 0x16, 0x1f, 0xf6, 0xc6, 0x0a, 0xe5, 0x9e, 0xb0,
+	0x55, 0x59, 0xe6, 0xf5, 0x1b, 0xc8, 0xf5, 0xa4, 0xe6, 0xe5, 0x14, 0x10, 0xa2, 0x47, 0x2b, 0x82,
+	0xaf, 0x13, 0xbc, 0x25, 0xfa, 0xf7, 0x33, 0xc8, 0xc0, 0xff, 0x14, 0xee, 0x14, 0xa0, 0x3e, 0xcb,
+	0x00, 0xb2, 0x5c, 0x09, 0x7f, 0x1b, 0x57, 0x27, 0x22, 0xad, 0xa6, 0x12, 0x35, 0x14, 0x6d, 0x3d,
+	0xfe, 0xb3, 0x8e, 0xda, 0x28, 0x8b, 0xd2, 0x94, 0xe1, 0x43, 0xf2, 0x69, 0x83, 0x6e, 0x1f, 0xa8,
+	0x02, 0xcc, 0xbb, 0xb5, 0x44, 0xd1, 0x5b, 0x4a, 0x8d, 0x9c, 0x1f, 0x87, 0x94, 0x3d, 0xb2, 0x43,
+	0x76, 0xb7, 0x86, 0x83, 0x8b, 0x26, 0xee, 0x7c, 0x6b, 0xe2, 0x07, 0x2e, 0x27, 0x58, 0x9b, 0x9e,
+	0x71, 0x0d, 0xc2, 0x48, 0x3c, 0xe5, 0x2f, 0x0a, 0xbc, 0x6a, 0xe2, 0xed, 0x5a, 0x9a, 0x7c, 0x3f,
+	0xb9, 0x01, 0x93, 0xd1, 0x96, 0x91, 0xf3, 0x20, 0xec, 0x25, 0x75, 0x81, 0xc7, 0xb9, 0x36, 0x1a,
+	0x7b, 0x1b, 0xff, 0x27, 0x79, 0x0d, 0x3a, 0x49, 0x5d, 0xe0, 0x2b, 0x77, 0x8e, 0x8e, 0x68, 0xd7,
+	0x57, 0x66, 0xba, 0x48, 0x61, 0xd6, 0xbb, 0xb5, 0x43, 0x76, 0xbb, 0x83, 0x87, 0x3c, 0xb4, 0xcc,
+	0x57, 0x2d, 0xf3, 0x83, 0x76, 0x24, 0x43, 0xe6, 0xec, 0xae, 0x9a, 0x38, 0x5a, 0x53, 0x0d, 0x6c,
+	0xf2, 0xf1, 0x7b, 0x4c, 0x46, 0x3e, 0xe0, 0xa1, 0x7f, 0xd8, 0xbf, 0xfd, 0xf3, 0x73, 0x4c, 0x92,
+	0x2f, 0x84, 0xd2, 0xd7, 0xd7, 0x8f, 0xd1, 0x4b, 0x7a, 0xc7, 0xa2, 0x9c, 0xa2, 0x9f, 0x48, 0x77,
+	0xd0, 0xff, 0xcb, 0xea, 0xfd, 0x6a, 0xba, 0xc3, 0x5e, 0xeb, 0x75, 0x37, 0x78, 0x79, 0x2c, 0x39,
+	0x77, 0x2e, 0x41, 0x22, 0x7a, 0x46, 0x37, 0x9d, 0x9d, 0x4a, 0xdb, 0x59, 0xf0, 0x7f, 0xcd, 0xe2,
+	0xde, 0x4d, 0x6a, 0x95, 0x26, 0xa3, 0x96, 0x0e, 0x41, 0x87, 0xcf, 0x2f, 0x16, 0x8c, 0x5c, 0x2e,
+	0x18, 0xf9, 0xb1, 0x60, 0xe4, 0x7c, 0xc9, 0x3a, 0x97, 0x4b, 0xd6, 0xf9, 0xba, 0x64, 0x9d, 0x23,
+	0x9e, 0x69, 0x3c, 0xad, 0xc6, 0x7c, 0x02, 0x46, 0x3c, 0x05, 0x6b, 0x0e, 0xdd, 0x4e, 0xba, 0x35,
+	0x4b, 0xc5, 0xfc, 0xf7, 0xdd, 0xc4, 0xba, 0x54, 0x76, 0xbc, 0xe9, 0x9b, 0x79, 0xf2, 0x6b, 0x00,
+	0x4d, 0x3f, 0x7c, 0x0b, 0xc1, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomSupplyPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomSupplyPolicy)
+	if !ok {
+		that2, ok := that.(DenomSupplyPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.MintLimit.Equal(that1.MintLimit) {
+		return false
+	}
+	if this.MintWindow != that1.MintWindow {
+		return false
+	}
+	return true
+}
+func (this *MintWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintWindow)
+	if !ok {
+		that2, ok := that.(MintWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if !this.Minted.Equal(that1.Minted) {
+		return false
+	}
+	return true
+}
+func (m *DenomSupplyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSupplyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSupplyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MintWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSupplyPolicy(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MintLimit.Size()
+		i -= size
+		if _, err := m.MintLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSupplyPolicy(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSupplyPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSupplyPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomSupplyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovSupplyPolicy(uint64(l))
+	l = m.MintLimit.Size()
+	n += 1 + l + sovSupplyPolicy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintWindow)
+	n += 1 + l + sovSupplyPolicy(uint64(l))
+	return n
+}
+
+func (m *MintWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovSupplyPolicy(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovSupplyPolicy(uint64(l))
+	return n
+}
+
+func sovSupplyPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSupplyPolicy(x uint64) (n int) {
+	return sovSupplyPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomSupplyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSupplyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSupplyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MintWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplyPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSupplyPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSupplyPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSupplyPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSupplyPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSupplyPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSupplyPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSupplyPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSupplyPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"encoding/binary"
 
 	corestoretypes "cosmossdk.io/core/store"
@@ -134,3 +135,108 @@ func (d TxContractsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	txContracts := types.NewTxContracts()
 	return next(types.WithTxContracts(ctx, txContracts), tx, simulate)
 }
+
+// GaslessExecutionAcceptor is the subset of the keeper used by the GaslessTxDecorator
+type GaslessExecutionAcceptor interface {
+	// AcceptGaslessExecution returns true when the contract execution is gasless
+	AcceptGaslessExecution(ctx sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte) bool
+	// GetParams returns the module params with the gasless tx limits
+	GetParams(ctx context.Context) types.Params
+}
+
+// GaslessTxDecorator ante decorator to let transactions pass with zero fee when all their messages execute
+// gasless contracts. The fee decorators are wrapped and skipped for those transactions. The gas cap and
+// rate limit are taken from the module params.
+type GaslessTxDecorator struct {
+	feeDecorators []sdk.AnteDecorator
+	keeper        GaslessExecutionAcceptor
+	storeService  corestoretypes.KVStoreService
+}
+
+// NewGaslessTxDecorator constructor
+func NewGaslessTxDecorator(
+	feeDecorators []sdk.AnteDecorator,
+	k GaslessExecutionAcceptor,
+	s corestoretypes.KVStoreService,
+) *GaslessTxDecorator {
+	return &GaslessTxDecorator{feeDecorators: feeDecorators, keeper: k, storeService: s}
+}
+
+// AnteHandle skips the fee decorators for gasless transactions within the gas cap and the rate limit of the
+// fee payer. All other transactions are handled by the fee decorators.
+func (d GaslessTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	limits := d.keeper.GetParams(ctx).GaslessTxLimits
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !limits.Enabled() || !d.isGaslessTx(ctx, feeTx, limits) {
+		return d.chainFeeDecorators(next)(ctx, tx, simulate)
+	}
+	if err := d.incrementTxCounter(ctx, feeTx.FeePayer(), limits); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d GaslessTxDecorator) isGaslessTx(ctx sdk.Context, tx sdk.FeeTx, limits types.GaslessTxLimits) bool {
+	if !tx.GetFee().IsZero() || tx.GetGas() > limits.MaxGas {
+		return false
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, m := range msgs {
+		msg, ok := m.(*types.MsgExecuteContract)
+		if !ok {
+			return false
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+		if err != nil {
+			return false
+		}
+		senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return false
+		}
+		if !d.keeper.AcceptGaslessExecution(ctx, contractAddr, senderAddr, msg.Msg) {
+			return false
+		}
+	}
+	return true
+}
+
+// incrementTxCounter counts the gasless transactions of the account within the current window
+func (d GaslessTxDecorator) incrementTxCounter(ctx sdk.Context, addr sdk.AccAddress, limits types.GaslessTxLimits) error {
+	store := d.storeService.OpenKVStore(ctx)
+	key := types.GetGaslessTxCounterKey(addr)
+	windowStart := ctx.BlockHeight() - ctx.BlockHeight()%limits.WindowBlocks
+
+	var txCounter uint32
+	bz, err := store.Get(key)
+	if err != nil {
+		return errorsmod.Wrap(err, "read gasless tx counter")
+	}
+	if bz != nil {
+		if lastWindowStart, val := decodeHeightCounter(bz); lastWindowStart == windowStart {
+			txCounter = val
+		}
+	}
+	if txCounter >= limits.MaxTxsPerAccount {
+		return errorsmod.Wrapf(types.ErrGaslessTxLimit, "max %d txs per %d blocks", limits.MaxTxsPerAccount, limits.WindowBlocks)
+	}
+	if err := store.Set(key, encodeHeightCounter(windowStart, txCounter+1)); err != nil {
+		return errorsmod.Wrap(err, "store gasless tx counter")
+	}
+	return nil
+}
+
+// chainFeeDecorators returns the ante handler that runs the fee decorators before next
+func (d GaslessTxDecorator) chainFeeDecorators(next sdk.AnteHandler) sdk.AnteHandler {
+	handler := next
+	for i := len(d.feeDecorators) - 1; i >= 0; i-- {
+		decorator, nextHandler := d.feeDecorators[i], handler
+		handler = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return decorator.AnteHandle(ctx, tx, simulate, nextHandler)
+		}
+	}
+	return handler
+}
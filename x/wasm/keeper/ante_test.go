@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
@@ -311,3 +313,152 @@ func TestTxContractsDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestGaslessTxDecorator(t *testing.T) {
+	keyWasm := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(keyWasm, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	var (
+		gaslessContract = keeper.RandomAccountAddress(t)
+		otherContract   = keeper.RandomAccountAddress(t)
+		sender          = keeper.RandomAccountAddress(t)
+	)
+	executeMsg := func(contract sdk.AccAddress) sdk.Msg {
+		return &types.MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Msg: []byte(`{}`)}
+	}
+	acceptFn := func(ctx sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte) bool {
+		return contractAddr.Equals(gaslessContract)
+	}
+	defaultLimits := types.GaslessTxLimits{MaxGas: 100_000, MaxTxsPerAccount: 2, WindowBlocks: 10}
+
+	specs := map[string]struct {
+		tx         sdk.Tx
+		limits     *types.GaslessTxLimits
+		txsSent    int
+		expGasless bool
+		expErr     *errorsmod.Error
+	}{
+		"gasless contract": {
+			tx:         gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_000, payer: sender},
+			expGasless: true,
+		},
+		"gasless contract with fee": {
+			tx: gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), payer: sender},
+		},
+		"gas above cap": {
+			tx: gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_001, payer: sender},
+		},
+		"gas within raised cap": {
+			tx:         gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_001, payer: sender},
+			limits:     &types.GaslessTxLimits{MaxGas: 200_000, MaxTxsPerAccount: 2, WindowBlocks: 10},
+			expGasless: true,
+		},
+		"gasless txs disabled": {
+			tx:     gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_000, payer: sender},
+			limits: &types.GaslessTxLimits{},
+		},
+		"other contract": {
+			tx: gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract), executeMsg(otherContract)}, gas: 100_000, payer: sender},
+		},
+		"other msg type": {
+			tx: gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract), &types.MsgClearAdmin{Sender: sender.String(), Contract: gaslessContract.String()}}, gas: 100_000, payer: sender},
+		},
+		"no msgs": {
+			tx: gaslessTestTx{gas: 100_000, payer: sender},
+		},
+		"rate limit exceeded": {
+			tx:      gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_000, payer: sender},
+			txsSent: 2,
+			expErr:  types.ErrGaslessTxLimit,
+		},
+		"raised rate limit": {
+			tx:         gaslessTestTx{msgs: []sdk.Msg{executeMsg(gaslessContract)}, gas: 100_000, payer: sender},
+			limits:     &types.GaslessTxLimits{MaxGas: 100_000, MaxTxsPerAccount: 3, WindowBlocks: 10},
+			txsSent:    2,
+			expGasless: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.NewContext(ms.CacheMultiStore(), cmtproto.Header{Height: 100}, false, log.NewNopLogger())
+			var feeDecoratorCalled bool
+			feeDecorator := mockAnteDecorator(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+				feeDecoratorCalled = true
+				return next(ctx, tx, simulate)
+			})
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+			limits := defaultLimits
+			if spec.limits != nil {
+				limits = *spec.limits
+			}
+			acceptor := mockGaslessExecutionAcceptor{acceptFn: acceptFn, params: types.Params{GaslessTxLimits: limits}}
+			ante := keeper.NewGaslessTxDecorator([]sdk.AnteDecorator{feeDecorator}, acceptor, runtime.NewKVStoreService(keyWasm))
+			for i := 0; i < spec.txsSent; i++ {
+				_, err := ante.AnteHandle(ctx, spec.tx, false, next)
+				require.NoError(t, err)
+			}
+			feeDecoratorCalled, nextCalled = false, false
+
+			// when
+			_, gotErr := ante.AnteHandle(ctx, spec.tx, false, next)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			assert.Equal(t, !spec.expGasless, feeDecoratorCalled)
+
+			// and the rate limit window is reset
+			ctx = ctx.WithBlockHeight(110)
+			for i := 0; i < int(limits.MaxTxsPerAccount); i++ {
+				_, err := ante.AnteHandle(ctx, spec.tx, false, next)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type mockGaslessExecutionAcceptor struct {
+	acceptFn func(ctx sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte) bool
+	params   types.Params
+}
+
+func (m mockGaslessExecutionAcceptor) AcceptGaslessExecution(ctx sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte) bool {
+	return m.acceptFn(ctx, contractAddr, caller, msg)
+}
+
+func (m mockGaslessExecutionAcceptor) GetParams(_ context.Context) types.Params {
+	return m.params
+}
+
+type mockAnteDecorator func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (m mockAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return m(ctx, tx, simulate, next)
+}
+
+type gaslessTestTx struct {
+	sdk.FeeTx
+	msgs  []sdk.Msg
+	gas   uint64
+	fee   sdk.Coins
+	payer sdk.AccAddress
+}
+
+func (tx gaslessTestTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx gaslessTestTx) GetGas() uint64 { return tx.gas }
+
+func (tx gaslessTestTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx gaslessTestTx) FeePayer() []byte { return tx.payer }
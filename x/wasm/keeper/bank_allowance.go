@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// GetBankAllowance returns the amount of the denom the spender can transfer from the owner account
+func (k Keeper) GetBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string) sdkmath.Int {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetBankAllowanceKey(owner, spender, denom))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetBankAllowance sets the amount of the denom the spender can transfer from the owner account.
+// A zero amount removes the allowance.
+func (k Keeper) SetBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string, amount sdkmath.Int) error {
+	if amount.IsNegative() {
+		return errorsmod.Wrap(types.ErrInvalid, "negative allowance")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.GetBankAllowanceKey(owner, spender, denom)
+	if amount.IsZero() {
+		return store.Delete(key)
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.Set(key, bz)
+}
+
+// SpendBankAllowance deducts the amount from the allowance of the spender on the owner account
+func (k Keeper) SpendBankAllowance(ctx context.Context, owner, spender sdk.AccAddress, denom string, amount sdkmath.Int) error {
+	allowance := k.GetBankAllowance(ctx, owner, spender, denom)
+	if allowance.LT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientAllowance, "allowance %s%s is less than %s%s", allowance, denom, amount, denom)
+	}
+	return k.SetBankAllowance(ctx, owner, spender, denom, allowance.Sub(amount))
+}
+
+// IterateBankAllowances iterates over all bank allowances granted by the owner. When owner is empty
+// the allowances of all accounts are iterated. The callback method can return true to abort early.
+func (k Keeper) IterateBankAllowances(ctx context.Context, owner sdk.AccAddress, cb func(types.BankAllowance) bool) {
+	keyPrefix := types.BankAllowancePrefix
+	if len(owner) != 0 {
+		keyPrefix = types.GetBankAllowancesPrefix(owner, nil)
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, granter := iter.Key(), owner
+		if len(owner) == 0 {
+			granter, key = key[1:key[0]+1], key[key[0]+1:]
+		}
+		allowance, err := newBankAllowance(granter, key, iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		// cb returns true to stop early
+		if cb(allowance) {
+			break
+		}
+	}
+}
+
+// newBankAllowance decodes a bank allowance from its key without the owner prefix and value
+func newBankAllowance(owner sdk.AccAddress, key, value []byte) (types.BankAllowance, error) {
+	spender, denom, err := types.ParseBankAllowanceKey(key)
+	if err != nil {
+		return types.BankAllowance{}, err
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return types.BankAllowance{}, err
+	}
+	return types.BankAllowance{
+		Owner:   owner.String(),
+		Spender: spender.String(),
+		Denom:   denom,
+		Amount:  amount,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSpendBankAllowance(t *testing.T) {
+	owner, spender := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		allowance    sdkmath.Int
+		spend        sdkmath.Int
+		expRemaining sdkmath.Int
+		expErr       error
+	}{
+		"partial": {
+			allowance:    sdkmath.NewInt(100),
+			spend:        sdkmath.NewInt(40),
+			expRemaining: sdkmath.NewInt(60),
+		},
+		"all": {
+			allowance:    sdkmath.NewInt(100),
+			spend:        sdkmath.NewInt(100),
+			expRemaining: sdkmath.ZeroInt(),
+		},
+		"exceeds allowance": {
+			allowance:    sdkmath.NewInt(100),
+			spend:        sdkmath.NewInt(101),
+			expRemaining: sdkmath.NewInt(100),
+			expErr:       types.ErrInsufficientAllowance,
+		},
+		"no allowance": {
+			allowance:    sdkmath.ZeroInt(),
+			spend:        sdkmath.NewInt(1),
+			expRemaining: sdkmath.ZeroInt(),
+			expErr:       types.ErrInsufficientAllowance,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			require.NoError(t, k.SetBankAllowance(ctx, owner, spender, "denom", spec.allowance))
+
+			gotErr := k.SpendBankAllowance(ctx, owner, spender, "denom", spec.spend)
+			require.ErrorIs(t, gotErr, spec.expErr)
+			assert.Equal(t, spec.expRemaining, k.GetBankAllowance(ctx, owner, spender, "denom"))
+			// other denoms and spenders are not affected
+			assert.True(t, k.GetBankAllowance(ctx, owner, spender, "other").IsZero())
+			assert.True(t, k.GetBankAllowance(ctx, spender, owner, "denom").IsZero())
+		})
+	}
+}
+
+func TestSetBankAllowance(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	owner, spender := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	require.NoError(t, k.SetBankAllowance(ctx, owner, spender, "denom", sdkmath.NewInt(1)))
+	assert.Equal(t, sdkmath.NewInt(1), k.GetBankAllowance(ctx, owner, spender, "denom"))
+
+	// zero removes the entry
+	require.NoError(t, k.SetBankAllowance(ctx, owner, spender, "denom", sdkmath.ZeroInt()))
+	var count int
+	k.IterateBankAllowances(ctx, nil, func(types.BankAllowance) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 0, count)
+
+	gotErr := k.SetBankAllowance(ctx, owner, spender, "denom", sdkmath.NewInt(-1))
+	require.ErrorIs(t, gotErr, types.ErrInvalid)
+}
+
+func TestQueryBankAllowances(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	owner, spender, otherSpender := RandomAccountAddress(t), RandomAccountAddress(t), RandomAccountAddress(t)
+	if string(spender) > string(otherSpender) {
+		spender, otherSpender = otherSpender, spender
+	}
+	require.NoError(t, k.SetBankAllowance(ctx, owner, spender, "alx", sdkmath.NewInt(1)))
+	require.NoError(t, k.SetBankAllowance(ctx, owner, spender, "blx", sdkmath.NewInt(2)))
+	require.NoError(t, k.SetBankAllowance(ctx, owner, otherSpender, "alx", sdkmath.NewInt(3)))
+	require.NoError(t, k.SetBankAllowance(ctx, spender, owner, "alx", sdkmath.NewInt(4)))
+
+	allowance := func(spender sdk.AccAddress, denom string, amount int64) types.BankAllowance {
+		return types.BankAllowance{Owner: owner.String(), Spender: spender.String(), Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	specs := map[string]struct {
+		srcQuery *types.QueryBankAllowancesRequest
+		exp      []types.BankAllowance
+		expErr   bool
+	}{
+		"all of owner": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: owner.String()},
+			exp:      []types.BankAllowance{allowance(spender, "alx", 1), allowance(spender, "blx", 2), allowance(otherSpender, "alx", 3)},
+		},
+		"filtered by spender": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: owner.String(), Spender: otherSpender.String()},
+			exp:      []types.BankAllowance{allowance(otherSpender, "alx", 3)},
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: owner.String(), Spender: spender.String(), Pagination: &query.PageRequest{Limit: 1}},
+			exp:      []types.BankAllowance{allowance(spender, "alx", 1)},
+		},
+		"unknown owner": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: RandomBech32AccountAddress(t)},
+			exp:      []types.BankAllowance{},
+		},
+		"invalid owner": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: "invalid"},
+			expErr:   true,
+		},
+		"invalid spender": {
+			srcQuery: &types.QueryBankAllowancesRequest{Owner: owner.String(), Spender: "invalid"},
+			expErr:   true,
+		},
+		"nil req": {
+			expErr: true,
+		},
+	}
+	q := Querier(k)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.BankAllowances(ctx, spec.srcQuery)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.Allowances)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// RegisterBankPointer registers the ERC20 pointer contract of a bank denom and returns its address.
+// The denom must have a supply or metadata.
+func (k Keeper) RegisterBankPointer(ctx context.Context, denom string) (common.Address, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "denom")
+	}
+	if k.bankViewKeeper.GetSupply(ctx, denom).IsZero() {
+		if _, found := k.bankViewKeeper.GetDenomMetaData(ctx, denom); !found {
+			return common.Address{}, errorsmod.Wrapf(types.ErrNotFound, "denom %s", denom)
+		}
+	}
+	pointer := types.BankPointerAddress(denom)
+	if _, found := k.GetBankPointerDenom(ctx, pointer); found {
+		return common.Address{}, errorsmod.Wrapf(types.ErrDuplicate, "pointer of denom %s", denom)
+	}
+	if err := k.importBankPointer(ctx, denom, pointer); err != nil {
+		return common.Address{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterBankPointer,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyPointer, pointer.Hex()),
+	))
+	return pointer, nil
+}
+
+// importBankPointer stores the pointer and adds it to the pending pointers that are registered as
+// precompiles at the next begin block
+func (k Keeper) importBankPointer(ctx context.Context, denom string, pointer common.Address) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetBankPointerKey(pointer.Bytes()), []byte(denom)); err != nil {
+		return err
+	}
+	return store.Set(types.GetPendingBankPointerKey(pointer.Bytes()), []byte(denom))
+}
+
+// GetBankPointerDenom returns the bank denom of a registered pointer contract address
+func (k Keeper) GetBankPointerDenom(ctx context.Context, pointer common.Address) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetBankPointerKey(pointer.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateBankPointers iterates over all registered bank pointer contracts.
+// The callback method can return true to abort early.
+func (k Keeper) IterateBankPointers(ctx context.Context, cb func(denom string, pointer common.Address) bool) {
+	k.iterateBankPointers(ctx, types.BankPointerPrefix, cb)
+}
+
+// IteratePendingBankPointers iterates over the bank pointer contracts stored since the pending pointers
+// were cleared last. The callback method can return true to abort early.
+func (k Keeper) IteratePendingBankPointers(ctx context.Context, cb func(denom string, pointer common.Address) bool) {
+	k.iterateBankPointers(ctx, types.PendingBankPointerPrefix, cb)
+}
+
+// ClearPendingBankPointers removes all pending bank pointer contracts
+func (k Keeper) ClearPendingBankPointers(ctx context.Context) {
+	var pointers []common.Address
+	k.IteratePendingBankPointers(ctx, func(_ string, pointer common.Address) bool {
+		pointers = append(pointers, pointer)
+		return false
+	})
+	store := k.storeService.OpenKVStore(ctx)
+	for _, pointer := range pointers {
+		if err := store.Delete(types.GetPendingBankPointerKey(pointer.Bytes())); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (k Keeper) iterateBankPointers(ctx context.Context, keyPrefix []byte, cb func(denom string, pointer common.Address) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(string(iter.Value()), common.BytesToAddress(iter.Key())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterBankPointer(t *testing.T) {
+	specs := map[string]struct {
+		denom  string
+		expErr error
+	}{
+		"denom with supply": {
+			denom: "denom",
+		},
+		"denom with metadata": {
+			denom: "meta",
+		},
+		"already registered": {
+			denom:  "registered",
+			expErr: types.ErrDuplicate,
+		},
+		"unknown denom": {
+			denom:  "unknown",
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1), sdk.NewInt64Coin("registered", 1))
+			keepers.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "meta", Display: "meta", DenomUnits: []*banktypes.DenomUnit{{Denom: "meta"}}})
+			_, err := k.RegisterBankPointer(ctx, "registered")
+			require.NoError(t, err)
+			em := sdk.NewEventManager()
+
+			// when
+			gotPointer, gotErr := k.RegisterBankPointer(ctx.WithEventManager(em), spec.denom)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.BankPointerAddress(spec.denom), gotPointer)
+			gotDenom, found := k.GetBankPointerDenom(ctx, gotPointer)
+			assert.True(t, found)
+			assert.Equal(t, spec.denom, gotDenom)
+			assert.Equal(t, map[common.Address]string{
+				types.BankPointerAddress("registered"): "registered",
+				gotPointer:                             spec.denom,
+			}, pendingBankPointers(ctx, k))
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				types.EventTypeRegisterBankPointer,
+				sdk.NewAttribute(types.AttributeKeyDenom, spec.denom),
+				sdk.NewAttribute(types.AttributeKeyPointer, gotPointer.Hex()),
+			)}, em.Events())
+		})
+	}
+}
+
+func TestClearPendingBankPointers(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1), sdk.NewInt64Coin("other", 1))
+	pointer, err := k.RegisterBankPointer(ctx, "denom")
+	require.NoError(t, err)
+
+	// when
+	k.ClearPendingBankPointers(ctx)
+
+	// then
+	assert.Empty(t, pendingBankPointers(ctx, k))
+	_, found := k.GetBankPointerDenom(ctx, pointer)
+	assert.True(t, found)
+
+	// and pointers registered later are pending again
+	otherPointer, err := k.RegisterBankPointer(ctx, "other")
+	require.NoError(t, err)
+	assert.Equal(t, map[common.Address]string{otherPointer: "other"}, pendingBankPointers(ctx, k))
+}
+
+func pendingBankPointers(ctx sdk.Context, k *Keeper) map[common.Address]string {
+	pointers := make(map[common.Address]string)
+	k.IteratePendingBankPointers(ctx, func(denom string, pointer common.Address) bool {
+		pointers[pointer] = denom
+		return false
+	})
+	return pointers
+}
+
+func TestQueryBankPointer(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1))
+	_, err := k.RegisterBankPointer(ctx, "denom")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcQuery *types.QueryBankPointerRequest
+		exp      *types.QueryBankPointerResponse
+		expErr   bool
+	}{
+		"registered": {
+			srcQuery: &types.QueryBankPointerRequest{Denom: "denom"},
+			exp:      &types.QueryBankPointerResponse{Address: types.BankPointerAddress("denom").Hex(), Registered: true},
+		},
+		"not registered": {
+			srcQuery: &types.QueryBankPointerRequest{Denom: "other"},
+			exp:      &types.QueryBankPointerResponse{Address: types.BankPointerAddress("other").Hex()},
+		},
+		"invalid denom": {
+			srcQuery: &types.QueryBankPointerRequest{Denom: "1"},
+			expErr:   true,
+		},
+		"nil req": {
+			expErr: true,
+		},
+	}
+	q := Querier(k)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.BankPointer(ctx, spec.srcQuery)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
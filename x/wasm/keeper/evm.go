@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// NewEVMMessageHandler handles the `evm` custom messages of contracts by calling the EVM contract with the
+// EVM address mapped to the contract as msg.sender. The call result is returned as JSON encoded
+// EVMCallResponse. Other messages are passed to the next handler.
+func NewEVMMessageHandler(k *Keeper) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+		if msg.Custom == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		var evmMsg types.EVMCustomMsg
+		if err := json.Unmarshal(msg.Custom, &evmMsg); err != nil || evmMsg.EVM == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		call := evmMsg.EVM.Call
+		if call == nil {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidMsg, "unknown variant of EVM")
+		}
+		if err := types.ValidateEVMCall(call.Contract, call.GasLimit); err != nil {
+			return nil, nil, nil, err
+		}
+		sender, err := k.evmAddress(ctx, contractAddr, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		em := sdk.NewEventManager()
+		res, err := k.callEVM(ctx.WithEventManager(em), sender, common.HexToAddress(call.Contract), call.Data, call.GasLimit, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		data, err := json.Marshal(types.EVMCallResponse{Data: res.Ret, GasUsed: res.GasUsed})
+		if err != nil {
+			return nil, nil, nil, errorsmod.Wrap(err, "evm call response")
+		}
+		return em.Events(), [][]byte{data}, nil, nil
+	}
+}
+
+// callEVM calls the EVM contract with the EVM address mapped to the sender as msg.sender.
+// The call can spend up to gasLimit EVM gas which must be covered by the gas left in the context.
+// The EVM gas used is converted by the gas register and charged to the context gas meter. Note that
+// the EVM charges a minimum share of the gas limit, so the gas limit should not be set higher than needed.
+// State changes are persisted only when commit is set. Committing calls are rejected when the contract was
+// called from a precompile, as they would write the EVM state under the running EVM transaction.
+func (k Keeper) callEVM(
+	ctx sdk.Context,
+	sender common.Address,
+	contract common.Address,
+	data []byte,
+	gasLimit uint64,
+	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	if k.evmKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotSupported, "evm calls")
+	}
+	if commit && types.IsWithinEVM(ctx) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotSupported, "evm calls from within the evm")
+	}
+	if available := k.gasRegister.ToEVMGas(ctx.GasMeter().GasRemaining()); available < gasLimit {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "evm gas limit %d exceeds available %d", gasLimit, available)
+	}
+
+	zero := big.NewInt(0)
+	msg := ethtypes.NewMessage(sender, &contract, 0, zero, gasLimit, zero, zero, zero, data, nil, !commit)
+	// store access is paid with EVM gas
+	evmCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := k.evmKeeper.ApplyMessage(evmCtx, msg, evmtypes.NewNoOpTracer(), commit)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(k.gasRegister.FromEVMGas(res.GasUsed), "evm call")
+	if res.Failed() {
+		if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+			return nil, errorsmod.Wrapf(types.ErrEVMCall, "%s: %s", res.VmError, reason)
+		}
+		return nil, errorsmod.Wrap(types.ErrEVMCall, res.VmError)
+	}
+	if commit {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallEVM,
+			sdk.NewAttribute(types.AttributeKeyEVMContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEVMSender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEVMGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		))
+	}
+	return res, nil
+}
+
+// evmAddress returns the EVM address mapped to the account. Accounts without a mapping use the
+// EVM address derived from their address. For 32 byte addresses like contracts, the derived address
+// does not map back to the account, so the mapping is persisted when persist is set.
+func (k Keeper) evmAddress(ctx sdk.Context, addr sdk.AccAddress, persist bool) (common.Address, error) {
+	if k.evmKeeper == nil {
+		return common.Address{}, errorsmod.Wrap(sdkerrors.ErrNotSupported, "evm calls")
+	}
+	if evmAddr, err := k.evmKeeper.GetEvmAddressMapping(ctx, addr); err == nil {
+		return *evmAddr, nil
+	}
+	evmAddr := common.BytesToAddress(addr)
+	if len(addr) == common.AddressLength || !persist {
+		return evmAddr, nil
+	}
+	if mapped := k.evmKeeper.GetCosmosAddressMapping(ctx, evmAddr); !mapped.Equals(sdk.AccAddress(evmAddr.Bytes())) {
+		return common.Address{}, errorsmod.Wrapf(types.ErrDuplicate, "evm address %s already mapped to %s", evmAddr, mapped)
+	}
+	k.evmKeeper.SetAddressMapping(ctx, addr, evmAddr)
+	return evmAddr, nil
+}
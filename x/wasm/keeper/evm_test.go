@@ -0,0 +1,340 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestEVMMessageHandler(t *testing.T) {
+	evmContract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contractAddr := BuildContractAddressClassic(1, 1)
+	derivedAddr := common.BytesToAddress(contractAddr)
+	mappedAddr := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	revertReason := append(crypto.Keccak256([]byte("Error(string)"))[:4], mustPackString(t, "not allowed")...)
+
+	callMsg := func(gasLimit uint64) wasmvmtypes.CosmosMsg {
+		bz, err := json.Marshal(types.EVMCustomMsg{EVM: &types.EVMMsg{Call: &types.EVMCall{
+			Contract: evmContract.Hex(),
+			Data:     []byte{1, 2},
+			GasLimit: gasLimit,
+		}}})
+		require.NoError(t, err)
+		return wasmvmtypes.CosmosMsg{Custom: bz}
+	}
+
+	specs := map[string]struct {
+		sender       sdk.AccAddress
+		msg          *wasmvmtypes.CosmosMsg
+		setup        func(m *mockEVMKeeper)
+		gasLimit     uint64
+		ctxGasLimit  storetypes.Gas
+		withinEVM    bool
+		result       *evmtypes.MsgEthereumTxResponse
+		expFrom      common.Address
+		expMapping   bool
+		expGasCharge storetypes.Gas
+		expErr       *errorsmod.Error
+		expErrMsg    string
+	}{
+		"contract mapped to derived address": {
+			sender:       contractAddr,
+			gasLimit:     100_000,
+			result:       &evmtypes.MsgEthereumTxResponse{Ret: []byte{1}, GasUsed: 50_000},
+			expFrom:      derivedAddr,
+			expMapping:   true,
+			expGasCharge: 50_000,
+		},
+		"existing mapping used": {
+			sender: contractAddr,
+			setup: func(m *mockEVMKeeper) {
+				m.SetAddressMapping(sdk.Context{}, contractAddr, mappedAddr)
+			},
+			gasLimit:     100_000,
+			result:       &evmtypes.MsgEthereumTxResponse{Ret: []byte{1}, GasUsed: 50_000},
+			expFrom:      mappedAddr,
+			expGasCharge: 50_000,
+		},
+		"derived address mapped to other account": {
+			sender: contractAddr,
+			setup: func(m *mockEVMKeeper) {
+				m.SetAddressMapping(sdk.Context{}, RandomAccountAddress(t), derivedAddr)
+			},
+			gasLimit: 100_000,
+			expErr:   types.ErrDuplicate,
+		},
+		"reverted with reason": {
+			sender:       contractAddr,
+			gasLimit:     100_000,
+			result:       &evmtypes.MsgEthereumTxResponse{Ret: revertReason, VmError: vm.ErrExecutionReverted.Error(), GasUsed: 60_000},
+			expFrom:      derivedAddr,
+			expMapping:   true,
+			expGasCharge: 60_000,
+			expErr:       types.ErrEVMCall,
+			expErrMsg:    "not allowed",
+		},
+		"gas limit exceeds gas left": {
+			sender:      contractAddr,
+			gasLimit:    100_000,
+			ctxGasLimit: 99_999,
+			expMapping:  true, // reverted with the tx
+			expErr:      sdkerrors.ErrOutOfGas,
+		},
+		"called from within the evm": {
+			sender:     contractAddr,
+			gasLimit:   100_000,
+			withinEVM:  true,
+			expMapping: true, // reverted with the tx
+			expErr:     sdkerrors.ErrNotSupported,
+		},
+		"other custom msg passed on": {
+			sender: contractAddr,
+			msg:    &wasmvmtypes.CosmosMsg{Custom: []byte(`{"token":{}}`)},
+			expErr: types.ErrUnknownMsg,
+		},
+		"non custom msg passed on": {
+			sender: contractAddr,
+			msg:    &wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			expErr: types.ErrUnknownMsg,
+		},
+		"unknown evm variant": {
+			sender: contractAddr,
+			msg:    &wasmvmtypes.CosmosMsg{Custom: []byte(`{"evm":{}}`)},
+			expErr: types.ErrInvalidMsg,
+		},
+		"zero gas limit": {
+			sender: contractAddr,
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := newMockEVMKeeper()
+			var gotMsg core.Message
+			var gotCommit bool
+			mock.ApplyMessageFn = func(_ sdk.Context, msg core.Message, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+				gotMsg, gotCommit = msg, commit
+				return spec.result, nil
+			}
+			if spec.setup != nil {
+				spec.setup(mock)
+			}
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithEVMKeeper(mock))
+			if spec.ctxGasLimit != 0 {
+				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(spec.ctxGasLimit))
+			}
+			if spec.withinEVM {
+				ctx = types.WithinEVM(ctx)
+			}
+			msg := callMsg(spec.gasLimit)
+			if spec.msg != nil {
+				msg = *spec.msg
+			}
+			gasBefore := ctx.GasMeter().GasConsumed()
+			em := sdk.NewEventManager()
+
+			// when
+			gotEvents, gotData, _, gotErr := NewEVMMessageHandler(keepers.WasmKeeper).DispatchMsg(ctx.WithEventManager(em), spec.sender, "", msg)
+
+			// then
+			assert.Equal(t, spec.expGasCharge, ctx.GasMeter().GasConsumed()-gasBefore)
+			assert.Empty(t, em.Events())
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Contains(t, gotErr.Error(), spec.expErrMsg)
+			} else {
+				require.NoError(t, gotErr)
+				require.Len(t, gotData, 1)
+				var gotRsp types.EVMCallResponse
+				require.NoError(t, json.Unmarshal(gotData[0], &gotRsp))
+				assert.Equal(t, spec.result.Ret, gotRsp.Data)
+				assert.Equal(t, spec.result.GasUsed, gotRsp.GasUsed)
+				require.Len(t, gotEvents, 1)
+				assert.Equal(t, types.EventTypeCallEVM, gotEvents[0].Type)
+			}
+			if spec.expFrom != (common.Address{}) {
+				require.NotNil(t, gotMsg)
+				assert.True(t, gotCommit)
+				assert.Equal(t, spec.expFrom, gotMsg.From())
+				assert.Equal(t, evmContract, *gotMsg.To())
+				assert.Equal(t, []byte{1, 2}, gotMsg.Data())
+				assert.Equal(t, spec.gasLimit, gotMsg.Gas())
+				assert.Equal(t, int64(0), gotMsg.Value().Int64())
+			}
+			gotMapped := mock.evmAddrs[spec.sender.String()] == derivedAddr
+			assert.Equal(t, spec.expMapping, gotMapped)
+		})
+	}
+}
+
+func TestEVMQuerier(t *testing.T) {
+	evmContract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contractAddr := BuildContractAddressClassic(1, 1)
+	nextRsp := []byte(`"next"`)
+	next := func(sdk.Context, json.RawMessage) ([]byte, error) {
+		return nextRsp, nil
+	}
+
+	specs := map[string]struct {
+		src      string
+		expFrom  common.Address
+		expRsp   []byte
+		expErr   bool
+		expCalls int
+	}{
+		"static call": {
+			src:      `{"evm":{"static_call":{"contract":"` + evmContract.Hex() + `","data":"AQI=","gas_limit":100000}}}`,
+			expRsp:   []byte(`{"data":"Aw==","gas_used":30000}`),
+			expCalls: 1,
+		},
+		"static call with sender": {
+			src:      `{"evm":{"static_call":{"sender":"` + contractAddr.String() + `","contract":"` + evmContract.Hex() + `","data":"AQI=","gas_limit":100000}}}`,
+			expFrom:  common.BytesToAddress(contractAddr),
+			expRsp:   []byte(`{"data":"Aw==","gas_used":30000}`),
+			expCalls: 1,
+		},
+		"other custom query passed to next": {
+			src:    `{"token":{}}`,
+			expRsp: nextRsp,
+		},
+		"invalid contract": {
+			src:    `{"evm":{"static_call":{"contract":"foo","data":"AQI=","gas_limit":100000}}}`,
+			expErr: true,
+		},
+		"zero gas limit": {
+			src:    `{"evm":{"static_call":{"contract":"` + evmContract.Hex() + `","data":"AQI="}}}`,
+			expErr: true,
+		},
+		"unknown evm variant": {
+			src:    `{"evm":{}}`,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := newMockEVMKeeper()
+			var calls int
+			mock.ApplyMessageFn = func(_ sdk.Context, msg core.Message, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+				calls++
+				assert.False(t, commit)
+				assert.Equal(t, spec.expFrom, msg.From())
+				assert.Equal(t, evmContract, *msg.To())
+				return &evmtypes.MsgEthereumTxResponse{Ret: []byte{3}, GasUsed: 30_000}, nil
+			}
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithEVMKeeper(mock))
+
+			// when
+			gotRsp, gotErr := EVMQuerier(keepers.WasmKeeper, next)(ctx, json.RawMessage(spec.src))
+
+			// then
+			assert.Equal(t, spec.expCalls, calls)
+			assert.Empty(t, mock.evmAddrs, "mapping must not be persisted")
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, string(spec.expRsp), string(gotRsp))
+		})
+	}
+}
+
+func TestWithEVMKeeperWrapsCustomHandlers(t *testing.T) {
+	mock := newMockEVMKeeper()
+	mock.ApplyMessageFn = func(sdk.Context, core.Message, bool) (*evmtypes.MsgEthereumTxResponse, error) {
+		return &evmtypes.MsgEthereumTxResponse{GasUsed: 21_000}, nil
+	}
+	customQuerier := func(sdk.Context, json.RawMessage) ([]byte, error) {
+		return []byte(`"custom"`), nil
+	}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities,
+		WithQueryPlugins(&QueryPlugins{Custom: customQuerier}),
+		WithEVMKeeper(mock),
+	)
+	q := keepers.WasmKeeper.wasmVMQueryHandler
+
+	gotRsp, err := q.HandleQuery(ctx, RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"foo":{}}`)})
+	require.NoError(t, err)
+	assert.Equal(t, `"custom"`, string(gotRsp))
+
+	gotRsp, err = q.HandleQuery(ctx, RandomAccountAddress(t), wasmvmtypes.QueryRequest{
+		Custom: []byte(`{"evm":{"static_call":{"contract":"0x1000000000000000000000000000000000000001","gas_limit":21000}}}`),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":null,"gas_used":21000}`, string(gotRsp))
+
+	_, gotData, _, err := keepers.WasmKeeper.messenger.DispatchMsg(ctx, BuildContractAddressClassic(1, 1), "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(`{"evm":{"call":{"contract":"0x1000000000000000000000000000000000000001","gas_limit":21000}}}`),
+	})
+	require.NoError(t, err)
+	require.Len(t, gotData, 1)
+	assert.JSONEq(t, `{"data":null,"gas_used":21000}`, string(gotData[0]))
+}
+
+func mustPackString(t *testing.T, s string) []byte {
+	t.Helper()
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	bz, err := abi.Arguments{{Type: stringType}}.Pack(s)
+	require.NoError(t, err)
+	return bz
+}
+
+var _ types.EVMKeeper = &mockEVMKeeper{}
+
+type mockEVMKeeper struct {
+	evmAddrs       map[string]common.Address
+	cosmosAddrs    map[common.Address]sdk.AccAddress
+	ApplyMessageFn func(ctx sdk.Context, msg core.Message, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+func newMockEVMKeeper() *mockEVMKeeper {
+	return &mockEVMKeeper{
+		evmAddrs:    make(map[string]common.Address),
+		cosmosAddrs: make(map[common.Address]sdk.AccAddress),
+	}
+}
+
+func (m *mockEVMKeeper) GetEvmAddressMapping(_ sdk.Context, addr sdk.AccAddress) (*common.Address, error) {
+	evmAddr, ok := m.evmAddrs[addr.String()]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &evmAddr, nil
+}
+
+func (m *mockEVMKeeper) GetCosmosAddressMapping(_ sdk.Context, evmAddress common.Address) sdk.AccAddress {
+	if addr, ok := m.cosmosAddrs[evmAddress]; ok {
+		return addr
+	}
+	return evmAddress.Bytes()
+}
+
+func (m *mockEVMKeeper) SetAddressMapping(_ sdk.Context, cosmosAddress sdk.AccAddress, evmAddress common.Address) {
+	m.evmAddrs[cosmosAddress.String()] = evmAddress
+	m.cosmosAddrs[evmAddress] = cosmosAddress
+}
+
+func (m *mockEVMKeeper) ApplyMessage(ctx sdk.Context, msg core.Message, _ vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	if m.ApplyMessageFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ApplyMessageFn(ctx, msg, commit)
+}
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -54,6 +55,36 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		}
 	}
 
+	for i, gasless := range data.GaslessContracts {
+		contractAddr, err := sdk.AccAddressFromBech32(gasless.ContractAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "address in gasless contract number %d", i)
+		}
+		if err := keeper.setGasless(ctx, contractAddr, gasless.Config); err != nil {
+			return nil, errorsmod.Wrapf(err, "gasless contract number %d", i)
+		}
+	}
+
+	for i, allowance := range data.BankAllowances {
+		owner, err := sdk.AccAddressFromBech32(allowance.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "owner in bank allowance number %d", i)
+		}
+		spender, err := sdk.AccAddressFromBech32(allowance.Spender)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "spender in bank allowance number %d", i)
+		}
+		if err := keeper.SetBankAllowance(ctx, owner, spender, allowance.Denom, allowance.Amount); err != nil {
+			return nil, errorsmod.Wrapf(err, "bank allowance number %d", i)
+		}
+	}
+
+	for i, pointer := range data.BankPointers {
+		if err := keeper.importBankPointer(ctx, pointer.Denom, common.HexToAddress(pointer.Address)); err != nil {
+			return nil, errorsmod.Wrapf(err, "bank pointer number %d", i)
+		}
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -120,6 +151,27 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		return false
 	})
 
+	keeper.IterateGaslessContracts(ctx, func(addr sdk.AccAddress, config types.GaslessConfig) bool {
+		genState.GaslessContracts = append(genState.GaslessContracts, types.GaslessContractEntry{
+			ContractAddress: addr.String(),
+			Config:          config,
+		})
+		return false
+	})
+
+	keeper.IterateBankAllowances(ctx, nil, func(allowance types.BankAllowance) bool {
+		genState.BankAllowances = append(genState.BankAllowances, allowance)
+		return false
+	})
+
+	keeper.IterateBankPointers(ctx, func(denom string, pointer common.Address) bool {
+		genState.BankPointers = append(genState.BankPointers, types.BankPointer{
+			Denom:   denom,
+			Address: pointer.Hex(),
+		})
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeySequenceCodeID, types.KeySequenceInstanceID} {
 		id, err := keeper.PeekAutoIncrementID(ctx, k)
 		if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
@@ -31,6 +32,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -56,6 +58,7 @@ func TestGenesisExportImport(t *testing.T) {
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
 			contractExtension bool
+			gasless           bool
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
@@ -63,6 +66,7 @@ func TestGenesisExportImport(t *testing.T) {
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&gasless)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -88,6 +92,16 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if gasless {
+			policy, err := types.NewGaslessPolicy(types.NewAcceptedMessageKeysFilter("foo"), RandomAccountAddress(t))
+			require.NoError(t, err)
+			config := types.GaslessConfig{MaxGasPerBlock: rand.Uint64()%1_000_000 + 1, Policy: policy}
+			require.NoError(t, wasmKeeper.setGasless(srcCtx, contractAddr, config))
+		}
+		allowance := sdkmath.NewIntFromUint64(rand.Uint64()%1_000_000 + 1)
+		require.NoError(t, wasmKeeper.SetBankAllowance(srcCtx, creatorAddr, contractAddr, "stake", allowance))
+		denom := fmt.Sprintf("factory/%s/token", contractAddr)
+		require.NoError(t, wasmKeeper.importBankPointer(srcCtx, denom, types.BankPointerAddress(denom)))
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -103,6 +117,15 @@ func TestGenesisExportImport(t *testing.T) {
 	rand.Shuffle(len(exportedState.Contracts), func(i, j int) {
 		exportedState.Contracts[i], exportedState.Contracts[j] = exportedState.Contracts[j], exportedState.Contracts[i]
 	})
+	rand.Shuffle(len(exportedState.GaslessContracts), func(i, j int) {
+		exportedState.GaslessContracts[i], exportedState.GaslessContracts[j] = exportedState.GaslessContracts[j], exportedState.GaslessContracts[i]
+	})
+	rand.Shuffle(len(exportedState.BankAllowances), func(i, j int) {
+		exportedState.BankAllowances[i], exportedState.BankAllowances[j] = exportedState.BankAllowances[j], exportedState.BankAllowances[i]
+	})
+	rand.Shuffle(len(exportedState.BankPointers), func(i, j int) {
+		exportedState.BankPointers[i], exportedState.BankPointers[j] = exportedState.BankPointers[j], exportedState.BankPointers[i]
+	})
 	rand.Shuffle(len(exportedState.Sequences), func(i, j int) {
 		exportedState.Sequences[i], exportedState.Sequences[j] = exportedState.Sequences[j], exportedState.Sequences[i]
 	})
@@ -347,6 +370,78 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: gasless contract": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    1,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *types.ContractInfo) { c.CodeID = 1 }, types.RandCreatedFields),
+						ContractCodeHistory: []types.ContractCodeHistoryEntry{
+							{
+								Operation: types.ContractCodeHistoryOperationTypeMigrate,
+								CodeID:    1,
+								Updated:   &types.AbsoluteTxPosition{BlockHeight: rand.Uint64(), TxIndex: rand.Uint64()},
+								Msg:       []byte(`{}`),
+							},
+						},
+					},
+				},
+				GaslessContracts: []types.GaslessContractEntry{{
+					ContractAddress: BuildContractAddressClassic(1, 1).String(),
+					Config:          types.GaslessConfig{MaxGasPerBlock: 1_000_000, MaxGasPerTx: 100_000},
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 2},
+					{IDKey: types.KeySequenceInstanceID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
+		"happy path: bank allowance": {
+			src: types.GenesisState{
+				BankAllowances: []types.BankAllowance{{
+					Owner:   RandomBech32AccountAddress(t),
+					Spender: RandomBech32AccountAddress(t),
+					Denom:   "stake",
+					Amount:  sdkmath.NewInt(100),
+				}},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
+		"happy path: bank pointer": {
+			src: types.GenesisState{
+				BankPointers: []types.BankPointer{{
+					Denom:   "stake",
+					Address: types.BankPointerAddress("stake").Hex(),
+				}},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
+		"prevent gasless entry for non existing contract": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    1,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				GaslessContracts: []types.GaslessContractEntry{{
+					ContractAddress: BuildContractAddressClassic(1, 1).String(),
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 2},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
 		"prevent contracts that points to non existing codeID": {
 			src: types.GenesisState{
 				Contracts: []types.Contract{
@@ -501,6 +596,21 @@ func TestGenesisInit(t *testing.T) {
 			for _, c := range spec.src.Codes {
 				assert.Equal(t, c.Pinned, keeper.IsPinnedCode(ctx, c.CodeID))
 			}
+			for _, g := range spec.src.GaslessContracts {
+				gotConfig := keeper.GetGaslessConfig(ctx, sdk.MustAccAddressFromBech32(g.ContractAddress))
+				require.NotNil(t, gotConfig)
+				assert.Equal(t, g.Config.MaxGasPerBlock, gotConfig.MaxGasPerBlock)
+				assert.Equal(t, g.Config.MaxGasPerTx, gotConfig.MaxGasPerTx)
+			}
+			for _, a := range spec.src.BankAllowances {
+				gotAmount := keeper.GetBankAllowance(ctx, sdk.MustAccAddressFromBech32(a.Owner), sdk.MustAccAddressFromBech32(a.Spender), a.Denom)
+				assert.Equal(t, a.Amount, gotAmount)
+			}
+			for _, p := range spec.src.BankPointers {
+				gotDenom, found := keeper.GetBankPointerDenom(ctx, common.HexToAddress(p.Address))
+				assert.True(t, found)
+				assert.Equal(t, p.Denom, gotDenom)
+			}
 		})
 	}
 }
@@ -89,6 +89,7 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankViewKeeper        types.BankViewKeeper
 	portKeeper            types.PortKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmEngine
@@ -102,6 +103,7 @@ type Keeper struct {
 	maxCallDepth         uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	evmKeeper            types.EVMKeeper
 	params               collections.Item[types.Params]
 	// propagate gov authZ to sub-messages
 	propagateGovAuthorization map[types.AuthorizationPolicyAction]struct{}
@@ -391,20 +393,42 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	isGasLess := k.IsGasless(sdkCtx, contractAddress)
-	// infinite gas meter
-	if isGasLess {
-		sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if !k.IsGasless(sdkCtx, contractAddress) {
+		return k.executeContract(sdkCtx, contractAddress, caller, msg, coins)
+	}
+	config := k.GetGaslessConfig(sdkCtx, contractAddress)
+	if config.Policy != nil {
+		// executions rejected by the policy pay for their gas
+		if ok, err := config.Policy.Accept(sdkCtx, caller, msg); err != nil || !ok {
+			return k.executeContract(sdkCtx, contractAddress, caller, msg, coins)
+		}
 	}
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	gasMeter, ok := k.gaslessGasMeter(sdkCtx, contractAddress, *config)
+	if !ok {
+		// the gas budget is exhausted so that the caller pays
+		return k.executeContract(sdkCtx, contractAddress, caller, msg, coins)
+	}
+	// failed executions use up the gas budget as well, so they are settled too
+	defer func() {
+		// settlement is a bounded bookkeeping cost that is not charged to the caller
+		settleCtx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		if settleErr := k.settleGaslessExecution(settleCtx, contractAddress, *config, gasMeter.GasConsumedToLimit()); settleErr != nil && err == nil {
+			data, err = nil, settleErr
+		}
+	}()
+	return k.executeContract(sdkCtx.WithGasMeter(gasMeter), contractAddress, caller, msg, coins)
+}
+
+func (k Keeper) executeContract(sdkCtx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(sdkCtx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
+	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(sdkCtx, contractInfo.CodeID))
 	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: execute")
@@ -1518,17 +1542,17 @@ func (h DefaultWasmVMContractResponseHandler) Handle(ctx sdk.Context, contractAd
 	return result, nil
 }
 
-// setGaslessContract set the gasless contract
-func (k Keeper) setGasless(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+// setGasless stores the contract as gasless with the given gas budget
+func (k Keeper) setGasless(ctx sdk.Context, contractAddr sdk.AccAddress, config types.GaslessConfig) error {
 	info := k.GetContractInfo(ctx, contractAddr)
 	if info == nil {
 		return errorsmod.Wrap(types.ErrNotFound, "contract info")
 	}
+	if err := config.ValidateBasic(); err != nil {
+		return err
+	}
 	store := k.storeService.OpenKVStore(ctx)
-	// store 1 byte to not run into `nil` debugging issues
-	store.Set(types.GetGaslessContractIndexPrefix(contractAddr), []byte{1})
-
-	return nil
+	return store.Set(types.GetGaslessContractIndexPrefix(contractAddr), k.cdc.MustMarshal(&config))
 }
 
 // unsetGaslessContract removes the gasless contract
@@ -1539,8 +1563,10 @@ func (k Keeper) unsetGasless(ctx sdk.Context, contractAddr sdk.AccAddress) error
 	}
 
 	store := k.storeService.OpenKVStore(ctx)
-	store.Delete(types.GetGaslessContractIndexPrefix(contractAddr))
-	return nil
+	if err := store.Delete(types.GetGaslessContractUsageKey(contractAddr)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetGaslessContractIndexPrefix(contractAddr))
 }
 
 // IsGaslessContract returns true when contract is gasless
@@ -1551,4 +1577,137 @@ func (k Keeper) IsGasless(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
 		return false
 	}
 	return ok
-}
\ No newline at end of file
+}
+
+// GetGaslessConfig returns the gas budget of a gasless contract or nil when the contract is not gasless
+func (k Keeper) GetGaslessConfig(ctx context.Context, contractAddr sdk.AccAddress) *types.GaslessConfig {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetGaslessContractIndexPrefix(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var config types.GaslessConfig
+	// entries without a budget were stored as single marker byte
+	if !bytes.Equal(bz, []byte{1}) {
+		k.cdc.MustUnmarshal(bz, &config)
+	}
+	return &config
+}
+
+// IterateGaslessContracts iterates over all gasless contracts with their gas budget.
+// The callback method can return true to abort early.
+func (k Keeper) IterateGaslessContracts(ctx context.Context, cb func(sdk.AccAddress, types.GaslessConfig) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GaslessContractIndexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var config types.GaslessConfig
+		// entries without a budget were stored as single marker byte
+		if !bytes.Equal(iter.Value(), []byte{1}) {
+			k.cdc.MustUnmarshal(iter.Value(), &config)
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), config) {
+			break
+		}
+	}
+}
+
+// getGaslessUsage returns the gas recorded for gasless executions of the contract
+func (k Keeper) getGaslessUsage(ctx context.Context, contractAddr sdk.AccAddress) types.GaslessUsage {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetGaslessContractUsageKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	var usage types.GaslessUsage
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
+
+// RemainingGaslessBlockGas returns the gas left for gasless executions of the contract in the current block.
+// The bool is false when the contract is not gasless or the gas per block is not limited, so that an
+// unlimited budget can be told apart from an exhausted one.
+func (k Keeper) RemainingGaslessBlockGas(ctx context.Context, contractAddr sdk.AccAddress) (uint64, bool) {
+	config := k.GetGaslessConfig(ctx, contractAddr)
+	if config == nil || config.MaxGasPerBlock == 0 {
+		return 0, false
+	}
+	return config.RemainingBlockGas(k.getGaslessUsage(ctx, contractAddr), sdk.UnwrapSDKContext(ctx).BlockHeight()), true
+}
+
+// AcceptGaslessExecution returns true when the contract execution by the caller with the given message is gasless
+// and within the gas budget of the contract.
+func (k Keeper) AcceptGaslessExecution(ctx sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte) bool {
+	config := k.GetGaslessConfig(ctx, contractAddr)
+	if config == nil {
+		return false
+	}
+	if config.Policy != nil {
+		if ok, err := config.Policy.Accept(ctx, caller, msg); err != nil || !ok {
+			return false
+		}
+	}
+	_, ok := k.gaslessGasMeter(ctx, contractAddr, *config)
+	return ok
+}
+
+// gaslessGasMeter returns the gas meter for a gasless execution that is limited to the remaining budget
+// of the contract. It returns false when the budget is exhausted.
+func (k Keeper) gaslessGasMeter(ctx sdk.Context, contractAddr sdk.AccAddress, config types.GaslessConfig) (storetypes.GasMeter, bool) {
+	if config.MaxGasPerBlock == 0 && config.MaxGasPerTx == 0 {
+		return storetypes.NewInfiniteGasMeter(), true
+	}
+	usage := k.getGaslessUsage(ctx, contractAddr)
+	txIndex, _ := types.TXCounter(ctx)
+	var limit uint64
+	if config.MaxGasPerBlock != 0 {
+		limit = config.RemainingBlockGas(usage, ctx.BlockHeight())
+		if limit == 0 {
+			return nil, false
+		}
+	}
+	if config.MaxGasPerTx != 0 {
+		txLimit := config.RemainingTxGas(usage, ctx.BlockHeight(), txIndex)
+		if txLimit == 0 {
+			return nil, false
+		}
+		if limit == 0 || txLimit < limit {
+			limit = txLimit
+		}
+	}
+	return storetypes.NewGasMeter(limit), true
+}
+
+// settleGaslessExecution records the gas consumed by a gasless execution and charges the sponsor
+func (k Keeper) settleGaslessExecution(ctx sdk.Context, contractAddr sdk.AccAddress, config types.GaslessConfig, gasUsed uint64) error {
+	if config.MaxGasPerBlock != 0 || config.MaxGasPerTx != 0 {
+		txIndex, _ := types.TXCounter(ctx)
+		usage := k.getGaslessUsage(ctx, contractAddr).Add(ctx.BlockHeight(), txIndex, gasUsed)
+		store := k.storeService.OpenKVStore(ctx)
+		if err := store.Set(types.GetGaslessContractUsageKey(contractAddr), k.cdc.MustMarshal(&usage)); err != nil {
+			return err
+		}
+	}
+	if !config.HasSponsor() {
+		return nil
+	}
+	fee := config.SponsorFee(gasUsed)
+	if fee.IsZero() {
+		return nil
+	}
+	sponsor, err := sdk.AccAddressFromBech32(config.Sponsor)
+	if err != nil {
+		return errorsmod.Wrap(err, "sponsor")
+	}
+	if err := k.bank.TransferCoins(ctx, sponsor, authtypes.NewModuleAddress(authtypes.FeeCollectorName), fee); err != nil {
+		return errorsmod.Wrap(err, "sponsor gas")
+	}
+	return nil
+}
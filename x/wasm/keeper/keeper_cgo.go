@@ -44,6 +44,7 @@ func NewKeeper(
 		wasmVM:               nil,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		bankViewKeeper:       bankKeeper,
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		portKeeper:           portKeeper,
 		capabilityKeeper:     capabilityKeeper,
@@ -2704,16 +2704,21 @@ func TestSetGaslessContract(t *testing.T) {
 	require.NoError(t, err)
 
 	// when
-	gotErr := k.setGasless(ctx, example.Contract)
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
+	gotErr := k.setGasless(ctx, example.Contract, types.GaslessConfig{})
 
 	// then
 	require.NoError(t, gotErr)
+	// and the gas per block is not limited
+	remaining, limited := k.RemainingGaslessBlockGas(ctx, example.Contract)
+	assert.False(t, limited)
+	assert.Zero(t, remaining)
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
 	assert.True(t, k.IsGasless(ctx, example.Contract))
 
 	_, err = k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
 	require.NoError(t, err)
-	assert.True(t, ctx.GasMeter().GasConsumed() == 5687)
+	assert.True(t, ctx.GasMeter().GasConsumed() == 3114)
 }
 
 func TestUnsetGaslessContract(t *testing.T) {
@@ -2724,7 +2729,7 @@ func TestUnsetGaslessContract(t *testing.T) {
 	example := SeedNewContractInstance(t, ctx, keepers, &mock)
 
 	// when
-	gotErr := k.setGasless(ctx, example.Contract)
+	gotErr := k.setGasless(ctx, example.Contract, types.GaslessConfig{})
 
 	// then
 	require.NoError(t, gotErr)
@@ -2736,6 +2741,150 @@ func TestUnsetGaslessContract(t *testing.T) {
 	assert.False(t, k.IsGasless(ctx, example.Contract))
 }
 
+func TestGaslessContractGasBudget(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	sponsor := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000_000))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	config := types.GaslessConfig{
+		MaxGasPerBlock:  200_000,
+		MaxGasPerTx:     100_000,
+		Sponsor:         sponsor.String(),
+		SponsorGasPrice: sdk.NewDecCoinFromDec("denom", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	}
+	require.NoError(t, k.setGasless(ctx, example.Contract, config))
+	remaining, limited := k.RemainingGaslessBlockGas(ctx, example.Contract)
+	require.True(t, limited)
+	require.Equal(t, uint64(200_000), remaining)
+
+	// when executed within the budget
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
+	_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
+	require.NoError(t, err)
+
+	// then the gas is taken from the budget and paid by the sponsor
+	remaining, _ = k.RemainingGaslessBlockGas(ctx, example.Contract)
+	require.Less(t, remaining, uint64(200_000))
+	gasUsed := 200_000 - remaining
+	assert.Less(t, ctx.GasMeter().GasConsumed(), uint64(20_000))
+	expFee := sdkmath.LegacyNewDecWithPrec(1, 1).MulInt64(int64(gasUsed)).Ceil().RoundInt()
+	assert.Equal(t, sdkmath.NewInt(1_000_000).Sub(expFee), keepers.BankKeeper.GetBalance(ctx, sponsor, "denom").Amount)
+	assert.Equal(t, expFee, keepers.BankKeeper.GetBalance(ctx, feeCollector, "denom").Amount)
+
+	// and the tx budget limits further executions within the same tx
+	assert.Panics(t, func() {
+		_, _ = k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
+	})
+
+	// and the budget is reset in a new block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	remaining, _ = k.RemainingGaslessBlockGas(ctx, example.Contract)
+	assert.Equal(t, uint64(200_000), remaining)
+
+	// when the block budget is exhausted
+	require.NoError(t, k.settleGaslessExecution(ctx, example.Contract, types.GaslessConfig{MaxGasPerBlock: 200_000}, 200_000))
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000_000))
+	remaining, limited = k.RemainingGaslessBlockGas(ctx, example.Contract)
+	assert.True(t, limited)
+	assert.Zero(t, remaining)
+	_, err = k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
+	require.NoError(t, err)
+
+	// then the caller pays
+	assert.Greater(t, ctx.GasMeter().GasConsumed(), gasUsed-10_000)
+}
+
+func TestGaslessContractFailedExecution(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Err: "testing"}, 0, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	sponsor := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000_000))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	config := types.GaslessConfig{
+		MaxGasPerBlock:  200_000,
+		Sponsor:         sponsor.String(),
+		SponsorGasPrice: sdk.NewDecCoinFromDec("denom", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	}
+	require.NoError(t, k.setGasless(ctx, example.Contract, config))
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
+
+	// when
+	_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
+
+	// then
+	require.Error(t, err)
+	// and the gas is taken from the budget and paid by the sponsor all the same
+	remaining, _ := k.RemainingGaslessBlockGas(ctx, example.Contract)
+	require.Less(t, remaining, uint64(200_000))
+	gasUsed := 200_000 - remaining
+	expFee := sdkmath.LegacyNewDecWithPrec(1, 1).MulInt64(int64(gasUsed)).Ceil().RoundInt()
+	assert.Equal(t, sdkmath.NewInt(1_000_000).Sub(expFee), keepers.BankKeeper.GetBalance(ctx, sponsor, "denom").Amount)
+	assert.Equal(t, expFee, keepers.BankKeeper.GetBalance(ctx, feeCollector, "denom").Amount)
+}
+
+func TestGaslessContractPolicy(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	alice, bob := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	policy, err := types.NewGaslessPolicy(types.NewAcceptedMessageKeysFilter("onboard"), alice)
+	require.NoError(t, err)
+	require.NoError(t, k.setGasless(ctx, example.Contract, types.GaslessConfig{Policy: policy}))
+
+	specs := map[string]struct {
+		caller     sdk.AccAddress
+		msg        []byte
+		expGasless bool
+	}{
+		"allowed caller and message": {
+			caller:     alice,
+			msg:        []byte(`{"onboard":{}}`),
+			expGasless: true,
+		},
+		"allowed caller with other message": {
+			caller: alice,
+			msg:    []byte(`{"admin":{}}`),
+		},
+		"other caller with allowed message": {
+			caller: bob,
+			msg:    []byte(`{"onboard":{}}`),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000_000))
+
+			// when
+			_, err := k.execute(ctx, example.Contract, spec.caller, spec.msg, nil)
+
+			// then
+			require.NoError(t, err)
+			if spec.expGasless {
+				assert.Less(t, ctx.GasMeter().GasConsumed(), uint64(10_000))
+				return
+			}
+			assert.Greater(t, ctx.GasMeter().GasConsumed(), uint64(10_000))
+		})
+	}
+}
+
 func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	r := make(map[string]string, len(attrs))
 	for _, v := range attrs {
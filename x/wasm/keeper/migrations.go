@@ -7,6 +7,8 @@ import (
 	v1 "github.com/CosmWasm/wasmd/x/wasm/migrations/v1"
 	v2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
 	v3 "github.com/CosmWasm/wasmd/x/wasm/migrations/v3"
+	v4 "github.com/CosmWasm/wasmd/x/wasm/migrations/v4"
+	v5 "github.com/CosmWasm/wasmd/x/wasm/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -36,3 +38,15 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v3.NewMigrator(m.keeper, m.keeper.mustStoreCodeInfo).Migrate3to4(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate4to5 migrates the x/wasm module state from the consensus
+// version 4 to version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v4.NewMigrator(m.keeper).Migrate4to5(ctx)
+}
+
+// Migrate5to6 migrates the x/wasm module state from the consensus
+// version 5 to version 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v5.NewMigrator(m.keeper).Migrate5to6(ctx)
+}
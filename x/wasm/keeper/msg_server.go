@@ -6,9 +6,9 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -500,7 +500,7 @@ func (m msgServer) SetGaslessContracts(ctx context.Context, msg *types.MsgSetGas
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, contract := range msg.Contracts {
 		contractAddress, _ := sdk.AccAddressFromBech32(contract)
-		if err := m.keeper.setGasless(sdkCtx, contractAddress); err != nil {
+		if err := m.keeper.setGasless(sdkCtx, contractAddress, msg.Config); err != nil {
 			// return nil, errorsmod.Wrapf(err, "set gas less failed for contract \"%s\"", contract)
 			return nil, err
 		}
@@ -508,3 +508,19 @@ func (m msgServer) SetGaslessContracts(ctx context.Context, msg *types.MsgSetGas
 
 	return &types.MsgSetGaslessContractsResponse{}, nil
 }
+
+// RegisterBankPointer registers the ERC20 pointer contract of a bank denom
+func (m msgServer) RegisterBankPointer(ctx context.Context, msg *types.MsgRegisterBankPointer) (*types.MsgRegisterBankPointerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	pointer, err := m.keeper.RegisterBankPointer(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterBankPointerResponse{
+		Pointer: pointer.Hex(),
+	}, nil
+}
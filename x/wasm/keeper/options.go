@@ -87,20 +87,51 @@ func WithQueryPlugins(x *QueryPlugins) Option {
 // This option expects the `DefaultMessageHandler` set and should not be combined with Option `WithMessageHandler` or `WithMessageHandlerDecorator`.
 func WithMessageEncoders(x *MessageEncoders) Option {
 	return optsFn(func(k *Keeper) {
-		q, ok := k.messenger.(*MessageHandlerChain)
+		updateMessageEncoders(k, func(e MessageEncoders) MessageEncoders {
+			return e.Merge(x)
+		})
+	})
+}
+
+func updateMessageEncoders(k *Keeper, update func(MessageEncoders) MessageEncoders) {
+	q, ok := k.messenger.(*MessageHandlerChain)
+	if !ok {
+		panic(fmt.Sprintf("Unsupported message handler type: %T", k.messenger))
+	}
+	s, ok := q.handlers[0].(SDKMessageHandler)
+	if !ok {
+		panic(fmt.Sprintf("Unexpected message handler type: %T", q.handlers[0]))
+	}
+	e, ok := s.encoders.(MessageEncoders)
+	if !ok {
+		panic(fmt.Sprintf("Unsupported encoder type: %T", s.encoders))
+	}
+	s.encoders = update(e)
+	q.handlers[0] = s
+}
+
+// WithEVMKeeper is an optional constructor parameter to let contracts call EVM contracts with the
+// `evm` custom message and static call them with the `evm` custom query. The `evm` custom messages are
+// handled before the default message handler. Other custom queries are passed to the querier set before,
+// so this option should come after them.
+// This option expects the default `QueryHandler` and `DefaultMessageHandler` set.
+func WithEVMKeeper(x types.EVMKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.evmKeeper = x
+		h, ok := k.messenger.(*MessageHandlerChain)
 		if !ok {
 			panic(fmt.Sprintf("Unsupported message handler type: %T", k.messenger))
 		}
-		s, ok := q.handlers[0].(SDKMessageHandler)
-		if !ok {
-			panic(fmt.Sprintf("Unexpected message handler type: %T", q.handlers[0]))
-		}
-		e, ok := s.encoders.(MessageEncoders)
+		h.handlers = append([]Messenger{NewEVMMessageHandler(k)}, h.handlers...)
+		q, ok := k.wasmVMQueryHandler.(QueryPlugins)
 		if !ok {
-			panic(fmt.Sprintf("Unsupported encoder type: %T", s.encoders))
+			panic(fmt.Sprintf("Unsupported query handler type: %T", k.wasmVMQueryHandler))
 		}
-		s.encoders = e.Merge(x)
-		q.handlers[0] = s
+		q.Custom = EVMQuerier(k, q.Custom)
+		k.wasmVMQueryHandler = q
 	})
 }
 
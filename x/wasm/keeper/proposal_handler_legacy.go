@@ -339,7 +339,7 @@ func handleSetGaslessContractsProposal(ctx sdk.Context, k types.ContractOpsKeepe
 		if err != nil {
 			return errorsmod.Wrap(err, "contract")
 		}
-		if err := k.SetGasless(ctx, contractAddr); err != nil {
+		if err := k.SetGasless(ctx, contractAddr, types.GaslessConfig{}); err != nil {
 			return errorsmod.Wrapf(err, "contract address: %s", v)
 		}
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -382,11 +383,23 @@ func (q GrpcQuerier) GaslessContracts(c context.Context, req *types.QueryGasless
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]string, 0)
+	contracts := make([]types.GaslessContract, 0)
 
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GaslessContractIndexPrefix)
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
 		if accumulate {
-			r = append(r, string(key))
+			contractAddr := sdk.AccAddress(key)
+			config := q.keeper.GetGaslessConfig(ctx, contractAddr)
+			if config == nil {
+				return false, nil
+			}
+			r = append(r, contractAddr.String())
+			remaining, _ := q.keeper.RemainingGaslessBlockGas(ctx, contractAddr)
+			contracts = append(contracts, types.GaslessContract{
+				ContractAddress:   contractAddr.String(),
+				Config:            *config,
+				RemainingBlockGas: remaining,
+			})
 		}
 		return true, nil
 	})
@@ -396,6 +409,7 @@ func (q GrpcQuerier) GaslessContracts(c context.Context, req *types.QueryGasless
 	return &types.QueryGaslessContractsResponse{
 		ContractAddresses: r,
 		Pagination:        pageRes,
+		GaslessContracts:  contracts,
 	}, nil
 }
 
@@ -440,6 +454,68 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 	}, nil
 }
 
+// BankAllowances returns the bank allowances granted by an owner, optionally filtered by spender
+func (q GrpcQuerier) BankAllowances(c context.Context, req *types.QueryBankAllowancesRequest) (*types.QueryBankAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "owner")
+	}
+	var spender sdk.AccAddress
+	if req.Spender != "" {
+		if spender, err = sdk.AccAddressFromBech32(req.Spender); err != nil {
+			return nil, errorsmod.Wrap(err, "spender")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowances := make([]types.BankAllowance, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetBankAllowancesPrefix(owner, spender))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if len(spender) != 0 {
+				// keys are denoms only when filtered by spender
+				key = append(address.MustLengthPrefix(spender), key...)
+			}
+			allowance, err := newBankAllowance(owner, key, value)
+			if err != nil {
+				return false, err
+			}
+			allowances = append(allowances, allowance)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBankAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BankPointer returns the ERC20 pointer contract of a bank denom
+func (q GrpcQuerier) BankPointer(c context.Context, req *types.QueryBankPointerRequest) (*types.QueryBankPointerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, errorsmod.Wrap(err, "denom")
+	}
+	pointer := types.BankPointerAddress(req.Denom)
+	_, registered := q.keeper.GetBankPointerDenom(c, pointer)
+	return &types.QueryBankPointerResponse{
+		Address:    pointer.Hex(),
+		Registered: registered,
+	}, nil
+}
+
 // max limit to pagination queries
 const maxResultEntries = 100
 
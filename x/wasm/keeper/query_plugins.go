@@ -22,6 +22,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -256,6 +257,39 @@ func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 }
 
+// EVMQuerier answers the `evm` custom queries of contracts by static calling EVM contracts.
+// Other custom queries are passed to next.
+func EVMQuerier(k *Keeper, next CustomQuerier) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query types.EVMCustomQuery
+		if err := json.Unmarshal(request, &query); err != nil || query.EVM == nil {
+			return next(ctx, request)
+		}
+		if query.EVM.StaticCall == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown EVM query variant"}
+		}
+		call := query.EVM.StaticCall
+		if err := types.ValidateEVMCall(call.Contract, call.GasLimit); err != nil {
+			return nil, err
+		}
+		var sender common.Address
+		if call.Sender != "" {
+			senderAddr, err := sdk.AccAddressFromBech32(call.Sender)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "sender")
+			}
+			if sender, err = k.evmAddress(ctx, senderAddr, false); err != nil {
+				return nil, err
+			}
+		}
+		res, err := k.callEVM(ctx, sender, common.HexToAddress(call.Contract), call.Data, call.GasLimit, false)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(types.EVMCallResponse{Data: res.Ret, GasUsed: res.GasUsed})
+	}
+}
+
 func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
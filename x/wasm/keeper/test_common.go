@@ -311,6 +311,8 @@ func createTestInput(
 	for acc := range maccPerms {
 		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
+	// allow the fee collector to receive funds as in the app setup
+	delete(blockedAddrs, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String())
 	require.NoError(t, accountKeeper.Params.Set(ctx, authtypes.DefaultParams()))
 
 	bankKeeper := bankkeeper.NewBaseKeeper(
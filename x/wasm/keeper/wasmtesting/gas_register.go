@@ -14,6 +14,8 @@ type MockGasRegister struct {
 	ToWasmVMGasFn       func(source storetypes.Gas) uint64
 	FromWasmVMGasFn     func(source uint64) storetypes.Gas
 	UncompressCostsFn   func(byteLength int) storetypes.Gas
+	ToEVMGasFn          func(source storetypes.Gas) uint64
+	FromEVMGasFn        func(source uint64) storetypes.Gas
 }
 
 func (m MockGasRegister) UncompressCosts(byteLength int) storetypes.Gas {
@@ -57,3 +59,17 @@ func (m MockGasRegister) FromWasmVMGas(source uint64) storetypes.Gas {
 	}
 	return m.FromWasmVMGasFn(source)
 }
+
+func (m MockGasRegister) ToEVMGas(source storetypes.Gas) uint64 {
+	if m.ToEVMGasFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ToEVMGasFn(source)
+}
+
+func (m MockGasRegister) FromEVMGas(source uint64) storetypes.Gas {
+	if m.FromEVMGasFn == nil {
+		panic("not expected to be called")
+	}
+	return m.FromEVMGasFn(source)
+}
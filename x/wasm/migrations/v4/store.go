@@ -0,0 +1,33 @@
+package v4
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx context.Context) types.Params
+	SetParams(ctx context.Context, ps types.Params) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate4to5 migrates from version 4 to 5. The gasless tx limits are set disabled, so that
+// transactions without fees are only accepted once governance enables them.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.GaslessTxLimits = types.GaslessTxLimits{}
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,32 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v4 "github.com/CosmWasm/wasmd/x/wasm/migrations/v4"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, []string{"iterator", "staking", "stargate"})
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.GaslessTxLimits = types.DefaultGaslessTxLimits()
+	require.NoError(t, wasmKeeper.SetParams(ctx, params))
+
+	// when
+	err := v4.NewMigrator(wasmKeeper).Migrate4to5(ctx)
+
+	// then
+	require.NoError(t, err)
+	got := wasmKeeper.GetParams(ctx)
+	assert.Equal(t, types.GaslessTxLimits{}, got.GaslessTxLimits)
+	assert.False(t, got.GaslessTxLimits.Enabled())
+	assert.Equal(t, params.CodeUploadAccess, got.CodeUploadAccess)
+	assert.Equal(t, params.PrecompileGas, got.PrecompileGas)
+}
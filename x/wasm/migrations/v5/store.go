@@ -0,0 +1,33 @@
+package v5
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx context.Context) types.Params
+	SetParams(ctx context.Context, ps types.Params) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate5to6 migrates from version 5 to 6. The default precompile gas schedule is set so that
+// precompile calls are charged their base and per byte input gas.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.PrecompileGas = types.DefaultPrecompileGasSchedule()
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,31 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v5 "github.com/CosmWasm/wasmd/x/wasm/migrations/v5"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate5To6(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, []string{"iterator", "staking", "stargate"})
+	wasmKeeper := keepers.WasmKeeper
+
+	params := wasmKeeper.GetParams(ctx)
+	params.PrecompileGas = types.PrecompileGasSchedule{}
+	require.NoError(t, wasmKeeper.SetParams(ctx, params))
+
+	// when
+	err := v5.NewMigrator(wasmKeeper).Migrate5to6(ctx)
+
+	// then
+	require.NoError(t, err)
+	got := wasmKeeper.GetParams(ctx)
+	assert.Equal(t, types.DefaultPrecompileGasSchedule(), got.PrecompileGas)
+	assert.Equal(t, params.CodeUploadAccess, got.CodeUploadAccess)
+	assert.Equal(t, params.GaslessTxLimits, got.GaslessTxLimits)
+}
@@ -9,6 +9,8 @@ import (
 // RandomizeGenState generates a random GenesisState for wasm
 func RandomizedGenState(simstate *module.SimulationState) {
 	params := types.DefaultParams()
+	// the simulated genesis has no contracts to make gasless. Gasless configs are set by the
+	// SimulateSetGaslessContractProposal operation instead and exported with the contracts.
 	wasmGenesis := types.GenesisState{
 		Params:    params,
 		Codes:     nil,
@@ -3,6 +3,8 @@ package simulation
 import (
 	"math/rand"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -353,7 +355,7 @@ func SimulateUnpinContractProposal(wasmKeeper WasmKeeper, codeSelector CodeIDSel
 	}
 }
 
-// Simulate set gasless contract proposal
+// Simulate set gasless contract proposal with a random gas budget
 func SimulateSetGaslessContractProposal(wasmKeeper WasmKeeper, contractSelector MsgExecuteContractSelector) simtypes.MsgSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
 		contractAddr := contractSelector(ctx, wasmKeeper)
@@ -361,12 +363,36 @@ func SimulateSetGaslessContractProposal(wasmKeeper WasmKeeper, contractSelector
 			return nil
 		}
 
-		return types.NewSetGaslessContractsProposal(
-			simtypes.RandStringOfLength(r, 10),
-			simtypes.RandStringOfLength(r, 10),
-			[]string{contractAddr.String()},
-		)
+		return &types.MsgSetGaslessContracts{
+			Authority: wasmKeeper.GetAuthority(),
+			Contracts: []string{contractAddr.String()},
+			Config:    RandomGaslessConfig(r, accs),
+		}
+	}
+}
+
+// RandomGaslessConfig returns a gas budget with random limits, an optional sponsor and an optional
+// caller allowlist of the simulation accounts
+func RandomGaslessConfig(r *rand.Rand, accs []simtypes.Account) types.GaslessConfig {
+	var config types.GaslessConfig
+	if r.Intn(2) == 0 {
+		config.MaxGasPerBlock = uint64(simtypes.RandIntBetween(r, 1_000_000, 10_000_000))
+		config.MaxGasPerTx = config.MaxGasPerBlock / uint64(simtypes.RandIntBetween(r, 1, 5))
+	}
+	if r.Intn(2) == 0 {
+		sponsor, _ := simtypes.RandomAcc(r, accs)
+		config.Sponsor = sponsor.Address.String()
+		config.SponsorGasPrice = sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 6))
+	}
+	if r.Intn(2) == 0 && len(accs) != 0 {
+		perm := r.Perm(len(accs))[:simtypes.RandIntBetween(r, 1, min(len(accs), 3)+1)]
+		allowed := make([]string, len(perm))
+		for i, j := range perm {
+			allowed[i] = accs[j].Address.String()
+		}
+		config.Policy = &types.GaslessPolicy{AllowedCallers: allowed}
 	}
+	return config
 }
 
 // Simulate unset gasless contract proposal
@@ -23,6 +23,9 @@ const (
 
 	// contracts in the current tx
 	contextKeyTxContracts contextKey = iota
+
+	// set when called from a precompile of an EVM call
+	contextKeyWithinEVM contextKey = iota
 )
 
 // WithTXCounter stores a transaction counter value in the context
@@ -98,3 +101,14 @@ func TxContractsFromContext(ctx context.Context) (TxContracts, bool) {
 	val, ok := ctx.Value(contextKeyTxContracts).(TxContracts)
 	return val, ok
 }
+
+// WithinEVM marks the context returned as the context of a precompile called by the EVM
+func WithinEVM(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(contextKeyWithinEVM, true)
+}
+
+// IsWithinEVM returns true when the context is the context of a precompile called by the EVM
+func IsWithinEVM(ctx context.Context) bool {
+	val, _ := ctx.Value(contextKeyWithinEVM).(bool)
+	return val
+}
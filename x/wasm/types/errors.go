@@ -95,6 +95,15 @@ var (
 
 	// ErrUnsetGaslessFailed error for unsetting gasless contract failures
 	ErrUnsetGaslessFailed = errorsmod.Register(DefaultCodespace, 41, "unsetting gasless contract failed")
+
+	// ErrGaslessTxLimit error if an account exceeds the gasless tx rate limit
+	ErrGaslessTxLimit = errorsmod.Register(DefaultCodespace, 42, "gasless tx limit exceeded")
+
+	// ErrEVMCall error for EVM calls that failed or reverted
+	ErrEVMCall = errorsmod.Register(DefaultCodespace, 43, "evm call failed")
+
+	// ErrInsufficientAllowance error when a transfer exceeds the bank allowance of the spender
+	ErrInsufficientAllowance = errorsmod.Register(DefaultCodespace, 44, "insufficient allowance")
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
 )
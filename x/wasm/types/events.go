@@ -29,6 +29,8 @@ const (
 	EventTypeUpdateContractLabel    = "update_contract_label"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
 	EventTypePacketRecv             = "ibc_packet_received"
+	EventTypeCallEVM                = "call_evm"
+	EventTypeRegisterBankPointer    = "register_bank_pointer"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
 
@@ -69,4 +71,9 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyEVMContract         = "evm_contract"
+	AttributeKeyEVMSender           = "evm_sender"
+	AttributeKeyEVMGasUsed          = "evm_gas_used"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyPointer             = "pointer"
 )
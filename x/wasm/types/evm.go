@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EVMCustomMsg is the custom message sent by contracts to call EVM contracts:
+//
+//	{"evm":{"call":{"contract":"0x...","data":"<base64>","gas_limit":100000}}}
+type EVMCustomMsg struct {
+	EVM *EVMMsg `json:"evm,omitempty"`
+}
+
+// EVMMsg defines the EVM operations a contract can execute
+type EVMMsg struct {
+	Call *EVMCall `json:"call,omitempty"`
+}
+
+// EVMCall calls an EVM contract with the EVM address mapped to the contract as msg.sender.
+// The call runs with GasLimit EVM gas at most.
+type EVMCall struct {
+	// Contract is the hex encoded address of the EVM contract
+	Contract string `json:"contract"`
+	// Data is the ABI encoded call data
+	Data []byte `json:"data"`
+	// GasLimit is the EVM gas available to the call
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// EVMCustomQuery is the custom query sent by contracts to static call EVM contracts:
+//
+//	{"evm":{"static_call":{"contract":"0x...","data":"<base64>","gas_limit":100000}}}
+type EVMCustomQuery struct {
+	EVM *EVMQuery `json:"evm,omitempty"`
+}
+
+// EVMQuery defines the EVM queries a contract can make
+type EVMQuery struct {
+	StaticCall *EVMStaticCall `json:"static_call,omitempty"`
+}
+
+// EVMStaticCall calls an EVM contract without persisting any state changes
+type EVMStaticCall struct {
+	// Sender is the optional bech32 address whose mapped EVM address is used as msg.sender.
+	// The zero address is used when empty.
+	Sender string `json:"sender,omitempty"`
+	// Contract is the hex encoded address of the EVM contract
+	Contract string `json:"contract"`
+	// Data is the ABI encoded call data
+	Data []byte `json:"data"`
+	// GasLimit is the EVM gas available to the call
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// EVMCallResponse is the JSON response of an EVM call or static call
+type EVMCallResponse struct {
+	// Data contains the bytes returned by the EVM contract
+	Data []byte `json:"data"`
+	// GasUsed is the EVM gas used by the call
+	GasUsed uint64 `json:"gas_used"`
+}
+
+// ValidateEVMCall validates the target and gas limit of an EVM call
+func ValidateEVMCall(contract string, gasLimit uint64) error {
+	if !common.IsHexAddress(contract) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "contract: %q is not a hex address", contract)
+	}
+	if gasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalid, "gas limit must not be zero")
+	}
+	return nil
+}
+
+// BankPointerAddress returns the address of the ERC20 pointer contract of a bank denom.
+// It is the last 20 bytes of keccak256("bank_pointer" | denom).
+func BankPointerAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("bank_pointer"), []byte(denom)))
+}
@@ -13,6 +13,10 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // BankViewKeeper defines a subset of methods implemented by the cosmos-sdk bank keeper
@@ -139,3 +143,11 @@ type CapabilityKeeper interface {
 type ICS20TransferPortSource interface {
 	GetPort(ctx sdk.Context) string
 }
+
+// EVMKeeper is a subset of the ethermint EVM keeper used by contracts to call EVM contracts
+type EVMKeeper interface {
+	GetEvmAddressMapping(ctx sdk.Context, addr sdk.AccAddress) (*common.Address, error)
+	GetCosmosAddressMapping(ctx sdk.Context, evmAddress common.Address) sdk.AccAddress
+	SetAddressMapping(ctx sdk.Context, cosmosAddress sdk.AccAddress, evmAddress common.Address)
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
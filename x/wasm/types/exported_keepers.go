@@ -9,6 +9,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // ViewKeeper provides read only operations
@@ -26,6 +27,9 @@ type ViewKeeper interface {
 	IterateCodeInfos(ctx context.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx context.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx context.Context, codeID uint64) bool
+	GetGaslessConfig(ctx context.Context, contractAddress sdk.AccAddress) *GaslessConfig
+	RemainingGaslessBlockGas(ctx context.Context, contractAddress sdk.AccAddress) (uint64, bool)
+	GetBankPointerDenom(ctx context.Context, pointer common.Address) (string, bool)
 	GetParams(ctx context.Context) Params
 }
 
@@ -77,8 +81,8 @@ type ContractOpsKeeper interface {
 	// UnpinCode removes the wasm contract from wasmvm cache
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 
-	// SetGasless set the gasless wasm contract in wasmvm cache
-	SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	// SetGasless set the gasless wasm contract with the given gas budget
+	SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress, config GaslessConfig) error
 
 	// UnsetGasless removes the gasless wasm contract from wasmvm cache
 	UnsetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -59,6 +61,9 @@ const (
 	DefaultPerCustomEventCost uint64 = 20
 	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge.
 	DefaultEventAttributeDataFreeTier = 100
+	// DefaultEVMGasMultiplier is how many EVM gas points = 1 Cosmos SDK gas point.
+	// EVM calls made by contracts are charged with the EVM gas used converted into SDK gas.
+	DefaultEVMGasMultiplier uint64 = 1
 )
 
 // default: 0.15 gas.
@@ -92,6 +97,10 @@ type GasRegister interface {
 	//
 	// [CosmWasm gas]: https://github.com/CosmWasm/cosmwasm/blob/v1.3.1/docs/GAS.md
 	FromWasmVMGas(source uint64) storetypes.Gas
+	// ToEVMGas converts from Cosmos SDK gas units to EVM gas
+	ToEVMGas(source storetypes.Gas) uint64
+	// FromEVMGas converts from EVM gas to Cosmos SDK gas units
+	FromEVMGas(source uint64) storetypes.Gas
 }
 
 // WasmGasRegisterConfig config type
@@ -126,6 +135,9 @@ type WasmGasRegisterConfig struct {
 	ContractMessageDataCost storetypes.Gas
 	// CustomEventCost cost per custom event
 	CustomEventCost uint64
+	// EVMGasMultiplier is how many EVM gas points = 1 sdk gas point.
+	// Zero defaults to DefaultEVMGasMultiplier.
+	EVMGasMultiplier uint64
 }
 
 // DefaultGasRegisterConfig default values
@@ -141,6 +153,7 @@ func DefaultGasRegisterConfig() WasmGasRegisterConfig {
 		EventAttributeDataFreeTier: DefaultEventAttributeDataFreeTier,
 		ContractMessageDataCost:    DefaultContractMessageDataCost,
 		UncompressCost:             DefaultPerByteUncompressCost(),
+		EVMGasMultiplier:           DefaultEVMGasMultiplier,
 	}
 }
 
@@ -159,6 +172,9 @@ func NewWasmGasRegister(c WasmGasRegisterConfig) WasmGasRegister {
 	if c.GasMultiplier == 0 {
 		panic(errorsmod.Wrap(sdkerrors.ErrLogic, "GasMultiplier can not be 0"))
 	}
+	if c.EVMGasMultiplier == 0 {
+		c.EVMGasMultiplier = DefaultEVMGasMultiplier
+	}
 	return WasmGasRegister{
 		c: c,
 	}
@@ -263,3 +279,21 @@ func (g WasmGasRegister) ToWasmVMGas(source storetypes.Gas) uint64 {
 func (g WasmGasRegister) FromWasmVMGas(source uint64) storetypes.Gas {
 	return source / g.c.GasMultiplier
 }
+
+// ToEVMGas converts from Cosmos SDK gas units to EVM gas
+func (g WasmGasRegister) ToEVMGas(source storetypes.Gas) uint64 {
+	x := source * g.c.EVMGasMultiplier
+	if source != 0 && x/source != g.c.EVMGasMultiplier {
+		return math.MaxUint64
+	}
+	return x
+}
+
+// FromEVMGas converts from EVM gas to Cosmos SDK gas units, rounded up so that EVM gas is never free
+func (g WasmGasRegister) FromEVMGas(source uint64) storetypes.Gas {
+	x := source / g.c.EVMGasMultiplier
+	if source%g.c.EVMGasMultiplier != 0 {
+		x++
+	}
+	return x
+}
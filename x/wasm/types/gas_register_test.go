@@ -330,6 +330,52 @@ func TestFromWasmVMGasConversion(t *testing.T) {
 	}
 }
 
+func TestEVMGasConversion(t *testing.T) {
+	specs := map[string]struct {
+		srcConfig WasmGasRegisterConfig
+		sdkGas    storetypes.Gas
+		expEVMGas uint64
+		evmGas    uint64
+		expSDKGas storetypes.Gas
+	}{
+		"default": {
+			srcConfig: DefaultGasRegisterConfig(),
+			sdkGas:    100,
+			expEVMGas: 100,
+			evmGas:    100,
+			expSDKGas: 100,
+		},
+		"unset multiplier defaults": {
+			srcConfig: WasmGasRegisterConfig{GasMultiplier: 1},
+			sdkGas:    1,
+			expEVMGas: DefaultEVMGasMultiplier,
+			evmGas:    DefaultEVMGasMultiplier,
+			expSDKGas: 1,
+		},
+		"rounded up to sdk gas": {
+			srcConfig: WasmGasRegisterConfig{GasMultiplier: 1, EVMGasMultiplier: 10},
+			sdkGas:    2,
+			expEVMGas: 20,
+			evmGas:    21,
+			expSDKGas: 3,
+		},
+		"overflow capped": {
+			srcConfig: WasmGasRegisterConfig{GasMultiplier: 1, EVMGasMultiplier: 10},
+			sdkGas:    math.MaxUint64,
+			expEVMGas: math.MaxUint64,
+			evmGas:    math.MaxUint64,
+			expSDKGas: math.MaxUint64/10 + 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			r := NewWasmGasRegister(spec.srcConfig)
+			assert.Equal(t, spec.expEVMGas, r.ToEVMGas(spec.sdkGas))
+			assert.Equal(t, spec.expSDKGas, r.FromEVMGas(spec.evmGas))
+		})
+	}
+}
+
 func TestUncompressCosts(t *testing.T) {
 	specs := map[string]struct {
 		lenIn    int
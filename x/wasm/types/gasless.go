@@ -0,0 +1,210 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = &GaslessConfig{}
+	_ cdctypes.UnpackInterfacesMessage = &GaslessPolicy{}
+)
+
+// ValidateBasic syntax checks
+func (c GaslessConfig) ValidateBasic() error {
+	if c.MaxGasPerBlock != 0 && c.MaxGasPerTx > c.MaxGasPerBlock {
+		return errorsmod.Wrap(ErrInvalid, "max gas per tx exceeds max gas per block")
+	}
+	if c.Policy != nil {
+		if err := c.Policy.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "policy")
+		}
+	}
+	if c.Sponsor == "" {
+		if !c.SponsorGasPrice.Amount.IsNil() && !c.SponsorGasPrice.IsZero() {
+			return errorsmod.Wrap(ErrEmpty, "sponsor")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "sponsor")
+	}
+	if c.SponsorGasPrice.Amount.IsNil() || !c.SponsorGasPrice.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "sponsor gas price")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c GaslessConfig) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if c.Policy == nil {
+		return nil
+	}
+	return c.Policy.UnpackInterfaces(unpacker)
+}
+
+// HasSponsor returns true when an account pays for the consumed gas
+func (c GaslessConfig) HasSponsor() bool {
+	return c.Sponsor != ""
+}
+
+// SponsorFee returns the amount charged to the sponsor for the given gas
+func (c GaslessConfig) SponsorFee(gasUsed uint64) sdk.Coins {
+	if !c.HasSponsor() || c.SponsorGasPrice.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+	amount := c.SponsorGasPrice.Amount.MulInt64(int64(gasUsed)).Ceil().RoundInt()
+	return sdk.NewCoins(sdk.NewCoin(c.SponsorGasPrice.Denom, amount))
+}
+
+// RemainingBlockGas returns the gas left for gasless executions within the block of the given usage.
+// The result is 0 when the config does not limit the gas per block.
+func (c GaslessConfig) RemainingBlockGas(usage GaslessUsage, height int64) uint64 {
+	if c.MaxGasPerBlock == 0 {
+		return 0
+	}
+	used := usage.BlockGasUsed
+	if usage.Height != height {
+		used = 0
+	}
+	if used >= c.MaxGasPerBlock {
+		return 0
+	}
+	return c.MaxGasPerBlock - used
+}
+
+// RemainingTxGas returns the gas left for gasless executions within the transaction of the given usage.
+// The result is 0 when the config does not limit the gas per transaction.
+func (c GaslessConfig) RemainingTxGas(usage GaslessUsage, height int64, txIndex uint32) uint64 {
+	if c.MaxGasPerTx == 0 {
+		return 0
+	}
+	used := usage.TxGasUsed
+	if usage.Height != height || usage.TxIndex != txIndex {
+		used = 0
+	}
+	if used >= c.MaxGasPerTx {
+		return 0
+	}
+	return c.MaxGasPerTx - used
+}
+
+// Add returns the usage with the given gas added for the block and transaction
+func (u GaslessUsage) Add(height int64, txIndex uint32, gasUsed uint64) GaslessUsage {
+	if u.Height != height {
+		u = GaslessUsage{Height: height}
+	}
+	if u.TxIndex != txIndex {
+		u.TxIndex, u.TxGasUsed = txIndex, 0
+	}
+	u.BlockGasUsed += gasUsed
+	u.TxGasUsed += gasUsed
+	return u
+}
+
+// NewGaslessPolicy constructor. The filter is optional.
+func NewGaslessPolicy(filter ContractAuthzFilterX, allowedCallers ...sdk.AccAddress) (*GaslessPolicy, error) {
+	var policy GaslessPolicy
+	if filter != nil {
+		pFilter, ok := filter.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
+		}
+		anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "filter")
+		}
+		policy.Filter = anyFilter
+	}
+	for _, c := range allowedCallers {
+		policy.AllowedCallers = append(policy.AllowedCallers, c.String())
+	}
+	return &policy, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p GaslessPolicy) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if p.Filter == nil {
+		return nil
+	}
+	var f ContractAuthzFilterX
+	return errorsmod.Wrap(unpacker.UnpackAny(p.Filter, &f), "filter")
+}
+
+// GetFilter returns the cached value from the GaslessPolicy.Filter if present.
+func (p GaslessPolicy) GetFilter() ContractAuthzFilterX {
+	if p.Filter == nil {
+		return NewAllowAllMessagesFilter()
+	}
+	f, ok := p.Filter.GetCachedValue().(ContractAuthzFilterX)
+	if !ok {
+		return &UndefinedFilter{}
+	}
+	return f
+}
+
+// ValidateBasic syntax checks
+func (p GaslessPolicy) ValidateBasic() error {
+	for i, c := range p.AllowedCallers {
+		if _, err := sdk.AccAddressFromBech32(c); err != nil {
+			return errorsmod.Wrapf(err, "allowed caller at %d", i)
+		}
+	}
+	if hasDuplicates(p.AllowedCallers) {
+		return errorsmod.Wrap(ErrDuplicate, "allowed callers")
+	}
+	if err := p.GetFilter().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "filter")
+	}
+	return nil
+}
+
+// Accept returns true when the caller and message qualify for a gasless execution
+func (p GaslessPolicy) Accept(ctx sdk.Context, caller sdk.AccAddress, msg RawContractMessage) (bool, error) {
+	if len(p.AllowedCallers) != 0 && !p.isAllowedCaller(caller) {
+		return false, nil
+	}
+	return p.GetFilter().Accept(ctx, msg)
+}
+
+func (p GaslessPolicy) isAllowedCaller(caller sdk.AccAddress) bool {
+	for _, c := range p.AllowedCallers {
+		if addr, err := sdk.AccAddressFromBech32(c); err == nil && addr.Equals(caller) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultGaslessTxLimits returns the default limits for gasless transactions
+func DefaultGaslessTxLimits() GaslessTxLimits {
+	return GaslessTxLimits{
+		MaxGas:           1_000_000,
+		MaxTxsPerAccount: 10,
+		WindowBlocks:     100,
+	}
+}
+
+// ValidateBasic syntax checks
+func (l GaslessTxLimits) ValidateBasic() error {
+	if !l.Enabled() {
+		return nil
+	}
+	if l.MaxTxsPerAccount == 0 {
+		return errorsmod.Wrap(ErrEmpty, "max txs per account")
+	}
+	if l.WindowBlocks <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "window blocks must be positive")
+	}
+	return nil
+}
+
+// Enabled returns true when transactions without fees are accepted for gasless contracts
+func (l GaslessTxLimits) Enabled() bool {
+	return l.MaxGas != 0
+}
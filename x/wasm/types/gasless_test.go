@@ -0,0 +1,144 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGaslessConfigValidateBasic(t *testing.T) {
+	var anyAddress sdk.AccAddress = make([]byte, ContractAddrLen)
+
+	specs := map[string]struct {
+		src    GaslessConfig
+		expErr bool
+	}{
+		"unlimited": {
+			src: GaslessConfig{},
+		},
+		"with limits": {
+			src: GaslessConfig{MaxGasPerBlock: 100, MaxGasPerTx: 10},
+		},
+		"with sponsor": {
+			src: GaslessConfig{Sponsor: anyAddress.String(), SponsorGasPrice: sdk.NewDecCoin("stake", sdkmath.OneInt())},
+		},
+		"tx limit exceeds block limit": {
+			src:    GaslessConfig{MaxGasPerBlock: 10, MaxGasPerTx: 100},
+			expErr: true,
+		},
+		"invalid sponsor": {
+			src:    GaslessConfig{Sponsor: "invalid", SponsorGasPrice: sdk.NewDecCoin("stake", sdkmath.OneInt())},
+			expErr: true,
+		},
+		"sponsor without gas price": {
+			src:    GaslessConfig{Sponsor: anyAddress.String()},
+			expErr: true,
+		},
+		"with policy": {
+			src: GaslessConfig{Policy: mustGaslessPolicy(t, NewAcceptedMessageKeysFilter("foo"), anyAddress)},
+		},
+		"policy with invalid filter": {
+			src:    GaslessConfig{Policy: mustGaslessPolicy(t, NewAcceptedMessageKeysFilter())},
+			expErr: true,
+		},
+		"policy with duplicate callers": {
+			src:    GaslessConfig{Policy: mustGaslessPolicy(t, nil, anyAddress, anyAddress)},
+			expErr: true,
+		},
+		"gas price without sponsor": {
+			src:    GaslessConfig{SponsorGasPrice: sdk.NewDecCoin("stake", sdkmath.OneInt())},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGaslessPolicyAccept(t *testing.T) {
+	var (
+		alice sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
+		bob   sdk.AccAddress = bytes.Repeat([]byte{2}, ContractAddrLen)
+	)
+	specs := map[string]struct {
+		policy    *GaslessPolicy
+		caller    sdk.AccAddress
+		msg       RawContractMessage
+		expAccept bool
+	}{
+		"empty policy": {
+			policy:    mustGaslessPolicy(t, nil),
+			caller:    bob,
+			msg:       []byte(`{"foo":{}}`),
+			expAccept: true,
+		},
+		"allowed caller": {
+			policy:    mustGaslessPolicy(t, nil, alice),
+			caller:    alice,
+			msg:       []byte(`{"foo":{}}`),
+			expAccept: true,
+		},
+		"not allowed caller": {
+			policy: mustGaslessPolicy(t, nil, alice),
+			caller: bob,
+			msg:    []byte(`{"foo":{}}`),
+		},
+		"accepted message": {
+			policy:    mustGaslessPolicy(t, NewAcceptedMessagesFilter([]byte(`{"foo":{}}`))),
+			caller:    bob,
+			msg:       []byte(`{"foo":{}}`),
+			expAccept: true,
+		},
+		"not accepted message": {
+			policy: mustGaslessPolicy(t, NewAcceptedMessageKeysFilter("bar"), bob),
+			caller: bob,
+			msg:    []byte(`{"foo":{}}`),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			gotAccept, err := spec.policy.Accept(ctx, spec.caller, spec.msg)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expAccept, gotAccept)
+		})
+	}
+}
+
+func mustGaslessPolicy(t *testing.T, filter ContractAuthzFilterX, callers ...sdk.AccAddress) *GaslessPolicy {
+	t.Helper()
+	policy, err := NewGaslessPolicy(filter, callers...)
+	require.NoError(t, err)
+	return policy
+}
+
+func TestGaslessUsage(t *testing.T) {
+	config := GaslessConfig{MaxGasPerBlock: 100, MaxGasPerTx: 60}
+
+	usage := GaslessUsage{}.Add(1, 0, 50)
+	assert.Equal(t, uint64(50), config.RemainingBlockGas(usage, 1))
+	assert.Equal(t, uint64(10), config.RemainingTxGas(usage, 1, 0))
+
+	// new tx in same block
+	usage = usage.Add(1, 1, 40)
+	assert.Equal(t, uint64(10), config.RemainingBlockGas(usage, 1))
+	assert.Equal(t, uint64(20), config.RemainingTxGas(usage, 1, 1))
+
+	// new block
+	assert.Equal(t, uint64(100), config.RemainingBlockGas(usage, 2))
+	assert.Equal(t, uint64(60), config.RemainingTxGas(usage, 2, 1))
+}
@@ -5,6 +5,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func (s Sequence) ValidateBasic() error {
@@ -33,6 +34,37 @@ func (s GenesisState) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	gaslessContracts := make(map[string]struct{}, len(s.GaslessContracts))
+	for i := range s.GaslessContracts {
+		if err := s.GaslessContracts[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "gasless contract: %d", i)
+		}
+		if _, exists := gaslessContracts[s.GaslessContracts[i].ContractAddress]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "gasless contract: %d", i)
+		}
+		gaslessContracts[s.GaslessContracts[i].ContractAddress] = struct{}{}
+	}
+	allowances := make(map[string]struct{}, len(s.BankAllowances))
+	for i, a := range s.BankAllowances {
+		if err := a.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "bank allowance: %d", i)
+		}
+		key := a.Owner + "/" + a.Spender + "/" + a.Denom
+		if _, exists := allowances[key]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "bank allowance: %d", i)
+		}
+		allowances[key] = struct{}{}
+	}
+	pointers := make(map[string]struct{}, len(s.BankPointers))
+	for i, p := range s.BankPointers {
+		if err := p.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "bank pointer: %d", i)
+		}
+		if _, exists := pointers[p.Denom]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "bank pointer: %d", i)
+		}
+		pointers[p.Denom] = struct{}{}
+	}
 
 	return nil
 }
@@ -77,6 +109,42 @@ func (c Contract) ValidateBasic() error {
 	return nil
 }
 
+func (g GaslessContractEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "contract address")
+	}
+	if err := g.Config.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "config")
+	}
+	return nil
+}
+
+func (a BankAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return errorsmod.Wrap(err, "owner")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Spender); err != nil {
+		return errorsmod.Wrap(err, "spender")
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(err, "denom")
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalid, "amount must be positive")
+	}
+	return nil
+}
+
+func (p BankPointer) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(err, "denom")
+	}
+	if !common.IsHexAddress(p.Address) || common.HexToAddress(p.Address) != BankPointerAddress(p.Denom) {
+		return errorsmod.Wrap(ErrInvalid, "address does not match denom")
+	}
+	return nil
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
@@ -92,6 +160,11 @@ func (s GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, v := range s.GaslessContracts {
+		if err := v.Config.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
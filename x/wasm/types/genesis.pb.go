@@ -27,10 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Params           Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes            []Code                 `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts        []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences        []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	GaslessContracts []GaslessContractEntry `protobuf:"bytes,5,rep,name=gasless_contracts,json=gaslessContracts,proto3" json:"gasless_contracts,omitempty"`
+	BankAllowances   []BankAllowance        `protobuf:"bytes,6,rep,name=bank_allowances,json=bankAllowances,proto3" json:"bank_allowances,omitempty"`
+	BankPointers     []BankPointer          `protobuf:"bytes,7,rep,name=bank_pointers,json=bankPointers,proto3" json:"bank_pointers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +97,27 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetGaslessContracts() []GaslessContractEntry {
+	if m != nil {
+		return m.GaslessContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBankAllowances() []BankAllowance {
+	if m != nil {
+		return m.BankAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBankPointers() []BankPointer {
+	if m != nil {
+		return m.BankPointers
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -286,54 +310,117 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// GaslessContractEntry contract address and budget of a gasless contract
+type GaslessContractEntry struct {
+	ContractAddress string        `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Config          GaslessConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *GaslessContractEntry) Reset()         { *m = GaslessContractEntry{} }
+func (m *GaslessContractEntry) String() string { return proto.CompactTextString(m) }
+func (*GaslessContractEntry) ProtoMessage()    {}
+func (*GaslessContractEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
+}
+func (m *GaslessContractEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessContractEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessContractEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessContractEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessContractEntry.Merge(m, src)
+}
+func (m *GaslessContractEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessContractEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessContractEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessContractEntry proto.InternalMessageInfo
+
+func (m *GaslessContractEntry) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *GaslessContractEntry) GetConfig() GaslessConfig {
+	if m != nil {
+		return m.Config
+	}
+	return GaslessConfig{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
+	proto.RegisterType((*GaslessContractEntry)(nil), "cosmwasm.wasm.v1.GaslessContractEntry")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x84, 0x6a, 0xa4, 0x55, 0x91, 0x50,
-	0x35, 0x41, 0xa3, 0x8d, 0x23, 0x17, 0xc8, 0x86, 0xa0, 0x4c, 0x20, 0x94, 0x1e, 0x90, 0x76, 0xa9,
-	0xd2, 0xd8, 0xeb, 0x2c, 0x96, 0xb8, 0xc4, 0x6e, 0x21, 0xdf, 0x82, 0x4f, 0x81, 0x38, 0x72, 0xe0,
-	0x43, 0xec, 0xc6, 0x84, 0x84, 0xc4, 0xa9, 0x42, 0xed, 0x01, 0x89, 0x4f, 0x81, 0xfc, 0x27, 0x59,
-	0xd4, 0xae, 0x17, 0xab, 0xf6, 0xf3, 0xbe, 0xbf, 0xbe, 0xef, 0xe3, 0x37, 0x06, 0x76, 0x40, 0x59,
-	0xf8, 0xd1, 0x67, 0xa1, 0x23, 0x97, 0xc9, 0x9e, 0x33, 0xc4, 0x11, 0x66, 0x84, 0x75, 0x46, 0x31,
-	0xe5, 0x14, 0x6e, 0xa6, 0x7a, 0x47, 0x2e, 0x93, 0xbd, 0xfa, 0xd6, 0x90, 0x0e, 0xa9, 0x14, 0x1d,
-	0xf1, 0x4b, 0xc5, 0xd5, 0x77, 0x96, 0x38, 0x3c, 0x19, 0x61, 0x4d, 0xa9, 0xdf, 0xf4, 0x43, 0x12,
-	0x51, 0x47, 0xae, 0xfa, 0xe8, 0xae, 0x48, 0xa0, 0xac, 0xaf, 0x48, 0x6a, 0xa3, 0xa4, 0xd6, 0x8f,
-	0x22, 0xa8, 0xbd, 0x50, 0x55, 0xf4, 0xb8, 0xcf, 0x31, 0x7c, 0x02, 0xcc, 0x91, 0x1f, 0xfb, 0x21,
-	0xb3, 0x8c, 0xa6, 0xd1, 0x5e, 0xdf, 0xb7, 0x3a, 0x8b, 0x55, 0x75, 0xde, 0x4a, 0xdd, 0xad, 0x9e,
-	0x4f, 0x1b, 0x85, 0xaf, 0x7f, 0xbf, 0xed, 0x1a, 0x9e, 0x4e, 0x81, 0xaf, 0x40, 0x39, 0xa0, 0x08,
-	0x33, 0xab, 0xd8, 0x5c, 0x6b, 0xaf, 0xef, 0x6f, 0x2f, 0xe7, 0x1e, 0x50, 0x84, 0xdd, 0x1d, 0x91,
-	0xf9, 0x6f, 0xda, 0xd8, 0x90, 0xc1, 0x0f, 0x69, 0x48, 0x38, 0x0e, 0x47, 0x3c, 0x51, 0x30, 0x85,
-	0x80, 0xc7, 0xa0, 0x1a, 0xd0, 0x88, 0xc7, 0x7e, 0xc0, 0x99, 0xb5, 0x26, 0x79, 0xf5, 0xab, 0x78,
-	0x2a, 0xc4, 0x6d, 0x6a, 0xe6, 0xad, 0x2c, 0x69, 0x91, 0x7b, 0x89, 0x13, 0x6c, 0x86, 0x3f, 0x8c,
-	0x71, 0x14, 0x60, 0x66, 0x95, 0x56, 0xb1, 0x7b, 0x3a, 0xe4, 0x92, 0x9d, 0x25, 0x2d, 0xb1, 0x33,
-	0xa5, 0xf5, 0xc5, 0x00, 0x25, 0xd1, 0x25, 0xbc, 0x0f, 0xae, 0x89, 0x4e, 0xfa, 0x04, 0x49, 0x2b,
-	0x4b, 0x2e, 0x98, 0x4d, 0x1b, 0xa6, 0x90, 0xba, 0x87, 0x9e, 0x29, 0xa4, 0x2e, 0x82, 0xae, 0xe8,
-	0x52, 0x04, 0x45, 0x27, 0xd4, 0x2a, 0x4a, 0xc7, 0xeb, 0x57, 0xbb, 0xd6, 0x8d, 0x4e, 0x68, 0xde,
-	0xf3, 0x4a, 0xa0, 0x0f, 0xe1, 0x3d, 0x00, 0x24, 0x63, 0x90, 0x70, 0x2c, 0xac, 0x32, 0xda, 0x35,
-	0x4f, 0x52, 0x5d, 0x71, 0x00, 0xb7, 0x81, 0x39, 0x22, 0x51, 0x84, 0x91, 0x55, 0x6a, 0x1a, 0xed,
-	0x8a, 0xa7, 0x77, 0xad, 0x5f, 0x45, 0x50, 0x49, 0xed, 0x83, 0x07, 0x60, 0x33, 0xb5, 0xa7, 0xef,
-	0x23, 0x14, 0x63, 0xa6, 0x06, 0xa0, 0xea, 0x5a, 0x3f, 0xbf, 0x3f, 0xda, 0xd2, 0x33, 0xf3, 0x4c,
-	0x29, 0x3d, 0x1e, 0x93, 0x68, 0xe8, 0x6d, 0xa4, 0x19, 0xfa, 0x18, 0xbe, 0x01, 0xd7, 0x33, 0x48,
-	0xae, 0x21, 0x7b, 0xf5, 0xb5, 0x2d, 0x36, 0x55, 0x0b, 0x72, 0x02, 0xec, 0x82, 0x1b, 0x19, 0x8f,
-	0x89, 0xe9, 0xd4, 0x73, 0x70, 0x67, 0x19, 0xf8, 0x9a, 0x22, 0x7c, 0x96, 0x27, 0x65, 0x95, 0xa8,
-	0xb1, 0x26, 0xe0, 0x76, 0x86, 0x92, 0x66, 0x9d, 0x12, 0xc6, 0x69, 0x9c, 0xe8, 0xdb, 0xdf, 0x5d,
-	0x5d, 0xa2, 0xf0, 0xfe, 0xa5, 0x0a, 0x7e, 0x1e, 0xf1, 0x38, 0xc9, 0xff, 0x49, 0x36, 0x6c, 0xb9,
-	0xa0, 0x96, 0x0b, 0x2a, 0xe9, 0xe4, 0xc0, 0x26, 0x30, 0x09, 0xea, 0xbf, 0xc7, 0x89, 0x34, 0xb3,
-	0xe6, 0x56, 0x67, 0xd3, 0x46, 0xb9, 0x7b, 0x78, 0x84, 0x13, 0xaf, 0x4c, 0xd0, 0x11, 0x4e, 0xe0,
-	0x16, 0x28, 0x4f, 0xfc, 0xb3, 0x31, 0x96, 0x5e, 0x95, 0x3c, 0xb5, 0x71, 0x9f, 0x9e, 0xcf, 0x6c,
-	0xe3, 0x62, 0x66, 0x1b, 0x7f, 0x66, 0xb6, 0xf1, 0x79, 0x6e, 0x17, 0x2e, 0xe6, 0x76, 0xe1, 0xf7,
-	0xdc, 0x2e, 0x1c, 0x3f, 0x18, 0x12, 0x7e, 0x3a, 0x1e, 0x74, 0x02, 0x1a, 0x3a, 0x07, 0x94, 0x85,
-	0xef, 0xd2, 0x77, 0x00, 0x39, 0x9f, 0xd4, 0x7b, 0x20, 0x1f, 0x83, 0x81, 0x29, 0xbf, 0xef, 0xc7,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0xf2, 0x58, 0x75, 0x04, 0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0xbb, 0xd0, 0x2e, 0xed, 0x50, 0xde, 0x86, 0x0a, 0x4b, 0x85, 0x6d, 0x53, 0x0d, 0x69,
+	0x88, 0xb6, 0x01, 0x8f, 0x5e, 0x64, 0xc0, 0x60, 0x25, 0x1a, 0x52, 0x0e, 0x26, 0x5c, 0x9a, 0xed,
+	0xee, 0x74, 0xd9, 0xd0, 0xdd, 0xa9, 0x3b, 0x03, 0xb8, 0x07, 0xbf, 0x83, 0xdf, 0xc0, 0x9b, 0x31,
+	0x9e, 0x3c, 0xf8, 0x21, 0x38, 0x12, 0x13, 0x13, 0x4f, 0x8d, 0x29, 0x07, 0x13, 0x3f, 0x85, 0xd9,
+	0x99, 0xd9, 0xb6, 0xec, 0x16, 0x4f, 0x5e, 0xb6, 0xdd, 0x79, 0x9e, 0xff, 0xef, 0x3f, 0x2f, 0xcf,
+	0x33, 0x0b, 0x74, 0x93, 0x50, 0xf7, 0xd2, 0xa0, 0x6e, 0x9d, 0x3f, 0x2e, 0xb6, 0xeb, 0x36, 0xf6,
+	0x30, 0x75, 0x68, 0xad, 0xe7, 0x13, 0x46, 0xe0, 0x62, 0x14, 0xaf, 0xf1, 0xc7, 0xc5, 0x76, 0xb1,
+	0x60, 0x13, 0x9b, 0xf0, 0x60, 0x3d, 0xfc, 0x27, 0xf2, 0x8a, 0xeb, 0x09, 0x0e, 0x0b, 0x7a, 0x58,
+	0x52, 0x8a, 0x4b, 0x86, 0xeb, 0x78, 0xa4, 0xce, 0x9f, 0x72, 0x68, 0x2d, 0x14, 0x10, 0xda, 0x12,
+	0x24, 0xf1, 0x22, 0x42, 0x95, 0x2f, 0x19, 0x90, 0x3f, 0x10, 0xb3, 0x38, 0x66, 0x06, 0xc3, 0xf0,
+	0x29, 0x50, 0x7b, 0x86, 0x6f, 0xb8, 0x54, 0x53, 0xca, 0x4a, 0x75, 0x76, 0x47, 0xab, 0xc5, 0x67,
+	0x55, 0x3b, 0xe2, 0x71, 0x94, 0xbb, 0xea, 0x97, 0x52, 0x9f, 0x7f, 0x7f, 0xdd, 0x52, 0x9a, 0x52,
+	0x02, 0x5f, 0x82, 0x8c, 0x49, 0x2c, 0x4c, 0xb5, 0xa9, 0xf2, 0x74, 0x75, 0x76, 0x67, 0x25, 0xa9,
+	0xdd, 0x23, 0x16, 0x46, 0xeb, 0xa1, 0xf2, 0x4f, 0xbf, 0xb4, 0xc0, 0x93, 0x1f, 0x11, 0xd7, 0x61,
+	0xd8, 0xed, 0xb1, 0x40, 0xc0, 0x04, 0x02, 0x9e, 0x80, 0x9c, 0x49, 0x3c, 0xe6, 0x1b, 0x26, 0xa3,
+	0xda, 0x34, 0xe7, 0x15, 0x27, 0xf1, 0x44, 0x0a, 0x2a, 0x4b, 0xe6, 0xf2, 0x50, 0x14, 0xe7, 0x8e,
+	0x70, 0x21, 0x9b, 0xe2, 0xb7, 0xe7, 0xd8, 0x33, 0x31, 0xd5, 0xd2, 0x77, 0xb1, 0x8f, 0x65, 0xca,
+	0x88, 0x3d, 0x14, 0x25, 0xd8, 0xc3, 0x08, 0x7c, 0x0f, 0x96, 0x6c, 0x83, 0x76, 0x31, 0xa5, 0xad,
+	0xd1, 0xfc, 0x33, 0xdc, 0x63, 0x33, 0xe9, 0x71, 0x20, 0x52, 0xa3, 0x65, 0x3c, 0xf7, 0x98, 0x1f,
+	0xa0, 0xaa, 0xf4, 0xbb, 0x9f, 0x00, 0xc5, 0x7d, 0x17, 0xed, 0xdb, 0x7a, 0x0a, 0x7b, 0x60, 0xa1,
+	0x6d, 0x78, 0x67, 0x2d, 0xa3, 0xdb, 0x25, 0x97, 0x06, 0x5f, 0xa0, 0xca, 0xcd, 0x4b, 0x49, 0x73,
+	0x64, 0x78, 0x67, 0xbb, 0x51, 0x1e, 0xda, 0x94, 0xae, 0x6b, 0x31, 0x7d, 0xdc, 0x73, 0xbe, 0x3d,
+	0x2e, 0xa3, 0xf0, 0x14, 0xcc, 0x71, 0x45, 0x8f, 0x38, 0x1e, 0xc3, 0x3e, 0xd5, 0x66, 0xb8, 0xdf,
+	0xc6, 0x64, 0xbf, 0x23, 0x91, 0x85, 0x1e, 0x4a, 0xb7, 0xd5, 0x5b, 0xda, 0xb8, 0x57, 0xbe, 0x3d,
+	0x92, 0xd0, 0xca, 0x27, 0x05, 0xa4, 0xc3, 0x02, 0x82, 0x0f, 0xc0, 0x4c, 0x58, 0x24, 0x2d, 0xc7,
+	0xe2, 0x55, 0x9a, 0x46, 0x60, 0xd0, 0x2f, 0xa9, 0x61, 0xa8, 0xb1, 0xdf, 0x54, 0xc3, 0x50, 0xc3,
+	0x82, 0x08, 0xe4, 0x44, 0x92, 0xd7, 0x21, 0xda, 0x54, 0x59, 0x99, 0x7c, 0xc8, 0x5c, 0xe4, 0x75,
+	0xc8, 0x78, 0x39, 0x67, 0x4d, 0x39, 0x08, 0x37, 0x00, 0xe0, 0x8c, 0x76, 0xc0, 0x70, 0x58, 0x85,
+	0x4a, 0x35, 0xdf, 0xe4, 0x54, 0x14, 0x0e, 0xc0, 0x15, 0xa0, 0xf6, 0x1c, 0xcf, 0xc3, 0x96, 0x96,
+	0x2e, 0x2b, 0xd5, 0x6c, 0x53, 0xbe, 0x55, 0x7e, 0x4c, 0x81, 0x6c, 0x74, 0x24, 0x70, 0x0f, 0x2c,
+	0x46, 0xe7, 0xd7, 0x32, 0x2c, 0xcb, 0xc7, 0x54, 0xf4, 0x56, 0x0e, 0x69, 0xdf, 0xbf, 0x3d, 0x2e,
+	0xc8, 0x76, 0xdc, 0x15, 0x91, 0x63, 0xe6, 0x3b, 0x9e, 0xdd, 0x5c, 0x88, 0x14, 0x72, 0x18, 0xbe,
+	0x06, 0x73, 0x43, 0xc8, 0xd8, 0x82, 0xf4, 0xbb, 0x3b, 0x22, 0xbe, 0xa8, 0xbc, 0x39, 0x16, 0x80,
+	0x0d, 0x30, 0x3f, 0xe4, 0xd1, 0xb0, 0xf1, 0x65, 0x8b, 0xad, 0x26, 0x81, 0xaf, 0x88, 0x85, 0xbb,
+	0xe3, 0xa4, 0xe1, 0x4c, 0xc4, 0x8d, 0xe1, 0x80, 0x7b, 0x43, 0x14, 0xdf, 0xac, 0x53, 0x87, 0x32,
+	0xe2, 0x07, 0xb2, 0xb1, 0xb6, 0xee, 0x9e, 0x62, 0xb8, 0xf7, 0x2f, 0x44, 0xb2, 0x28, 0xfc, 0x31,
+	0x93, 0x65, 0x33, 0x99, 0x54, 0x41, 0x20, 0x1b, 0x35, 0x25, 0x2c, 0x03, 0xd5, 0xb1, 0x5a, 0x67,
+	0x38, 0xe0, 0x9b, 0x99, 0x47, 0xb9, 0x41, 0xbf, 0x94, 0x69, 0xec, 0x1f, 0xe2, 0xa0, 0x99, 0x71,
+	0xac, 0x43, 0x1c, 0xc0, 0x02, 0xc8, 0x5c, 0x18, 0xdd, 0x73, 0xcc, 0xf7, 0x2a, 0xdd, 0x14, 0x2f,
+	0x95, 0x8f, 0x0a, 0x28, 0x4c, 0xea, 0xba, 0xff, 0x73, 0x4e, 0x08, 0xa8, 0x26, 0xf1, 0x3a, 0x8e,
+	0x2d, 0x0f, 0xa8, 0xf4, 0xaf, 0x96, 0xef, 0x38, 0xf6, 0xad, 0x5b, 0x54, 0x28, 0xd1, 0xb3, 0xab,
+	0x81, 0xae, 0x5c, 0x0f, 0x74, 0xe5, 0xd7, 0x40, 0x57, 0x3e, 0xdc, 0xe8, 0xa9, 0xeb, 0x1b, 0x3d,
+	0xf5, 0xf3, 0x46, 0x4f, 0x9d, 0x6c, 0xda, 0x0e, 0x3b, 0x3d, 0x6f, 0xd7, 0x4c, 0xe2, 0xd6, 0xf7,
+	0x08, 0x75, 0xdf, 0x44, 0x1f, 0x01, 0xab, 0xfe, 0x8e, 0xff, 0x8a, 0x2f, 0x41, 0x5b, 0xe5, 0x97,
+	0xfb, 0x93, 0xbf, 0x03, 0x00, 0x98, 0x9f, 0x36, 0x63, 0x72, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -356,6 +443,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BankPointers) > 0 {
+		for iNdEx := len(m.BankPointers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BankPointers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BankAllowances) > 0 {
+		for iNdEx := len(m.BankAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BankAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GaslessContracts) > 0 {
+		for iNdEx := len(m.GaslessContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaslessContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -569,6 +698,46 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaslessContractEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessContractEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessContractEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -606,6 +775,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GaslessContracts) > 0 {
+		for _, e := range m.GaslessContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BankAllowances) > 0 {
+		for _, e := range m.BankAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BankPointers) > 0 {
+		for _, e := range m.BankPointers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -673,6 +860,21 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *GaslessContractEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -843,6 +1045,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaslessContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaslessContracts = append(m.GaslessContracts, GaslessContractEntry{})
+			if err := m.GaslessContracts[len(m.GaslessContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankAllowances = append(m.BankAllowances, BankAllowance{})
+			if err := m.BankAllowances[len(m.BankAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankPointers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankPointers = append(m.BankPointers, BankPointer{})
+			if err := m.BankPointers[len(m.BankPointers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1306,6 +1610,121 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GaslessContractEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessContractEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessContractEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
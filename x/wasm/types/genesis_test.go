@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -49,6 +51,60 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"gasless contract address invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.GaslessContracts[0].ContractAddress = invalidAddress
+			},
+			expError: true,
+		},
+		"gasless contract config invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.GaslessContracts[0].Config = GaslessConfig{MaxGasPerBlock: 1, MaxGasPerTx: 2}
+			},
+			expError: true,
+		},
+		"gasless contract duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.GaslessContracts = append(s.GaslessContracts, s.GaslessContracts[0])
+			},
+			expError: true,
+		},
+		"bank allowance spender invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.BankAllowances[0].Spender = invalidAddress
+			},
+			expError: true,
+		},
+		"bank allowance denom invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.BankAllowances[0].Denom = "1"
+			},
+			expError: true,
+		},
+		"bank allowance amount not positive": {
+			srcMutator: func(s *GenesisState) {
+				s.BankAllowances[0].Amount = sdkmath.ZeroInt()
+			},
+			expError: true,
+		},
+		"bank pointer address invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.BankPointers[0].Address = BankPointerAddress("other").Hex()
+			},
+			expError: true,
+		},
+		"bank pointer duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.BankPointers = append(s.BankPointers, s.BankPointers[0])
+			},
+			expError: true,
+		},
+		"bank allowance duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.BankAllowances = append(s.BankAllowances, s.BankAllowances[0])
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/binary"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -37,6 +39,11 @@ var (
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
 	GaslessContractIndexPrefix                     = []byte{0x0a}
+	GaslessContractUsagePrefix                     = []byte{0x0b}
+	GaslessTxCounterPrefix                         = []byte{0x0c}
+	BankAllowancePrefix                            = []byte{0x0d}
+	BankPointerPrefix                              = []byte{0x0e}
+	PendingBankPointerPrefix                       = []byte{0x0f}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -156,7 +163,62 @@ func GetGaslessContractIndexPrefix(contractAddr sdk.AccAddress) []byte {
 	return r
 }
 
+// GetGaslessContractUsageKey returns the key for the gas consumed by a gasless contract
+func GetGaslessContractUsageKey(contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(GaslessContractUsagePrefix)
+	contractAddrLen := len(contractAddr)
+	r := make([]byte, prefixLen+contractAddrLen)
+	copy(r[0:], GaslessContractUsagePrefix)
+	copy(r[prefixLen:], contractAddr)
+	return r
+}
+
+// GetGaslessTxCounterKey returns the key for the gasless tx counter of an account
+func GetGaslessTxCounterKey(addr sdk.AccAddress) []byte {
+	prefixLen := len(GaslessTxCounterPrefix)
+	addrLen := len(addr)
+	r := make([]byte, prefixLen+addrLen)
+	copy(r[0:], GaslessTxCounterPrefix)
+	copy(r[prefixLen:], addr)
+	return r
+}
+
 // ParsePinnedCodeIndex converts the serialized code ID back.
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetBankAllowanceKey returns the key for the bank allowance of the spender on a denom of the owner
+func GetBankAllowanceKey(owner, spender sdk.AccAddress, denom string) []byte {
+	return append(GetBankAllowancesPrefix(owner, spender), []byte(denom)...)
+}
+
+// GetBankAllowancesPrefix returns the key prefix for the bank allowances granted by the owner.
+// When the spender is not empty, the prefix is restricted to the allowances of the spender.
+func GetBankAllowancesPrefix(owner, spender sdk.AccAddress) []byte {
+	r := append(BankAllowancePrefix, address.MustLengthPrefix(owner)...)
+	if len(spender) == 0 {
+		return r
+	}
+	return append(r, address.MustLengthPrefix(spender)...)
+}
+
+// ParseBankAllowanceKey returns the spender and denom of a bank allowance key without the owner prefix
+func ParseBankAllowanceKey(key []byte) (sdk.AccAddress, string, error) {
+	if len(key) == 0 || len(key) < int(key[0])+1 {
+		return nil, "", errorsmod.Wrap(ErrInvalid, "bank allowance key")
+	}
+	spenderLen := int(key[0])
+	return key[1 : spenderLen+1], string(key[spenderLen+1:]), nil
+}
+
+// GetBankPointerKey returns the key for the denom of the bank pointer contract address
+func GetBankPointerKey(addr []byte) []byte {
+	return append(BankPointerPrefix, addr...)
+}
+
+// GetPendingBankPointerKey returns the key for the denom of a bank pointer contract address that is not
+// registered as precompile yet
+func GetPendingBankPointerKey(addr []byte) []byte {
+	return append(PendingBankPointerPrefix, addr...)
+}
@@ -146,3 +146,19 @@ func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetGaslessKeys(t *testing.T) {
+	specs := map[string]func(addr []byte) []byte{
+		"usage":      func(addr []byte) []byte { return GetGaslessContractUsageKey(addr) },
+		"tx counter": func(addr []byte) []byte { return GetGaslessTxCounterKey(addr) },
+	}
+	for msg, keyFn := range specs {
+		t.Run(msg, func(t *testing.T) {
+			first := keyFn(bytes.Repeat([]byte{1}, 20))
+			second := keyFn(bytes.Repeat([]byte{2}, 20))
+			assert.Equal(t, byte(1), first[len(first)-1])
+			assert.Equal(t, byte(2), second[len(second)-1])
+			assert.NotEqual(t, first, second)
+		})
+	}
+}
@@ -87,6 +87,8 @@ func DefaultParams() Params {
 	return Params{
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
+		PrecompileGas:                DefaultPrecompileGasSchedule(),
+		GaslessTxLimits:              DefaultGaslessTxLimits(),
 	}
 }
 
@@ -106,6 +108,12 @@ func (p Params) ValidateBasic() error {
 	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := p.PrecompileGas.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "precompile gas")
+	}
+	if err := p.GaslessTxLimits.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "gasless tx limits")
+	}
 	return nil
 }
 
@@ -3,11 +3,14 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,9 +18,10 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	var (
-		anyAddress     sdk.AccAddress = make([]byte, ContractAddrLen)
-		otherAddress   sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
-		invalidAddress                = "invalid address"
+		anyAddress        sdk.AccAddress = make([]byte, ContractAddrLen)
+		otherAddress      sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
+		invalidAddress                   = "invalid address"
+		precompileAddress                = "0x9000000000000000000000000000000000000001"
 	)
 
 	specs := map[string]struct {
@@ -98,6 +102,82 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with precompile method costs": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				PrecompileGas: PrecompileGasSchedule{
+					Methods: []PrecompileMethodGasCost{
+						{Address: precompileAddress, Cost: PrecompileGasCost{BaseGas: 1, SDKGasMultiplier: sdkmath.LegacyOneDec()}},
+						{Address: precompileAddress, Method: "foo", Cost: PrecompileGasCost{PerByteGas: 1, SDKGasMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1)}},
+					},
+				},
+			},
+		},
+		"reject zero default sdk gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				PrecompileGas:                PrecompileGasSchedule{Default: &PrecompileGasCost{BaseGas: 1, SDKGasMultiplier: sdkmath.LegacyZeroDec()}},
+			},
+			expErr: true,
+		},
+		"reject unset method sdk gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				PrecompileGas: PrecompileGasSchedule{
+					Methods: []PrecompileMethodGasCost{{Address: precompileAddress, Cost: PrecompileGasCost{BaseGas: 1}}},
+				},
+			},
+			expErr: true,
+		},
+		"reject invalid precompile address": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				PrecompileGas: PrecompileGasSchedule{
+					Methods: []PrecompileMethodGasCost{{Address: invalidAddress, Cost: PrecompileGasCost{SDKGasMultiplier: sdkmath.LegacyOneDec()}}},
+				},
+			},
+			expErr: true,
+		},
+		"reject duplicate precompile method": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				PrecompileGas: PrecompileGasSchedule{
+					Methods: []PrecompileMethodGasCost{
+						{Address: precompileAddress, Method: "foo", Cost: PrecompileGasCost{SDKGasMultiplier: sdkmath.LegacyOneDec()}},
+						{Address: strings.ToLower(precompileAddress), Method: "foo", Cost: PrecompileGasCost{SDKGasMultiplier: sdkmath.LegacyOneDec()}},
+					},
+				},
+			},
+			expErr: true,
+		},
+		"all good with gasless txs disabled": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GaslessTxLimits:              GaslessTxLimits{},
+			},
+		},
+		"reject gasless tx limits without max txs per account": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GaslessTxLimits:              GaslessTxLimits{MaxGas: 1, WindowBlocks: 1},
+			},
+			expErr: true,
+		},
+		"reject gasless tx limits without window blocks": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GaslessTxLimits:              GaslessTxLimits{MaxGas: 1, MaxTxsPerAccount: 1},
+			},
+			expErr: true,
+		},
 		"reject duplicate address in any of addresses": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), anyAddress.String()}},
@@ -166,9 +246,19 @@ func TestParamsUnmarshalJson(t *testing.T) {
 	}{
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
-				"instantiate_default_permission": "Everybody"}`,
+				"instantiate_default_permission": "Everybody",
+				"precompile_gas": {"default": {"base_gas": "1000", "per_byte_gas": "3", "sdk_gas_multiplier": "1"}},
+				"gasless_tx_limits": {"max_gas": "1000000", "max_txs_per_account": 10, "window_blocks": "100"}}`,
 			exp: DefaultParams(),
 		},
+		"without precompile gas": {
+			src: `{"code_upload_access": {"permission": "Everybody"},
+				"instantiate_default_permission": "Everybody"}`,
+			exp: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
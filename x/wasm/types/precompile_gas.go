@@ -0,0 +1,89 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultPrecompileBaseGas is the default gas charged once per precompile call
+	DefaultPrecompileBaseGas uint64 = 1_000
+	// DefaultPrecompilePerByteGas is the default gas charged per byte of precompile input
+	DefaultPrecompilePerByteGas uint64 = 3
+)
+
+// DefaultPrecompileGasSchedule returns the default gas schedule, charging the SDK gas consumed
+// by the precompile calls 1:1 on top of the default base and per byte costs
+func DefaultPrecompileGasSchedule() PrecompileGasSchedule {
+	return PrecompileGasSchedule{
+		Default: &PrecompileGasCost{
+			BaseGas:          DefaultPrecompileBaseGas,
+			PerByteGas:       DefaultPrecompilePerByteGas,
+			SDKGasMultiplier: sdkmath.LegacyOneDec(),
+		},
+	}
+}
+
+// ValidateBasic syntax checks
+func (s PrecompileGasSchedule) ValidateBasic() error {
+	if s.Default != nil {
+		if err := s.Default.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "default")
+		}
+	}
+	index := make(map[common.Address]map[string]struct{}, len(s.Methods))
+	for _, m := range s.Methods {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+		addr := common.HexToAddress(m.Address)
+		if _, ok := index[addr]; !ok {
+			index[addr] = make(map[string]struct{})
+		}
+		if _, found := index[addr][m.Method]; found {
+			return errorsmod.Wrapf(ErrDuplicate, "method %q of precompile %s", m.Method, m.Address)
+		}
+		index[addr][m.Method] = struct{}{}
+	}
+	return nil
+}
+
+// GasCost returns the cost of the method of the precompile at the given address. Method costs take
+// precedence over the precompile cost, which takes precedence over the default cost. Nil is
+// returned when none is set.
+func (s PrecompileGasSchedule) GasCost(address common.Address, method string) *PrecompileGasCost {
+	var precompileCost *PrecompileGasCost
+	for i, m := range s.Methods {
+		if common.HexToAddress(m.Address) != address {
+			continue
+		}
+		switch m.Method {
+		case method:
+			return &s.Methods[i].Cost
+		case "":
+			precompileCost = &s.Methods[i].Cost
+		}
+	}
+	if precompileCost != nil {
+		return precompileCost
+	}
+	return s.Default
+}
+
+// ValidateBasic syntax checks
+func (m PrecompileMethodGasCost) ValidateBasic() error {
+	if !common.IsHexAddress(m.Address) {
+		return errorsmod.Wrapf(ErrInvalid, "precompile address: %q", m.Address)
+	}
+	return errorsmod.Wrapf(m.Cost.ValidateBasic(), "method %q of precompile %s", m.Method, m.Address)
+}
+
+// ValidateBasic syntax checks
+func (c PrecompileGasCost) ValidateBasic() error {
+	if c.SDKGasMultiplier.IsNil() || !c.SDKGasMultiplier.IsPositive() {
+		return errorsmod.Wrap(ErrInvalid, "sdk gas multiplier must be positive")
+	}
+	return nil
+}
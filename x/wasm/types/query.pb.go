@@ -930,6 +930,8 @@ type QueryGaslessContractsResponse struct {
 	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// GaslessContracts contains the gas budget of each contract
+	GaslessContracts []GaslessContract `protobuf:"bytes,3,rep,name=gasless_contracts,json=gaslessContracts,proto3" json:"gasless_contracts"`
 }
 
 func (m *QueryGaslessContractsResponse) Reset()         { *m = QueryGaslessContractsResponse{} }
@@ -965,6 +967,50 @@ func (m *QueryGaslessContractsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGaslessContractsResponse proto.InternalMessageInfo
 
+// GaslessContract is the gas budget of a gasless contract
+type GaslessContract struct {
+	// ContractAddress is the address of the gasless contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Config is the gas budget of the contract
+	Config GaslessConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// RemainingBlockGas is the gas left for gasless executions in the current
+	// block. It is only set when the config limits the gas per block.
+	RemainingBlockGas uint64 `protobuf:"varint,3,opt,name=remaining_block_gas,json=remainingBlockGas,proto3" json:"remaining_block_gas,omitempty"`
+}
+
+func (m *GaslessContract) Reset()         { *m = GaslessContract{} }
+func (m *GaslessContract) String() string { return proto.CompactTextString(m) }
+func (*GaslessContract) ProtoMessage()    {}
+func (*GaslessContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{23}
+}
+func (m *GaslessContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessContract.Merge(m, src)
+}
+func (m *GaslessContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessContract proto.InternalMessageInfo
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -973,7 +1019,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{23}
+	return fileDescriptor_9677c207036b9f2b, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1058,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{24}
+	return fileDescriptor_9677c207036b9f2b, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1100,7 @@ func (m *QueryContractsByCreatorRequest) Reset()         { *m = QueryContractsBy
 func (m *QueryContractsByCreatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByCreatorRequest) ProtoMessage()    {}
 func (*QueryContractsByCreatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{25}
+	return fileDescriptor_9677c207036b9f2b, []int{26}
 }
 func (m *QueryContractsByCreatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1142,7 @@ func (m *QueryContractsByCreatorResponse) Reset()         { *m = QueryContractsB
 func (m *QueryContractsByCreatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByCreatorResponse) ProtoMessage()    {}
 func (*QueryContractsByCreatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{26}
+	return fileDescriptor_9677c207036b9f2b, []int{27}
 }
 func (m *QueryContractsByCreatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1189,7 @@ func (m *QueryBuildAddressRequest) Reset()         { *m = QueryBuildAddressReque
 func (m *QueryBuildAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuildAddressRequest) ProtoMessage()    {}
 func (*QueryBuildAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{27}
+	return fileDescriptor_9677c207036b9f2b, []int{28}
 }
 func (m *QueryBuildAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1229,7 @@ func (m *QueryBuildAddressResponse) Reset()         { *m = QueryBuildAddressResp
 func (m *QueryBuildAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuildAddressResponse) ProtoMessage()    {}
 func (*QueryBuildAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{28}
+	return fileDescriptor_9677c207036b9f2b, []int{29}
 }
 func (m *QueryBuildAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,6 +1258,175 @@ func (m *QueryBuildAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBuildAddressResponse proto.InternalMessageInfo
 
+// QueryBankAllowancesRequest is the request type for the Query/BankAllowances
+// RPC method.
+type QueryBankAllowancesRequest struct {
+	// Owner is the address that granted the allowances
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Spender optionally restricts the result to the allowances of this address
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBankAllowancesRequest) Reset()         { *m = QueryBankAllowancesRequest{} }
+func (m *QueryBankAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBankAllowancesRequest) ProtoMessage()    {}
+func (*QueryBankAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{30}
+}
+func (m *QueryBankAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankAllowancesRequest.Merge(m, src)
+}
+func (m *QueryBankAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankAllowancesRequest proto.InternalMessageInfo
+
+// QueryBankAllowancesResponse is the response type for the
+// Query/BankAllowances RPC method.
+type QueryBankAllowancesResponse struct {
+	// Allowances result set
+	Allowances []BankAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBankAllowancesResponse) Reset()         { *m = QueryBankAllowancesResponse{} }
+func (m *QueryBankAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBankAllowancesResponse) ProtoMessage()    {}
+func (*QueryBankAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{31}
+}
+func (m *QueryBankAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankAllowancesResponse.Merge(m, src)
+}
+func (m *QueryBankAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankAllowancesResponse proto.InternalMessageInfo
+
+// QueryBankPointerRequest is the request type for the Query/BankPointer RPC
+// method.
+type QueryBankPointerRequest struct {
+	// Denom is the bank denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryBankPointerRequest) Reset()         { *m = QueryBankPointerRequest{} }
+func (m *QueryBankPointerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBankPointerRequest) ProtoMessage()    {}
+func (*QueryBankPointerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{32}
+}
+func (m *QueryBankPointerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankPointerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankPointerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankPointerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankPointerRequest.Merge(m, src)
+}
+func (m *QueryBankPointerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankPointerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankPointerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankPointerRequest proto.InternalMessageInfo
+
+// QueryBankPointerResponse is the response type for the Query/BankPointer RPC
+// method.
+type QueryBankPointerResponse struct {
+	// Address is the hex encoded address of the pointer contract. It is
+	// derived from the denom and returned even when not registered.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Registered is true when the pointer contract is registered
+	Registered bool `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *QueryBankPointerResponse) Reset()         { *m = QueryBankPointerResponse{} }
+func (m *QueryBankPointerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBankPointerResponse) ProtoMessage()    {}
+func (*QueryBankPointerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{33}
+}
+func (m *QueryBankPointerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankPointerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankPointerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankPointerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankPointerResponse.Merge(m, src)
+}
+func (m *QueryBankPointerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankPointerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankPointerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankPointerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryContractInfoRequest)(nil), "cosmwasm.wasm.v1.QueryContractInfoRequest")
 	proto.RegisterType((*QueryContractInfoResponse)(nil), "cosmwasm.wasm.v1.QueryContractInfoResponse")
@@ -1236,122 +1451,144 @@ func init() {
 	proto.RegisterType((*QueryPinnedCodesResponse)(nil), "cosmwasm.wasm.v1.QueryPinnedCodesResponse")
 	proto.RegisterType((*QueryGaslessContractsRequest)(nil), "cosmwasm.wasm.v1.QueryGaslessContractsRequest")
 	proto.RegisterType((*QueryGaslessContractsResponse)(nil), "cosmwasm.wasm.v1.QueryGaslessContractsResponse")
+	proto.RegisterType((*GaslessContract)(nil), "cosmwasm.wasm.v1.GaslessContract")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmwasm.wasm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmwasm.wasm.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryContractsByCreatorRequest)(nil), "cosmwasm.wasm.v1.QueryContractsByCreatorRequest")
 	proto.RegisterType((*QueryContractsByCreatorResponse)(nil), "cosmwasm.wasm.v1.QueryContractsByCreatorResponse")
 	proto.RegisterType((*QueryBuildAddressRequest)(nil), "cosmwasm.wasm.v1.QueryBuildAddressRequest")
 	proto.RegisterType((*QueryBuildAddressResponse)(nil), "cosmwasm.wasm.v1.QueryBuildAddressResponse")
+	proto.RegisterType((*QueryBankAllowancesRequest)(nil), "cosmwasm.wasm.v1.QueryBankAllowancesRequest")
+	proto.RegisterType((*QueryBankAllowancesResponse)(nil), "cosmwasm.wasm.v1.QueryBankAllowancesResponse")
+	proto.RegisterType((*QueryBankPointerRequest)(nil), "cosmwasm.wasm.v1.QueryBankPointerRequest")
+	proto.RegisterType((*QueryBankPointerResponse)(nil), "cosmwasm.wasm.v1.QueryBankPointerResponse")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/query.proto", fileDescriptor_9677c207036b9f2b) }
 
 var fileDescriptor_9677c207036b9f2b = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xa4, 0x8e, 0xe3, 0x4c, 0xf2, 0xfd, 0xd6, 0x99, 0x6f, 0xda, 0xba, 0x6e, 0x6b, 0xe7,
-	0xbb, 0x6d, 0x93, 0xd4, 0x6d, 0xbc, 0x4d, 0x4a, 0xa9, 0x5a, 0x0e, 0xc8, 0x4e, 0x4b, 0xd3, 0x8a,
-	0xd2, 0x74, 0x2b, 0x81, 0x04, 0x42, 0x66, 0xbc, 0x9e, 0x38, 0x0b, 0xf6, 0xae, 0xbb, 0xb3, 0x69,
-	0x1a, 0x45, 0xe9, 0xa1, 0x27, 0x24, 0x0e, 0x80, 0x38, 0x51, 0x10, 0x70, 0xe0, 0x50, 0x28, 0xa0,
-	0x4a, 0x20, 0x51, 0x21, 0x71, 0xcf, 0xb1, 0x82, 0x0b, 0x27, 0x0b, 0x5c, 0xa4, 0xa2, 0xfe, 0x09,
-	0x3d, 0xa1, 0x9d, 0x9d, 0xf1, 0xae, 0x7f, 0x8c, 0xed, 0xa6, 0x46, 0xe2, 0x62, 0xad, 0x77, 0xde,
-	0x8f, 0xcf, 0x7c, 0xe6, 0xbd, 0x79, 0xef, 0xd9, 0x70, 0xbf, 0x6e, 0xd1, 0xf2, 0x1a, 0xa6, 0x65,
-	0x95, 0x7d, 0x5c, 0x9f, 0x53, 0xaf, 0xad, 0x12, 0x7b, 0x3d, 0x5d, 0xb1, 0x2d, 0xc7, 0x42, 0x51,
-	0xb1, 0x9a, 0x66, 0x1f, 0xd7, 0xe7, 0xe2, 0x13, 0x45, 0xab, 0x68, 0xb1, 0x45, 0xd5, 0x7d, 0xf2,
-	0xe4, 0xe2, 0xad, 0x56, 0x9c, 0xf5, 0x0a, 0xa1, 0x62, 0xb5, 0x68, 0x59, 0xc5, 0x12, 0x51, 0x71,
-	0xc5, 0x50, 0xb1, 0x69, 0x5a, 0x0e, 0x76, 0x0c, 0xcb, 0x14, 0xab, 0x29, 0x57, 0xd7, 0xa2, 0x6a,
-	0x1e, 0x53, 0xe2, 0x39, 0x57, 0xaf, 0xcf, 0xe5, 0x89, 0x83, 0xe7, 0xd4, 0x0a, 0x2e, 0x1a, 0x26,
-	0x13, 0xe6, 0xb2, 0xfb, 0xb8, 0xac, 0x10, 0x0b, 0x82, 0x8d, 0x8f, 0xe3, 0xb2, 0x61, 0x5a, 0x2a,
-	0xfb, 0xe4, 0xaf, 0xf6, 0x7a, 0xf2, 0x39, 0x0f, 0xb0, 0xf7, 0xc5, 0x5b, 0x52, 0x5e, 0x81, 0xb1,
-	0x2b, 0xae, 0xf2, 0x82, 0x65, 0x3a, 0x36, 0xd6, 0x9d, 0x0b, 0xe6, 0xb2, 0xa5, 0x91, 0x6b, 0xab,
-	0x84, 0x3a, 0x68, 0x1e, 0x0e, 0xe3, 0x42, 0xc1, 0x26, 0x94, 0xc6, 0xc0, 0x24, 0x98, 0x19, 0xc9,
-	0xc6, 0x7e, 0xf9, 0x61, 0x76, 0x82, 0xab, 0x67, 0xbc, 0x95, 0xab, 0x8e, 0x6d, 0x98, 0x45, 0x4d,
-	0x08, 0x2a, 0xdf, 0x02, 0xb8, 0xb7, 0x8d, 0x41, 0x5a, 0xb1, 0x4c, 0x4a, 0xb6, 0x63, 0x11, 0xbd,
-	0x0a, 0xff, 0xa3, 0x73, 0x5b, 0x39, 0xc3, 0x5c, 0xb6, 0x62, 0x83, 0x93, 0x60, 0x66, 0x74, 0x3e,
-	0x91, 0x6e, 0x3e, 0x94, 0x74, 0xd0, 0x65, 0x76, 0x7c, 0xab, 0x9a, 0x1c, 0x78, 0x50, 0x4d, 0x82,
-	0xc7, 0xd5, 0xe4, 0xc0, 0x9d, 0x47, 0xf7, 0x52, 0x40, 0x1b, 0xd3, 0x03, 0x02, 0x67, 0x42, 0x7f,
-	0x7d, 0x91, 0x04, 0xca, 0xc7, 0x00, 0xee, 0x6b, 0xc0, 0xbb, 0x68, 0x50, 0xc7, 0xb2, 0xd7, 0x9f,
-	0x81, 0x03, 0xf4, 0x12, 0x84, 0xfe, 0x91, 0x71, 0xb8, 0x53, 0x69, 0xae, 0xe3, 0x9e, 0x6f, 0xda,
-	0x3b, 0x2f, 0x7e, 0xbe, 0xe9, 0x25, 0x5c, 0x24, 0xdc, 0x9f, 0x16, 0xd0, 0x54, 0xee, 0x03, 0xb8,
-	0xbf, 0x3d, 0x36, 0x4e, 0xe7, 0x65, 0x38, 0x4c, 0x4c, 0xc7, 0x36, 0x88, 0x0b, 0x6e, 0xc7, 0xcc,
-	0xe8, 0x7c, 0x4a, 0x4e, 0xca, 0x82, 0x55, 0x20, 0x5c, 0xff, 0x9c, 0xe9, 0xd8, 0xeb, 0xd9, 0x91,
-	0xad, 0x3a, 0x31, 0xc2, 0x0a, 0x3a, 0xdf, 0x06, 0xf9, 0x74, 0x57, 0xe4, 0x1e, 0x9a, 0x06, 0xe8,
-	0x37, 0x9b, 0x58, 0xa5, 0xd9, 0x75, 0x17, 0x80, 0x60, 0x75, 0x0f, 0x1c, 0xd6, 0xad, 0x02, 0xc9,
-	0x19, 0x05, 0xc6, 0x6a, 0x48, 0x0b, 0xbb, 0x5f, 0x2f, 0x14, 0xfa, 0x46, 0xdd, 0xe7, 0xcd, 0xd4,
-	0xd5, 0x01, 0x70, 0xea, 0x9e, 0x87, 0x23, 0x22, 0x1a, 0x3c, 0xf2, 0x3a, 0x9d, 0xac, 0x2f, 0xda,
-	0x3f, 0x86, 0x6e, 0x0b, 0x84, 0x99, 0x52, 0x49, 0x80, 0xbc, 0xea, 0x60, 0x87, 0xfc, 0x1b, 0x22,
-	0xef, 0x4b, 0x00, 0x0f, 0x48, 0xc0, 0x71, 0xfe, 0xce, 0xc0, 0x70, 0xd9, 0x2a, 0x90, 0x92, 0x88,
-	0xbc, 0x3d, 0xad, 0x91, 0x77, 0xc9, 0x5d, 0x0f, 0x86, 0x19, 0xd7, 0xe8, 0x1f, 0x87, 0xd7, 0x38,
-	0x85, 0x1a, 0x5e, 0xeb, 0x1b, 0x85, 0x07, 0x20, 0x64, 0xde, 0x73, 0x05, 0xec, 0x60, 0x06, 0x6e,
-	0x4c, 0x1b, 0x61, 0x6f, 0xce, 0x62, 0x07, 0x2b, 0x27, 0x38, 0x31, 0xad, 0x2e, 0x39, 0x31, 0x08,
-	0x86, 0x98, 0x26, 0x60, 0x9a, 0xec, 0x59, 0xf9, 0x04, 0xc0, 0x04, 0xd3, 0xba, 0x5a, 0xc6, 0xb6,
-	0xd3, 0x37, 0xa8, 0xe7, 0x5a, 0xa1, 0x66, 0xa7, 0x9e, 0x54, 0x93, 0x28, 0x00, 0xee, 0x12, 0xa1,
-	0x14, 0x17, 0xc9, 0xed, 0x47, 0xf7, 0x52, 0xa3, 0x86, 0x59, 0x32, 0x4c, 0x92, 0x7b, 0x9b, 0x5a,
-	0x66, 0x70, 0x4b, 0x6f, 0xc2, 0xa4, 0x14, 0x5c, 0xfd, 0xb4, 0x03, 0x9b, 0xea, 0xd9, 0x87, 0xb7,
-	0xf9, 0xa3, 0x30, 0xca, 0x33, 0xb1, 0x7b, 0xfe, 0x2b, 0x2a, 0x9c, 0xa8, 0x0b, 0x07, 0x4b, 0x91,
-	0x54, 0xe1, 0xeb, 0x41, 0xb8, 0xab, 0x49, 0x83, 0x63, 0x3e, 0xd8, 0xa4, 0x92, 0x85, 0xb5, 0x6a,
-	0x32, 0xcc, 0xc4, 0xce, 0xd6, 0xef, 0x9b, 0x79, 0x38, 0xac, 0xdb, 0x04, 0x3b, 0x96, 0xcd, 0xf8,
-	0xeb, 0x48, 0x3b, 0x17, 0x44, 0x4b, 0x30, 0xa2, 0xaf, 0x10, 0xfd, 0x1d, 0xba, 0x5a, 0x8e, 0xed,
-	0x60, 0x84, 0x3c, 0xf7, 0xa4, 0x9a, 0x3c, 0x5e, 0x34, 0x9c, 0x95, 0xd5, 0x7c, 0x5a, 0xb7, 0xca,
-	0xaa, 0x6e, 0x95, 0x89, 0x93, 0x5f, 0x76, 0xfc, 0x87, 0x92, 0x91, 0xa7, 0x6a, 0x7e, 0xdd, 0x21,
-	0x34, 0xbd, 0x48, 0x6e, 0x64, 0xdd, 0x07, 0xad, 0x6e, 0x05, 0xbd, 0x05, 0x77, 0x1b, 0x26, 0x75,
-	0xb0, 0xe9, 0x18, 0xd8, 0x21, 0xb9, 0x0a, 0xb1, 0xcb, 0x06, 0xa5, 0x6e, 0x72, 0x84, 0x64, 0xb5,
-	0x2e, 0xa3, 0xeb, 0x84, 0xd2, 0x05, 0xcb, 0x5c, 0x36, 0x8a, 0xc1, 0x1c, 0xdb, 0x15, 0x30, 0xb4,
-	0x54, 0xb7, 0xc3, 0x8b, 0xdd, 0xfd, 0x41, 0x18, 0x6d, 0xe1, 0xe9, 0x48, 0x33, 0x4f, 0x51, 0x9f,
-	0xa7, 0xc7, 0xd5, 0xe4, 0xa0, 0x51, 0x78, 0x26, 0xb6, 0xae, 0xc0, 0x11, 0x37, 0x0c, 0x72, 0x2b,
-	0x98, 0xae, 0x3c, 0x1b, 0x5d, 0xae, 0x99, 0x45, 0x4c, 0x57, 0x3a, 0xd0, 0x15, 0xee, 0x27, 0x5d,
-	0x17, 0x43, 0x91, 0x50, 0x74, 0xe8, 0x62, 0x28, 0x32, 0x14, 0x0d, 0x2b, 0xb7, 0x00, 0x1c, 0x0f,
-	0x84, 0x31, 0xe7, 0xee, 0x82, 0x5b, 0x45, 0x5c, 0xee, 0xdc, 0xbe, 0x04, 0x30, 0xe7, 0x4a, 0xbb,
-	0x12, 0xdc, 0x48, 0x79, 0x36, 0x22, 0xfa, 0x12, 0x2d, 0xa2, 0xf3, 0x35, 0xb4, 0x9f, 0xa7, 0x98,
-	0x97, 0xc6, 0x91, 0xc7, 0xd5, 0x24, 0xfb, 0xee, 0x25, 0x11, 0x3f, 0xbf, 0x37, 0x02, 0x18, 0xa8,
-	0x48, 0x8d, 0xc6, 0x3b, 0x1f, 0x6c, 0xfb, 0xce, 0xbf, 0x0b, 0x20, 0x0a, 0x5a, 0xe7, 0x5b, 0x7c,
-	0x19, 0xc2, 0xfa, 0x16, 0xc5, 0x65, 0xdf, 0xcb, 0x1e, 0x03, 0x24, 0x8f, 0x88, 0x4d, 0xf6, 0xf1,
-	0xea, 0xc7, 0x70, 0x0f, 0x03, 0xbb, 0x64, 0x98, 0x26, 0x29, 0x74, 0x20, 0x64, 0xfb, 0x45, 0xf0,
-	0x3d, 0xc0, 0x7b, 0xe3, 0x06, 0x1f, 0x9c, 0x96, 0x29, 0x18, 0xe1, 0x59, 0xe3, 0x91, 0x12, 0xca,
-	0x8e, 0xd6, 0xaa, 0xc9, 0x61, 0x2f, 0x6d, 0xa8, 0x36, 0xec, 0x65, 0x4c, 0x1f, 0x37, 0xbc, 0xcc,
-	0x6b, 0xdd, 0x79, 0x4c, 0x4b, 0x5e, 0x28, 0x7b, 0x1d, 0x49, 0xbf, 0x77, 0xfd, 0x9d, 0x28, 0xfd,
-	0xad, 0x8e, 0xf8, 0xd6, 0xcf, 0x42, 0x54, 0x6f, 0xc8, 0x79, 0x29, 0x22, 0xa2, 0x87, 0xda, 0x55,
-	0xab, 0x26, 0xc7, 0x85, 0x4a, 0x46, 0x2c, 0x6a, 0xe3, 0x7a, 0xf3, 0xab, 0xfe, 0x11, 0x33, 0xc1,
-	0xc3, 0x76, 0x09, 0xdb, 0xb8, 0x2c, 0xe8, 0x50, 0x34, 0xf8, 0xbf, 0x86, 0xb7, 0x1c, 0xfb, 0x0b,
-	0x30, 0x5c, 0x61, 0x6f, 0x78, 0xa2, 0xc4, 0x5a, 0x23, 0xd9, 0xd3, 0x68, 0xe8, 0x5b, 0x3c, 0x15,
-	0x37, 0x43, 0x12, 0x2d, 0x4d, 0xa5, 0x77, 0xcd, 0x89, 0x53, 0xc8, 0xc0, 0x9d, 0xfc, 0xe2, 0xcb,
-	0xf5, 0x5a, 0xce, 0xff, 0xcb, 0x15, 0x32, 0x7d, 0xee, 0xe1, 0xbe, 0x07, 0xbc, 0xae, 0xb7, 0x43,
-	0xcb, 0xe9, 0x38, 0xdf, 0xe1, 0x28, 0xe5, 0x88, 0xff, 0xc9, 0xd3, 0xbc, 0x2b, 0x92, 0x2e, 0xbb,
-	0x6a, 0x94, 0x0a, 0xdc, 0x81, 0x60, 0x77, 0x1f, 0xbf, 0x6e, 0x59, 0x2d, 0x61, 0xbc, 0x7a, 0x17,
-	0x28, 0xab, 0x0a, 0x6d, 0xa8, 0x1f, 0x7c, 0x4a, 0xea, 0x11, 0x0c, 0x51, 0x5c, 0x72, 0x58, 0x99,
-	0x1a, 0xd1, 0xd8, 0xb3, 0xeb, 0xd3, 0x30, 0x0d, 0x27, 0x87, 0xed, 0x22, 0x65, 0xe5, 0x78, 0x4c,
-	0x8b, 0xb8, 0x2f, 0x32, 0x76, 0x91, 0x2a, 0x97, 0xf9, 0xb0, 0xdb, 0x08, 0x76, 0xfb, 0xc3, 0xee,
-	0xfc, 0xd6, 0x38, 0x1c, 0x62, 0x16, 0xd1, 0x6d, 0x00, 0xc7, 0x82, 0x03, 0x2d, 0x6a, 0x33, 0xdb,
-	0xc9, 0x26, 0xf7, 0xf8, 0xd1, 0x9e, 0x64, 0x3d, 0x9c, 0xca, 0xdc, 0xbb, 0x6e, 0x94, 0xdf, 0xfa,
-	0xf5, 0xcf, 0x8f, 0x06, 0xa7, 0xd0, 0x21, 0xb5, 0xe5, 0x37, 0x0c, 0x71, 0xda, 0xea, 0x06, 0x47,
-	0xb9, 0x89, 0xee, 0x02, 0xb8, 0xb3, 0x69, 0x28, 0x45, 0xb3, 0x5d, 0x7c, 0x36, 0x0e, 0xd6, 0xf1,
-	0x74, 0xaf, 0xe2, 0x1c, 0xe5, 0x69, 0x1f, 0x65, 0x1a, 0x1d, 0xeb, 0x05, 0xa5, 0xba, 0xc2, 0x91,
-	0x7d, 0x15, 0x40, 0xcb, 0xe7, 0xc0, 0xae, 0x68, 0x1b, 0x07, 0xd6, 0xae, 0x68, 0x9b, 0xc6, 0x4b,
-	0xe5, 0x94, 0x8f, 0xf6, 0x18, 0x4a, 0xb5, 0x43, 0x5b, 0x20, 0xea, 0x06, 0xaf, 0x20, 0x9b, 0xaa,
-	0x3f, 0x5f, 0x7e, 0x03, 0x60, 0xb4, 0x79, 0xe8, 0x42, 0x32, 0xef, 0x92, 0xd1, 0x31, 0xae, 0xf6,
-	0x2c, 0xdf, 0x33, 0xdc, 0x16, 0x72, 0x29, 0x43, 0xf6, 0x23, 0x80, 0xd1, 0xe6, 0x51, 0x48, 0x0a,
-	0x57, 0x32, 0xa6, 0x49, 0xe1, 0xca, 0x66, 0x2c, 0x25, 0xeb, 0xc3, 0x3d, 0x85, 0x4e, 0xf6, 0x04,
-	0xd7, 0xc6, 0x6b, 0xea, 0x86, 0x3f, 0x2d, 0x6d, 0xa2, 0x9f, 0x00, 0x44, 0xad, 0x13, 0x0f, 0x3a,
-	0x2e, 0xc1, 0x22, 0x9d, 0xdc, 0xe2, 0x73, 0x4f, 0xa1, 0xc1, 0xf1, 0xbf, 0xc8, 0xa0, 0x9f, 0x46,
-	0xa7, 0x7a, 0x63, 0xda, 0x35, 0xd4, 0x08, 0xfe, 0x26, 0x0c, 0xb1, 0x28, 0x56, 0xa4, 0x61, 0xe9,
-	0x87, 0xee, 0xc1, 0x8e, 0x32, 0x1c, 0xd1, 0xac, 0xcf, 0xa8, 0x82, 0x26, 0xbb, 0xc5, 0x2b, 0x5a,
-	0x83, 0x43, 0xac, 0x1d, 0x42, 0x9d, 0x8c, 0x8b, 0x6b, 0x3b, 0x7e, 0xa8, 0xb3, 0x10, 0x87, 0x70,
-	0xd0, 0x87, 0x10, 0x43, 0xbb, 0xdb, 0x43, 0x40, 0xef, 0x03, 0x18, 0x11, 0xad, 0x26, 0x9a, 0xea,
-	0x60, 0x37, 0x78, 0x1b, 0x4e, 0x77, 0x95, 0xe3, 0x10, 0xe6, 0x7d, 0x08, 0xd3, 0xe8, 0x70, 0x7b,
-	0x08, 0xb3, 0x6e, 0x23, 0x1c, 0xa0, 0xe2, 0x43, 0x00, 0x47, 0x03, 0x0d, 0x22, 0x3a, 0x22, 0x71,
-	0xd6, 0xda, 0xa8, 0xc6, 0x53, 0xbd, 0x88, 0x72, 0x68, 0x47, 0x7d, 0x68, 0x93, 0x28, 0xd1, 0x1e,
-	0x1a, 0x55, 0x2b, 0x4c, 0x13, 0x7d, 0x0a, 0x60, 0xb4, 0xb9, 0x7d, 0x93, 0x66, 0xa5, 0xa4, 0xa1,
-	0x94, 0x66, 0xa5, 0xac, 0x2f, 0x54, 0xa6, 0x19, 0xba, 0xff, 0xa3, 0xa4, 0x0c, 0x5d, 0xd1, 0xd3,
-	0x44, 0xb7, 0x00, 0x0c, 0x7b, 0x5d, 0x16, 0x92, 0x85, 0x46, 0x43, 0x33, 0x17, 0x3f, 0xdc, 0x45,
-	0xea, 0xe9, 0x38, 0xf2, 0x3c, 0xff, 0x0c, 0x20, 0x6a, 0xed, 0x8c, 0xa4, 0xf9, 0x2f, 0x6d, 0xf9,
-	0xa4, 0xf9, 0x2f, 0x6f, 0xbb, 0x7a, 0xbe, 0xbf, 0xa8, 0xca, 0x1b, 0x14, 0x75, 0xa3, 0xa9, 0xb5,
-	0xd9, 0x44, 0x9f, 0x01, 0x38, 0x16, 0x6c, 0x3b, 0xa4, 0xfd, 0x41, 0x9b, 0x46, 0x4a, 0xda, 0x1f,
-	0xb4, 0xeb, 0x63, 0x94, 0x93, 0x3e, 0xda, 0x14, 0x9a, 0xe9, 0x70, 0x65, 0xe5, 0x5d, 0x6d, 0x81,
-	0x30, 0xbb, 0xb8, 0xf5, 0x47, 0x62, 0xe0, 0x4e, 0x2d, 0x31, 0xb0, 0x55, 0x4b, 0x80, 0x07, 0xb5,
-	0x04, 0xf8, 0xbd, 0x96, 0x00, 0x1f, 0x3c, 0x4c, 0x0c, 0x3c, 0x78, 0x98, 0x18, 0xf8, 0xed, 0x61,
-	0x62, 0xe0, 0xf5, 0xa9, 0xc0, 0x6f, 0x00, 0x0b, 0x16, 0x2d, 0xbf, 0x26, 0xac, 0x16, 0xd4, 0x1b,
-	0x9e, 0x75, 0xf6, 0xf7, 0x49, 0x3e, 0xcc, 0xfe, 0xaa, 0x38, 0xf1, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xbe, 0x26, 0x73, 0xa0, 0xa5, 0x19, 0x00, 0x00,
+	// 1932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1c, 0x49,
+	0x15, 0xc7, 0x5d, 0xce, 0x78, 0x3c, 0x7e, 0x36, 0x9b, 0x71, 0xad, 0xb3, 0x99, 0x74, 0x92, 0x99,
+	0x6c, 0x67, 0xd7, 0x49, 0xec, 0x78, 0x7a, 0xed, 0x65, 0x37, 0xda, 0xe5, 0x80, 0x66, 0x9c, 0x25,
+	0x3f, 0xc4, 0xb2, 0xde, 0x09, 0x02, 0x01, 0x42, 0x43, 0x4d, 0x77, 0xb9, 0xdd, 0x64, 0xa6, 0x7b,
+	0xd2, 0xd5, 0x8e, 0xd7, 0xb2, 0xb2, 0x87, 0x88, 0x03, 0x88, 0x03, 0x20, 0x4e, 0x04, 0x04, 0x1c,
+	0x40, 0x5a, 0x30, 0x48, 0x2b, 0x40, 0x62, 0x85, 0xc4, 0xdd, 0xe2, 0x14, 0xc1, 0x85, 0xd3, 0x08,
+	0x1c, 0xa4, 0x45, 0xf9, 0x13, 0xf6, 0x84, 0xba, 0xaa, 0x7a, 0xba, 0xa7, 0x67, 0x7a, 0xa6, 0xed,
+	0x0c, 0xd2, 0x5e, 0xac, 0x9e, 0xae, 0xf7, 0xaa, 0x3e, 0xf5, 0xad, 0x57, 0x55, 0xaf, 0x9f, 0xe1,
+	0x9c, 0xee, 0xb0, 0xd6, 0x0e, 0x61, 0x2d, 0x8d, 0xff, 0xb9, 0xbf, 0xaa, 0xdd, 0xdb, 0xa6, 0xee,
+	0x6e, 0xb9, 0xed, 0x3a, 0x9e, 0x83, 0xf3, 0x41, 0x6b, 0x99, 0xff, 0xb9, 0xbf, 0xaa, 0x2c, 0x98,
+	0x8e, 0xe9, 0xf0, 0x46, 0xcd, 0x7f, 0x12, 0x76, 0x4a, 0x7f, 0x2f, 0xde, 0x6e, 0x9b, 0xb2, 0xa0,
+	0xd5, 0x74, 0x1c, 0xb3, 0x49, 0x35, 0xd2, 0xb6, 0x34, 0x62, 0xdb, 0x8e, 0x47, 0x3c, 0xcb, 0xb1,
+	0x83, 0xd6, 0x25, 0xdf, 0xd7, 0x61, 0x5a, 0x83, 0x30, 0x2a, 0x06, 0xd7, 0xee, 0xaf, 0x36, 0xa8,
+	0x47, 0x56, 0xb5, 0x36, 0x31, 0x2d, 0x9b, 0x1b, 0x4b, 0xdb, 0xb3, 0xd2, 0x36, 0x30, 0x8b, 0xc2,
+	0x2a, 0xf3, 0xa4, 0x65, 0xd9, 0x8e, 0xc6, 0xff, 0xca, 0x57, 0x67, 0x84, 0x7d, 0x5d, 0x00, 0x8b,
+	0x1f, 0xa2, 0x49, 0xfd, 0x12, 0x14, 0xde, 0xf5, 0x9d, 0xd7, 0x1d, 0xdb, 0x73, 0x89, 0xee, 0xdd,
+	0xb2, 0x37, 0x9d, 0x1a, 0xbd, 0xb7, 0x4d, 0x99, 0x87, 0xd7, 0x60, 0x9a, 0x18, 0x86, 0x4b, 0x19,
+	0x2b, 0xa0, 0x0b, 0xe8, 0xf2, 0x4c, 0xb5, 0xf0, 0xf7, 0x3f, 0xad, 0x2c, 0x48, 0xf7, 0x8a, 0x68,
+	0xb9, 0xe3, 0xb9, 0x96, 0x6d, 0xd6, 0x02, 0x43, 0xf5, 0xf7, 0x08, 0xce, 0x0c, 0xe8, 0x90, 0xb5,
+	0x1d, 0x9b, 0xd1, 0xe3, 0xf4, 0x88, 0xbf, 0x02, 0x9f, 0xd1, 0x65, 0x5f, 0x75, 0xcb, 0xde, 0x74,
+	0x0a, 0x93, 0x17, 0xd0, 0xe5, 0xd9, 0xb5, 0x62, 0x39, 0xbe, 0x28, 0xe5, 0xe8, 0x90, 0xd5, 0xf9,
+	0x83, 0x4e, 0x69, 0xe2, 0x71, 0xa7, 0x84, 0x9e, 0x76, 0x4a, 0x13, 0x1f, 0x7c, 0xfc, 0xe1, 0x12,
+	0xaa, 0xcd, 0xe9, 0x11, 0x83, 0x37, 0x33, 0xff, 0xfd, 0x65, 0x09, 0xa9, 0x3f, 0x41, 0x70, 0xb6,
+	0x87, 0xf7, 0xa6, 0xc5, 0x3c, 0xc7, 0xdd, 0x7d, 0x06, 0x0d, 0xf0, 0x17, 0x00, 0xc2, 0x25, 0x93,
+	0xb8, 0x8b, 0x65, 0xe9, 0xe3, 0xaf, 0x6f, 0x59, 0xac, 0x97, 0x5c, 0xdf, 0xf2, 0x06, 0x31, 0xa9,
+	0x1c, 0xaf, 0x16, 0xf1, 0x54, 0x3f, 0x42, 0x70, 0x6e, 0x30, 0x9b, 0x94, 0xf3, 0x1d, 0x98, 0xa6,
+	0xb6, 0xe7, 0x5a, 0xd4, 0x87, 0x3b, 0x71, 0x79, 0x76, 0x6d, 0x29, 0x59, 0x94, 0x75, 0xc7, 0xa0,
+	0xd2, 0xff, 0x2d, 0xdb, 0x73, 0x77, 0xab, 0x33, 0x07, 0x5d, 0x61, 0x82, 0x5e, 0xf0, 0x8d, 0x01,
+	0xe4, 0x97, 0x46, 0x92, 0x0b, 0x9a, 0x1e, 0xf4, 0xf7, 0x63, 0xaa, 0xb2, 0xea, 0xae, 0x0f, 0x10,
+	0xa8, 0x7a, 0x1a, 0xa6, 0x75, 0xc7, 0xa0, 0x75, 0xcb, 0xe0, 0xaa, 0x66, 0x6a, 0x59, 0xff, 0xe7,
+	0x2d, 0x63, 0x6c, 0xd2, 0xfd, 0x22, 0x2e, 0x5d, 0x17, 0x40, 0x4a, 0xf7, 0x3a, 0xcc, 0x04, 0xd1,
+	0x20, 0xc4, 0x1b, 0xb6, 0xb2, 0xa1, 0xe9, 0xf8, 0x14, 0x7a, 0x14, 0x10, 0x56, 0x9a, 0xcd, 0x00,
+	0xf2, 0x8e, 0x47, 0x3c, 0xfa, 0x69, 0x88, 0xbc, 0x5f, 0x21, 0x38, 0x9f, 0x00, 0x27, 0xf5, 0x7b,
+	0x13, 0xb2, 0x2d, 0xc7, 0xa0, 0xcd, 0x20, 0xf2, 0x4e, 0xf7, 0x47, 0xde, 0xdb, 0x7e, 0x7b, 0x34,
+	0xcc, 0xa4, 0xc7, 0xf8, 0x34, 0xbc, 0x27, 0x25, 0xac, 0x91, 0x9d, 0xb1, 0x49, 0x78, 0x1e, 0x80,
+	0x8f, 0x5e, 0x37, 0x88, 0x47, 0x38, 0xdc, 0x5c, 0x6d, 0x86, 0xbf, 0xb9, 0x4e, 0x3c, 0xa2, 0xbe,
+	0x0a, 0xe7, 0x13, 0x86, 0x94, 0xc2, 0x60, 0xc8, 0x70, 0x4f, 0xc4, 0x3d, 0xf9, 0xb3, 0xfa, 0x53,
+	0x04, 0x45, 0xee, 0x75, 0xa7, 0x45, 0x5c, 0x6f, 0x6c, 0xa8, 0x6f, 0xf5, 0xa3, 0x56, 0x17, 0x3f,
+	0xe9, 0x94, 0x70, 0x04, 0xee, 0x6d, 0xca, 0x18, 0x31, 0xe9, 0xa3, 0x8f, 0x3f, 0x5c, 0x9a, 0xb5,
+	0xec, 0xa6, 0x65, 0xd3, 0xfa, 0xb7, 0x99, 0x63, 0x47, 0xa7, 0xf4, 0x4d, 0x28, 0x25, 0xc2, 0x75,
+	0x57, 0x3b, 0x32, 0xa9, 0xd4, 0x63, 0x88, 0xc9, 0x2f, 0x43, 0x5e, 0xee, 0xc4, 0xd1, 0xfb, 0x5f,
+	0xd5, 0x60, 0xa1, 0x6b, 0x1c, 0xbd, 0x8a, 0x12, 0x1d, 0x7e, 0x3b, 0x09, 0xa7, 0x62, 0x1e, 0x92,
+	0xf9, 0x62, 0xcc, 0xa5, 0x0a, 0x87, 0x9d, 0x52, 0x96, 0x9b, 0x5d, 0xef, 0x9e, 0x37, 0x6b, 0x30,
+	0xad, 0xbb, 0x94, 0x78, 0x8e, 0x5b, 0x98, 0x1c, 0x25, 0xbb, 0x34, 0xc4, 0x1b, 0x90, 0xd3, 0xb7,
+	0xa8, 0x7e, 0x97, 0x6d, 0xb7, 0x0a, 0x27, 0xb8, 0x20, 0x9f, 0xfd, 0xa4, 0x53, 0x7a, 0xc5, 0xb4,
+	0xbc, 0xad, 0xed, 0x46, 0x59, 0x77, 0x5a, 0x9a, 0xee, 0xb4, 0xa8, 0xd7, 0xd8, 0xf4, 0xc2, 0x87,
+	0xa6, 0xd5, 0x60, 0x5a, 0x63, 0xd7, 0xa3, 0xac, 0x7c, 0x93, 0xbe, 0x57, 0xf5, 0x1f, 0x6a, 0xdd,
+	0x5e, 0xf0, 0xb7, 0xe0, 0x05, 0xcb, 0x66, 0x1e, 0xb1, 0x3d, 0x8b, 0x78, 0xb4, 0xde, 0xa6, 0x6e,
+	0xcb, 0x62, 0xcc, 0xdf, 0x1c, 0x99, 0xa4, 0xbb, 0xae, 0xa2, 0xeb, 0x94, 0xb1, 0x75, 0xc7, 0xde,
+	0xb4, 0xcc, 0xe8, 0x1e, 0x3b, 0x15, 0xe9, 0x68, 0xa3, 0xdb, 0x8f, 0xbc, 0xec, 0x3e, 0x9a, 0x84,
+	0x7c, 0x9f, 0x4e, 0x57, 0xe2, 0x3a, 0xe5, 0x43, 0x9d, 0x9e, 0x76, 0x4a, 0x93, 0x96, 0xf1, 0x4c,
+	0x6a, 0xbd, 0x0b, 0x33, 0x7e, 0x18, 0xd4, 0xb7, 0x08, 0xdb, 0x7a, 0x36, 0xb9, 0xfc, 0x6e, 0x6e,
+	0x12, 0xb6, 0x35, 0x44, 0xae, 0xec, 0x38, 0xe5, 0xba, 0x9d, 0xc9, 0x65, 0xf2, 0x53, 0xb7, 0x33,
+	0xb9, 0xa9, 0x7c, 0x56, 0x7d, 0x88, 0x60, 0x3e, 0x12, 0xc6, 0x52, 0xbb, 0x5b, 0x30, 0x23, 0xb4,
+	0xf3, 0xf3, 0x12, 0xc4, 0x07, 0x57, 0x07, 0x5d, 0xc1, 0xbd, 0x92, 0x57, 0x73, 0x41, 0x5e, 0x52,
+	0xcb, 0xe9, 0xb2, 0x0d, 0x9f, 0x93, 0x5b, 0x4c, 0x6c, 0xe3, 0xdc, 0xd3, 0x4e, 0x89, 0xff, 0x16,
+	0x9b, 0x48, 0xae, 0xdf, 0x37, 0x22, 0x0c, 0x2c, 0xd8, 0x1a, 0xbd, 0x67, 0x3e, 0x3a, 0xf6, 0x99,
+	0xbf, 0x8f, 0x00, 0x47, 0x7b, 0x97, 0x53, 0xfc, 0x22, 0x40, 0x77, 0x8a, 0xc1, 0x61, 0x9f, 0x66,
+	0x8e, 0x11, 0x91, 0x67, 0x82, 0x49, 0x8e, 0xf1, 0xe8, 0x27, 0x70, 0x9a, 0xc3, 0x6e, 0x58, 0xb6,
+	0x4d, 0x8d, 0x21, 0x82, 0x1c, 0xff, 0x12, 0xfc, 0x3e, 0x82, 0x42, 0xff, 0x18, 0x52, 0x96, 0x45,
+	0xc8, 0xc9, 0x5d, 0x23, 0x44, 0xc9, 0x54, 0x67, 0x0f, 0x3b, 0xa5, 0x69, 0xb1, 0x6d, 0x58, 0x6d,
+	0x5a, 0xec, 0x98, 0x31, 0x4e, 0x78, 0x53, 0xde, 0x75, 0x37, 0x08, 0x6b, 0x8a, 0x50, 0x16, 0x19,
+	0xc9, 0xb8, 0x67, 0xfd, 0xbd, 0x49, 0x38, 0x9f, 0x30, 0x90, 0x9c, 0xfa, 0x75, 0xc0, 0xdd, 0x84,
+	0x5c, 0x5e, 0x45, 0x34, 0xc8, 0xa1, 0x4e, 0x1d, 0x76, 0x4a, 0xf3, 0x81, 0x4b, 0x25, 0x68, 0xac,
+	0xcd, 0xeb, 0xf1, 0x57, 0x63, 0x13, 0x06, 0x7f, 0x0d, 0xe6, 0x4d, 0x81, 0x5a, 0x0f, 0x33, 0xba,
+	0x13, 0x3c, 0x4e, 0x5f, 0xec, 0x8f, 0xd3, 0xd8, 0xac, 0xa2, 0x61, 0x9a, 0x37, 0x7b, 0xdb, 0x98,
+	0xfa, 0x37, 0x04, 0x27, 0x63, 0x0e, 0x78, 0x1d, 0xf2, 0xf1, 0xd9, 0x8f, 0xbc, 0xb1, 0x4f, 0xc6,
+	0xa6, 0x8f, 0xab, 0x90, 0xd5, 0xf9, 0x59, 0x24, 0x27, 0x5e, 0x1a, 0x06, 0x1a, 0x3b, 0xb2, 0xa4,
+	0x27, 0x2e, 0xc3, 0xf3, 0x2e, 0x6d, 0x11, 0xcb, 0xb6, 0x6c, 0xb3, 0xde, 0x68, 0x3a, 0xfa, 0xdd,
+	0xba, 0x49, 0x18, 0x3f, 0x62, 0x33, 0xb5, 0xf9, 0x6e, 0x53, 0xd5, 0x6f, 0xb9, 0x41, 0x98, 0xba,
+	0x20, 0xb7, 0xf7, 0x06, 0x71, 0x49, 0x2b, 0x08, 0x1b, 0xb5, 0x06, 0xcf, 0xf7, 0xbc, 0x95, 0x6b,
+	0xfc, 0x39, 0xc8, 0xb6, 0xf9, 0x1b, 0x79, 0xa0, 0x14, 0xfa, 0x01, 0x85, 0x47, 0x0f, 0x99, 0x70,
+	0x51, 0xf7, 0x83, 0x74, 0x27, 0x9a, 0x7c, 0x8b, 0xeb, 0x20, 0x88, 0xd6, 0x0a, 0x9c, 0x94, 0x17,
+	0x44, 0x6a, 0x11, 0x9f, 0x93, 0x0e, 0x95, 0x31, 0xe7, 0xba, 0x7f, 0x44, 0x50, 0x4a, 0xa4, 0x95,
+	0x72, 0xdc, 0x18, 0x12, 0xf2, 0xc9, 0xc4, 0xff, 0xc7, 0xa8, 0x57, 0xf7, 0x83, 0xc3, 0xa9, 0xba,
+	0x6d, 0x35, 0x0d, 0x39, 0x40, 0xa0, 0xee, 0x59, 0x79, 0x2d, 0xf1, 0x3b, 0x97, 0xeb, 0x2a, 0x2e,
+	0x1a, 0x7e, 0x7b, 0x0e, 0x90, 0x7e, 0xf2, 0x88, 0xd2, 0x63, 0xc8, 0x30, 0xd2, 0xf4, 0x78, 0xac,
+	0xcd, 0xd4, 0xf8, 0xb3, 0x3f, 0xa6, 0x65, 0x5b, 0x5e, 0x9d, 0xb8, 0x26, 0xe3, 0x69, 0xcb, 0x5c,
+	0x2d, 0xe7, 0xbf, 0xa8, 0xb8, 0x26, 0x53, 0xdf, 0x81, 0x33, 0x03, 0x60, 0x8f, 0x5f, 0x14, 0x50,
+	0x0f, 0x10, 0x28, 0xa2, 0x47, 0x62, 0xdf, 0xad, 0x34, 0x9b, 0xce, 0x0e, 0xb1, 0xf5, 0xf0, 0x0a,
+	0x28, 0xc3, 0x94, 0xb3, 0x63, 0x53, 0x77, 0x64, 0x87, 0xc2, 0xcc, 0x47, 0x60, 0x6d, 0x6a, 0x1b,
+	0x34, 0x45, 0x62, 0x23, 0x0d, 0x63, 0xf1, 0x77, 0xe2, 0xd8, 0xf1, 0xf7, 0x87, 0xa0, 0x02, 0x11,
+	0x9f, 0x8a, 0x94, 0xe7, 0x36, 0x00, 0xe9, 0xbe, 0x95, 0x17, 0xf0, 0x80, 0xf3, 0xa2, 0xc7, 0x3b,
+	0xba, 0x2b, 0x23, 0xde, 0xe3, 0x0b, 0x3f, 0x4d, 0x5e, 0xbf, 0xfe, 0xa8, 0x1b, 0x8e, 0x65, 0x7b,
+	0xb4, 0xbb, 0xb5, 0x17, 0x60, 0xca, 0xa0, 0xb6, 0xd3, 0x92, 0x81, 0x27, 0x7e, 0xa8, 0x5f, 0x86,
+	0x42, 0xbf, 0x83, 0x9c, 0x61, 0x21, 0x16, 0x00, 0xe1, 0x17, 0x4e, 0x11, 0xc0, 0xa5, 0xa6, 0xc5,
+	0x3c, 0xea, 0x52, 0x83, 0xf3, 0xe6, 0x6a, 0x91, 0x37, 0x6b, 0xdf, 0x59, 0x80, 0x29, 0xde, 0x2d,
+	0x7e, 0x84, 0x60, 0x2e, 0x5a, 0xff, 0xc1, 0x03, 0x4a, 0x21, 0x49, 0x85, 0x2e, 0x65, 0x39, 0x95,
+	0xad, 0xa0, 0x55, 0x57, 0xbf, 0xeb, 0xcb, 0xfa, 0xf0, 0x1f, 0xff, 0xf9, 0xf1, 0xe4, 0x22, 0x7e,
+	0x49, 0xeb, 0x2b, 0xf9, 0x05, 0x9b, 0x5e, 0xdb, 0x93, 0xb3, 0x78, 0x80, 0xf7, 0x11, 0x9c, 0x8c,
+	0xd5, 0x70, 0xf0, 0xca, 0x88, 0x31, 0x7b, 0xeb, 0x50, 0x4a, 0x39, 0xad, 0xb9, 0xa4, 0x7c, 0x23,
+	0xa4, 0x2c, 0xe3, 0xab, 0x69, 0x28, 0xb5, 0x2d, 0x49, 0xf6, 0x9b, 0x08, 0xad, 0x2c, 0x9b, 0x8c,
+	0xa4, 0xed, 0xad, 0xef, 0x28, 0xe5, 0xb4, 0xe6, 0x92, 0xf6, 0x5a, 0x48, 0x7b, 0x15, 0x2f, 0x0d,
+	0xa2, 0x35, 0xa8, 0xb6, 0x27, 0x13, 0xae, 0x07, 0x5a, 0x58, 0x8e, 0xf9, 0x1d, 0x82, 0x7c, 0xbc,
+	0x46, 0x81, 0x93, 0x46, 0x4f, 0xa8, 0xb4, 0x28, 0x5a, 0x6a, 0xfb, 0xd4, 0xb8, 0x7d, 0xe2, 0x32,
+	0x4e, 0xf6, 0x67, 0x04, 0xf9, 0x78, 0xe5, 0x20, 0x11, 0x37, 0xa1, 0xaa, 0xa1, 0x68, 0xa9, 0xed,
+	0x25, 0x6e, 0x35, 0xc4, 0xbd, 0x86, 0x5f, 0x4b, 0x85, 0xeb, 0x92, 0x1d, 0x6d, 0x2f, 0x2c, 0x2e,
+	0x3c, 0xc0, 0x7f, 0x41, 0x80, 0xfb, 0x0b, 0x04, 0xf8, 0x95, 0x04, 0x96, 0xc4, 0x42, 0x87, 0xb2,
+	0x7a, 0x04, 0x0f, 0xc9, 0xff, 0x79, 0x8e, 0xfe, 0x06, 0xbe, 0x96, 0x4e, 0x69, 0xbf, 0xa3, 0x5e,
+	0xf8, 0xf7, 0x21, 0xc3, 0xa3, 0x58, 0x4d, 0x0c, 0xcb, 0x30, 0x74, 0x2f, 0x0e, 0xb5, 0x91, 0x44,
+	0x2b, 0xa1, 0xa2, 0x2a, 0xbe, 0x30, 0x2a, 0x5e, 0xf1, 0x0e, 0x4c, 0xf9, 0xee, 0x0c, 0x0f, 0xeb,
+	0x3c, 0xb8, 0xbc, 0x94, 0x97, 0x86, 0x1b, 0x49, 0x84, 0x8b, 0x21, 0x42, 0x01, 0xbf, 0x30, 0x18,
+	0x01, 0xff, 0x00, 0x41, 0x2e, 0xf8, 0x32, 0xc3, 0x8b, 0x43, 0xfa, 0x8d, 0x9e, 0x86, 0x97, 0x46,
+	0xda, 0x49, 0x84, 0xb5, 0x10, 0xe1, 0x12, 0x7e, 0x79, 0x30, 0xc2, 0x8a, 0xff, 0xdd, 0x18, 0x91,
+	0xe2, 0x47, 0x08, 0x66, 0x23, 0xdf, 0x53, 0xf8, 0x4a, 0xc2, 0x60, 0xfd, 0xdf, 0x75, 0xca, 0x52,
+	0x1a, 0x53, 0x89, 0xb6, 0x1c, 0xa2, 0x5d, 0xc0, 0xc5, 0xc1, 0x68, 0x4c, 0x6b, 0x73, 0x4f, 0xfc,
+	0x33, 0x04, 0xf9, 0xf8, 0xd7, 0x4e, 0xe2, 0xae, 0x4c, 0xf8, 0xfe, 0x52, 0xb4, 0xd4, 0xf6, 0x12,
+	0xf1, 0x12, 0xa7, 0x7b, 0x11, 0x97, 0x92, 0xe8, 0xe4, 0xe7, 0x08, 0x7e, 0x88, 0x20, 0x2b, 0x92,
+	0x6d, 0x9c, 0x14, 0x1a, 0x3d, 0x39, 0xbd, 0xf2, 0xf2, 0x08, 0xab, 0xa3, 0x69, 0x24, 0x46, 0xfe,
+	0x2b, 0x02, 0xdc, 0x9f, 0x20, 0x27, 0xee, 0xff, 0xc4, 0xcc, 0x5f, 0x59, 0x3d, 0x82, 0xc7, 0x11,
+	0xcf, 0x2f, 0xa6, 0xc9, 0x3c, 0x55, 0xdb, 0x8b, 0x65, 0xb8, 0x0f, 0xf0, 0xcf, 0x11, 0xcc, 0x45,
+	0xb3, 0xcf, 0xc4, 0xfc, 0x60, 0x40, 0x3e, 0xad, 0x2c, 0xa7, 0xb2, 0x95, 0xb4, 0xaf, 0x85, 0xb4,
+	0x4b, 0xf8, 0xf2, 0x90, 0x23, 0xab, 0xe1, 0x7b, 0x07, 0x84, 0xf8, 0xd7, 0x08, 0x9e, 0xeb, 0xcd,
+	0x00, 0xf1, 0xd5, 0xa4, 0x61, 0x07, 0xe5, 0xbc, 0xca, 0x4a, 0x4a, 0x6b, 0x89, 0xf9, 0x7a, 0x88,
+	0xb9, 0x8c, 0xaf, 0xf4, 0x63, 0x36, 0x88, 0x7d, 0xb7, 0x1e, 0xa6, 0x8e, 0xda, 0x1e, 0xcf, 0x94,
+	0xc5, 0x06, 0x8e, 0x24, 0x71, 0x89, 0x1b, 0xb8, 0x3f, 0x33, 0x54, 0x96, 0xd2, 0x98, 0xa6, 0x0c,
+	0x4e, 0x8e, 0xd7, 0x16, 0x4e, 0xd5, 0x9b, 0x07, 0xff, 0x2e, 0x4e, 0x7c, 0x70, 0x58, 0x9c, 0x38,
+	0x38, 0x2c, 0xa2, 0xc7, 0x87, 0x45, 0xf4, 0xaf, 0xc3, 0x22, 0xfa, 0xe1, 0x93, 0xe2, 0xc4, 0xe3,
+	0x27, 0xc5, 0x89, 0x7f, 0x3e, 0x29, 0x4e, 0x7c, 0x7d, 0x31, 0x52, 0x6e, 0x5c, 0x77, 0x58, 0xeb,
+	0xab, 0x41, 0x5f, 0x86, 0xf6, 0x9e, 0xe8, 0x93, 0xff, 0xa7, 0xb6, 0x91, 0xe5, 0xff, 0x15, 0x7d,
+	0xf5, 0x7f, 0x03, 0x00, 0xe6, 0x5b, 0x08, 0x82, 0x10, 0x1e, 0x00, 0x00,
 }
 
 func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
@@ -1515,6 +1752,10 @@ type QueryClient interface {
 	ContractsByCreator(ctx context.Context, in *QueryContractsByCreatorRequest, opts ...grpc.CallOption) (*QueryContractsByCreatorResponse, error)
 	// BuildAddress builds a contract address
 	BuildAddress(ctx context.Context, in *QueryBuildAddressRequest, opts ...grpc.CallOption) (*QueryBuildAddressResponse, error)
+	// BankAllowances gets the bank precompile allowances granted by an owner
+	BankAllowances(ctx context.Context, in *QueryBankAllowancesRequest, opts ...grpc.CallOption) (*QueryBankAllowancesResponse, error)
+	// BankPointer gets the ERC20 pointer contract of a bank denom
+	BankPointer(ctx context.Context, in *QueryBankPointerRequest, opts ...grpc.CallOption) (*QueryBankPointerResponse, error)
 }
 
 type queryClient struct {
@@ -1651,6 +1892,24 @@ func (c *queryClient) BuildAddress(ctx context.Context, in *QueryBuildAddressReq
 	return out, nil
 }
 
+func (c *queryClient) BankAllowances(ctx context.Context, in *QueryBankAllowancesRequest, opts ...grpc.CallOption) (*QueryBankAllowancesResponse, error) {
+	out := new(QueryBankAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.Query/BankAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BankPointer(ctx context.Context, in *QueryBankPointerRequest, opts ...grpc.CallOption) (*QueryBankPointerResponse, error) {
+	out := new(QueryBankPointerResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.Query/BankPointer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ContractInfo gets the contract meta data
@@ -1681,6 +1940,10 @@ type QueryServer interface {
 	ContractsByCreator(context.Context, *QueryContractsByCreatorRequest) (*QueryContractsByCreatorResponse, error)
 	// BuildAddress builds a contract address
 	BuildAddress(context.Context, *QueryBuildAddressRequest) (*QueryBuildAddressResponse, error)
+	// BankAllowances gets the bank precompile allowances granted by an owner
+	BankAllowances(context.Context, *QueryBankAllowancesRequest) (*QueryBankAllowancesResponse, error)
+	// BankPointer gets the ERC20 pointer contract of a bank denom
+	BankPointer(context.Context, *QueryBankPointerRequest) (*QueryBankPointerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1729,6 +1992,12 @@ func (*UnimplementedQueryServer) ContractsByCreator(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) BuildAddress(ctx context.Context, req *QueryBuildAddressRequest) (*QueryBuildAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuildAddress not implemented")
 }
+func (*UnimplementedQueryServer) BankAllowances(ctx context.Context, req *QueryBankAllowancesRequest) (*QueryBankAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankAllowances not implemented")
+}
+func (*UnimplementedQueryServer) BankPointer(ctx context.Context, req *QueryBankPointerRequest) (*QueryBankPointerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankPointer not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1986,6 +2255,42 @@ func _Query_BuildAddress_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BankAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBankAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BankAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1.Query/BankAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BankAllowances(ctx, req.(*QueryBankAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BankPointer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBankPointerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BankPointer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1.Query/BankPointer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BankPointer(ctx, req.(*QueryBankPointerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2046,6 +2351,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuildAddress",
 			Handler:    _Query_BuildAddress_Handler,
 		},
+		{
+			MethodName: "BankAllowances",
+			Handler:    _Query_BankAllowances_Handler,
+		},
+		{
+			MethodName: "BankPointer",
+			Handler:    _Query_BankPointer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmwasm/wasm/v1/query.proto",
@@ -2950,6 +3263,20 @@ func (m *QueryGaslessContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.GaslessContracts) > 0 {
+		for iNdEx := len(m.GaslessContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaslessContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2974,6 +3301,51 @@ func (m *QueryGaslessContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *GaslessContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3197,48 +3569,216 @@ func (m *QueryBuildAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBankAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryContractInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryBankAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryContractInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBankAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.ContractInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryContractHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBankAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBankAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBankAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBankPointerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBankPointerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBankPointerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBankPointerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBankPointerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBankPointerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryContractInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ContractInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -3582,6 +4122,30 @@ func (m *QueryGaslessContractsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.GaslessContracts) > 0 {
+		for _, e := range m.GaslessContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaslessContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlockGas))
+	}
 	return n
 }
 
@@ -3679,6 +4243,75 @@ func (m *QueryBuildAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBankAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBankAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBankPointerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBankPointerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6212,25 +6845,193 @@ func (m *QueryGaslessContractsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaslessContracts", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaslessContracts = append(m.GaslessContracts, GaslessContract{})
+			if err := m.GaslessContracts[len(m.GaslessContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaslessContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlockGas", wireType)
+			}
+			m.RemainingBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
@@ -6864,6 +7665,460 @@ func (m *QueryBuildAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBankAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBankAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, BankAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBankPointerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankPointerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankPointerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBankPointerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankPointerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankPointerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
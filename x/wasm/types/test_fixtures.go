@@ -34,6 +34,20 @@ func GenesisFixture(mutators ...func(*GenesisState)) GenesisState {
 	for i := 0; i < numContracts; i++ {
 		fixture.Contracts[i] = ContractFixture()
 	}
+	fixture.GaslessContracts = []GaslessContractEntry{{
+		ContractAddress: fixture.Contracts[0].ContractAddress,
+		Config:          GaslessConfig{MaxGasPerBlock: 1_000_000, MaxGasPerTx: 100_000},
+	}}
+	fixture.BankAllowances = []BankAllowance{{
+		Owner:   fixture.Contracts[0].ContractInfo.Creator,
+		Spender: fixture.Contracts[0].ContractAddress,
+		Denom:   "stake",
+		Amount:  sdkmath.NewInt(100),
+	}}
+	fixture.BankPointers = []BankPointer{{
+		Denom:   "stake",
+		Address: BankPointerAddress("stake").Hex(),
+	}}
 	for i := 0; i < numSequences; i++ {
 		fixture.Sequences[i] = Sequence{
 			IDKey: randBytes(5),
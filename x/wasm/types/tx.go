@@ -8,6 +8,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -574,5 +575,30 @@ func (msg MsgSetGaslessContracts) ValidateBasic() error {
 	if hasDuplicates(msg.Contracts) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate contract addresses")
 	}
+	if err := msg.Config.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "config")
+	}
 	return nil
 }
+
+var _ cdctypes.UnpackInterfacesMessage = &MsgSetGaslessContracts{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSetGaslessContracts) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return msg.Config.UnpackInterfaces(unpacker)
+}
+
+func (msg MsgRegisterBankPointer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterBankPointer) Type() string {
+	return "register-bank-pointer"
+}
+
+func (msg MsgRegisterBankPointer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	return errorsmod.Wrap(sdk.ValidateDenom(msg.Denom), "denom")
+}
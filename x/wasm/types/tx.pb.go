@@ -1508,6 +1508,8 @@ type MsgSetGaslessContracts struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// Contracts are the addresses of the smart contracts
 	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty" yaml:"contracts"`
+	// Config is the gas budget applied to all contracts
+	Config GaslessConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
 func (m *MsgSetGaslessContracts) Reset()         { *m = MsgSetGaslessContracts{} }
@@ -1580,6 +1582,87 @@ func (m *MsgSetGaslessContractsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetGaslessContractsResponse proto.InternalMessageInfo
 
+// MsgRegisterBankPointer registers the ERC20 pointer contract of a bank denom.
+// Any account can register the pointer of a denom with a supply.
+type MsgRegisterBankPointer struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Denom is the bank denom to register the pointer for
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRegisterBankPointer) Reset()         { *m = MsgRegisterBankPointer{} }
+func (m *MsgRegisterBankPointer) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBankPointer) ProtoMessage()    {}
+func (*MsgRegisterBankPointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f74d82755520264, []int{36}
+}
+func (m *MsgRegisterBankPointer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBankPointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBankPointer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBankPointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBankPointer.Merge(m, src)
+}
+func (m *MsgRegisterBankPointer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBankPointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBankPointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBankPointer proto.InternalMessageInfo
+
+// MsgRegisterBankPointerResponse returns the pointer address
+type MsgRegisterBankPointerResponse struct {
+	// Pointer is the hex encoded address of the ERC20 pointer contract
+	Pointer string `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+}
+
+func (m *MsgRegisterBankPointerResponse) Reset()         { *m = MsgRegisterBankPointerResponse{} }
+func (m *MsgRegisterBankPointerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBankPointerResponse) ProtoMessage()    {}
+func (*MsgRegisterBankPointerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f74d82755520264, []int{37}
+}
+func (m *MsgRegisterBankPointerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBankPointerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBankPointerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBankPointerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBankPointerResponse.Merge(m, src)
+}
+func (m *MsgRegisterBankPointerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBankPointerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBankPointerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBankPointerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "cosmwasm.wasm.v1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "cosmwasm.wasm.v1.MsgStoreCodeResponse")
@@ -1617,125 +1700,132 @@ func init() {
 	proto.RegisterType((*MsgUpdateContractLabelResponse)(nil), "cosmwasm.wasm.v1.MsgUpdateContractLabelResponse")
 	proto.RegisterType((*MsgSetGaslessContracts)(nil), "cosmwasm.wasm.v1.MsgSetGaslessContracts")
 	proto.RegisterType((*MsgSetGaslessContractsResponse)(nil), "cosmwasm.wasm.v1.MsgSetGaslessContractsResponse")
+	proto.RegisterType((*MsgRegisterBankPointer)(nil), "cosmwasm.wasm.v1.MsgRegisterBankPointer")
+	proto.RegisterType((*MsgRegisterBankPointerResponse)(nil), "cosmwasm.wasm.v1.MsgRegisterBankPointerResponse")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/tx.proto", fileDescriptor_4f74d82755520264) }
 
 var fileDescriptor_4f74d82755520264 = []byte{
-	// 1803 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4b, 0x6f, 0xdb, 0x46,
-	0xda, 0xb4, 0xde, 0x63, 0x6d, 0xec, 0x30, 0x4e, 0x2c, 0xd3, 0x89, 0xe4, 0x30, 0x89, 0xad, 0x78,
-	0x1d, 0xc9, 0xd6, 0x66, 0xb3, 0x89, 0x76, 0x2f, 0x96, 0xb3, 0x0f, 0x07, 0x2b, 0xc0, 0x90, 0xe1,
-	0x0d, 0x76, 0x11, 0x40, 0xa0, 0xc5, 0x31, 0xcd, 0x8d, 0x44, 0xaa, 0x1a, 0xca, 0x8f, 0x43, 0x81,
-	0x22, 0x28, 0x0a, 0xb4, 0xe8, 0xa1, 0x97, 0x5c, 0xda, 0x73, 0x81, 0xb6, 0x97, 0xfa, 0xd0, 0x9f,
-	0x50, 0x14, 0x46, 0xd1, 0x43, 0x50, 0xf4, 0x90, 0x93, 0xdb, 0x3a, 0x07, 0x9f, 0x7a, 0xc9, 0xb1,
-	0x87, 0xa2, 0xe0, 0x0c, 0x39, 0xa2, 0xa8, 0x21, 0xf5, 0x32, 0x92, 0x1e, 0x7a, 0xb1, 0xc9, 0xf9,
-	0xde, 0x4f, 0x7e, 0xdf, 0x08, 0x4c, 0x57, 0x74, 0x54, 0xdb, 0x93, 0x50, 0x2d, 0x8b, 0xff, 0xec,
-	0x2e, 0x67, 0x8d, 0xfd, 0x4c, 0xbd, 0xa1, 0x1b, 0x3a, 0x3f, 0x61, 0x83, 0x32, 0xf8, 0xcf, 0xee,
-	0xb2, 0x90, 0x34, 0x4f, 0x74, 0x94, 0xdd, 0x92, 0x10, 0xcc, 0xee, 0x2e, 0x6f, 0x41, 0x43, 0x5a,
-	0xce, 0x56, 0x74, 0x55, 0x23, 0x14, 0xc2, 0x94, 0x05, 0xaf, 0x21, 0xc5, 0xe4, 0x54, 0x43, 0x8a,
-	0x05, 0x98, 0x54, 0x74, 0x45, 0xc7, 0x8f, 0x59, 0xf3, 0xc9, 0x3a, 0xbd, 0xdc, 0x29, 0xfb, 0xa0,
-	0x0e, 0x91, 0x05, 0x9d, 0x26, 0xcc, 0xca, 0x84, 0x8c, 0xbc, 0x58, 0xa0, 0xf3, 0x52, 0x4d, 0xd5,
-	0xf4, 0x2c, 0xfe, 0x4b, 0x8e, 0xc4, 0x5f, 0x38, 0x10, 0x2f, 0x22, 0x65, 0xc3, 0xd0, 0x1b, 0x70,
-	0x55, 0x97, 0x21, 0xbf, 0x04, 0xc2, 0x08, 0x6a, 0x32, 0x6c, 0x24, 0xb8, 0x59, 0x2e, 0x1d, 0x2b,
-	0x24, 0xbe, 0xfd, 0xe2, 0xd6, 0xa4, 0xc5, 0x65, 0x45, 0x96, 0x1b, 0x10, 0xa1, 0x0d, 0xa3, 0xa1,
-	0x6a, 0x4a, 0xc9, 0xc2, 0xe3, 0xef, 0x80, 0x73, 0xa6, 0x1e, 0xe5, 0xad, 0x03, 0x03, 0x96, 0x2b,
-	0xba, 0x0c, 0x13, 0xa3, 0xb3, 0x5c, 0x3a, 0x5e, 0x98, 0x38, 0x39, 0x4e, 0xc5, 0x1f, 0xae, 0x6c,
-	0x14, 0x0b, 0x07, 0x06, 0xe6, 0x5d, 0x8a, 0x9b, 0x78, 0xf6, 0x1b, 0xbf, 0x09, 0x2e, 0xa9, 0x1a,
-	0x32, 0x24, 0xcd, 0x50, 0x25, 0x03, 0x96, 0xeb, 0xb0, 0x51, 0x53, 0x11, 0x52, 0x75, 0x2d, 0x11,
-	0x9a, 0xe5, 0xd2, 0x63, 0xb9, 0x64, 0xc6, 0xed, 0xc8, 0xcc, 0x4a, 0xa5, 0x02, 0x11, 0x5a, 0xd5,
-	0xb5, 0x6d, 0x55, 0x29, 0x5d, 0x74, 0x50, 0xaf, 0x53, 0xe2, 0xfc, 0xd5, 0x27, 0xa7, 0x87, 0x0b,
-	0x96, 0x6e, 0xef, 0x9d, 0x1e, 0x2e, 0x9c, 0xc7, 0x4e, 0x72, 0xda, 0xf8, 0x20, 0x18, 0x0d, 0x4c,
-	0x04, 0x1f, 0x04, 0xa3, 0xc1, 0x89, 0x90, 0xf8, 0x10, 0x4c, 0x3a, 0x61, 0x25, 0x88, 0xea, 0xba,
-	0x86, 0x20, 0x7f, 0x0d, 0x44, 0x4c, 0x5b, 0xca, 0xaa, 0x8c, 0x1d, 0x11, 0x2c, 0x80, 0x93, 0xe3,
-	0x54, 0xd8, 0x44, 0x59, 0xbb, 0x5f, 0x0a, 0x9b, 0xa0, 0x35, 0x99, 0x17, 0x40, 0xb4, 0xb2, 0x03,
-	0x2b, 0x8f, 0x51, 0xb3, 0x46, 0x8c, 0x2e, 0xd1, 0x77, 0xf1, 0x69, 0x00, 0x5c, 0x2a, 0x22, 0x65,
-	0xad, 0xa5, 0xe4, 0xaa, 0xae, 0x19, 0x0d, 0xa9, 0x62, 0x0c, 0xe0, 0xe3, 0x0c, 0x08, 0x49, 0x72,
-	0x4d, 0xd5, 0xb0, 0x14, 0x3f, 0x02, 0x82, 0xe6, 0xd4, 0x3e, 0xe0, 0xa9, 0xfd, 0x24, 0x08, 0x55,
-	0xa5, 0x2d, 0x58, 0x4d, 0x04, 0x4d, 0xa6, 0x25, 0xf2, 0xc2, 0xdf, 0x05, 0x81, 0x1a, 0x52, 0x70,
-	0x0c, 0xe2, 0x85, 0xb9, 0x9f, 0x8f, 0x53, 0x7c, 0x49, 0xda, 0xb3, 0x55, 0x2f, 0x42, 0x84, 0x24,
-	0x05, 0x7e, 0x78, 0x7a, 0xb8, 0x30, 0xa6, 0x6a, 0x55, 0x55, 0x83, 0xe5, 0xff, 0x23, 0x5d, 0x2b,
-	0x99, 0x24, 0xfc, 0x1e, 0x08, 0x6d, 0x37, 0x35, 0x19, 0x25, 0xc2, 0xb3, 0x81, 0xf4, 0x58, 0x6e,
-	0x3a, 0x63, 0x69, 0x68, 0xa6, 0x7d, 0xc6, 0x4a, 0xfb, 0xcc, 0xaa, 0xae, 0x6a, 0x85, 0x7f, 0x1c,
-	0x1d, 0xa7, 0x46, 0x3e, 0xfb, 0x3e, 0x95, 0x56, 0x54, 0x63, 0xa7, 0xb9, 0x95, 0xa9, 0xe8, 0x35,
-	0x2b, 0x53, 0xad, 0x7f, 0xb7, 0x90, 0xfc, 0xd8, 0xca, 0x6a, 0x93, 0x00, 0x99, 0x02, 0xe3, 0x55,
-	0xa8, 0x48, 0x95, 0x83, 0xb2, 0x59, 0x38, 0xe8, 0x93, 0xd3, 0xc3, 0x05, 0xae, 0x44, 0xe4, 0xe5,
-	0xff, 0xe8, 0x0a, 0xf9, 0x8c, 0x1d, 0x72, 0x86, 0xf3, 0xc5, 0x1d, 0x90, 0x64, 0x43, 0x68, 0xe8,
-	0x73, 0x20, 0x22, 0x11, 0xa7, 0x76, 0x8d, 0x8f, 0x8d, 0xc8, 0xf3, 0x20, 0x28, 0x4b, 0x86, 0x64,
-	0x65, 0x01, 0x7e, 0x16, 0xbf, 0x0c, 0x80, 0x29, 0xb6, 0xa8, 0xdc, 0xef, 0x29, 0x70, 0xb6, 0x29,
-	0x60, 0xfa, 0x1f, 0x49, 0x55, 0x23, 0x11, 0x21, 0xfe, 0x37, 0x9f, 0xf9, 0x29, 0x10, 0xd9, 0x56,
-	0xf7, 0xcb, 0xa6, 0x29, 0xd1, 0x59, 0x2e, 0x1d, 0x2d, 0x85, 0xb7, 0xd5, 0xfd, 0x22, 0x52, 0xf2,
-	0x8b, 0xae, 0x7c, 0xb9, 0xec, 0x93, 0x2f, 0x39, 0x51, 0x05, 0x29, 0x0f, 0xd0, 0x99, 0x67, 0xcc,
-	0xf3, 0x51, 0xc0, 0x17, 0x91, 0xf2, 0xf7, 0x7d, 0x58, 0x69, 0x0e, 0xd5, 0x2f, 0x6e, 0x83, 0x68,
-	0xc5, 0xa2, 0xee, 0x9a, 0x2f, 0x14, 0xd3, 0x8e, 0x7b, 0x60, 0x88, 0xb8, 0x87, 0x5e, 0x71, 0xe9,
-	0xcf, 0xbb, 0x42, 0x39, 0x65, 0x87, 0xd2, 0xe5, 0x43, 0x71, 0x09, 0x08, 0x9d, 0xa7, 0x34, 0x80,
-	0x76, 0x30, 0x38, 0x47, 0x30, 0xde, 0x26, 0xc1, 0x28, 0xaa, 0x4a, 0x43, 0x7a, 0x0d, 0xc1, 0xe8,
-	0xa9, 0x7e, 0xad, 0x88, 0x05, 0xfb, 0x8e, 0x98, 0xb7, 0xe3, 0x5c, 0xf6, 0x5a, 0x8e, 0x73, 0x9d,
-	0xfa, 0x3a, 0xee, 0x3b, 0x0e, 0x9c, 0x2b, 0x22, 0x65, 0xb3, 0x2e, 0x4b, 0x06, 0x5c, 0xc1, 0xcd,
-	0xa8, 0x7f, 0xa7, 0xfd, 0x19, 0xc4, 0x34, 0xb8, 0x57, 0xee, 0xad, 0xe5, 0x45, 0x35, 0xb8, 0x47,
-	0x04, 0x39, 0x7d, 0x1d, 0xe8, 0xd5, 0xd7, 0xf9, 0x6b, 0x2e, 0x67, 0x5c, 0xb0, 0x9d, 0xe1, 0xb0,
-	0x41, 0x4c, 0xe0, 0xef, 0xb9, 0xe3, 0xc4, 0x76, 0x82, 0xf8, 0x11, 0x07, 0xfe, 0x50, 0x44, 0xca,
-	0x6a, 0x15, 0x4a, 0x8d, 0x41, 0xed, 0x1d, 0x4c, 0x71, 0xd1, 0xa5, 0x38, 0x6f, 0x2b, 0xde, 0xd2,
-	0x45, 0x9c, 0x02, 0x17, 0xdb, 0x0e, 0xa8, 0xda, 0x4f, 0x46, 0x71, 0x68, 0x89, 0x45, 0xed, 0xfd,
-	0x6d, 0x5b, 0x55, 0x06, 0xb0, 0xc1, 0x91, 0xb2, 0xa3, 0x9e, 0x29, 0xfb, 0x08, 0x08, 0x66, 0x60,
-	0x3d, 0x46, 0xbf, 0x40, 0x4f, 0xa3, 0x5f, 0x42, 0x83, 0x7b, 0x6b, 0xcc, 0xe9, 0x2f, 0xeb, 0x72,
-	0x48, 0xaa, 0x3d, 0x92, 0x1d, 0x56, 0x8a, 0xd7, 0x81, 0xe8, 0x0d, 0xa5, 0xae, 0xfa, 0x9c, 0x03,
-	0xe3, 0x14, 0x6d, 0x5d, 0x6a, 0x48, 0x35, 0xc4, 0xdf, 0x01, 0x31, 0xa9, 0x69, 0xec, 0xe8, 0x0d,
-	0xd5, 0x38, 0xe8, 0xea, 0xa2, 0x16, 0x2a, 0xff, 0x57, 0x10, 0xae, 0x63, 0x0e, 0xd8, 0x49, 0x63,
-	0xb9, 0x44, 0xa7, 0xb1, 0x44, 0x42, 0x21, 0x66, 0xf6, 0x4a, 0xd2, 0xee, 0x2c, 0x12, 0x52, 0xb6,
-	0x2d, 0x66, 0xa6, 0x89, 0x93, 0xed, 0x26, 0x12, 0x5a, 0x71, 0x1a, 0xcf, 0x1e, 0xce, 0x23, 0x6a,
-	0xcc, 0x09, 0x31, 0x66, 0xa3, 0x29, 0xeb, 0xb4, 0xab, 0x0d, 0x6a, 0xcc, 0x2b, 0xfe, 0xd0, 0xf8,
-	0xda, 0xef, 0x34, 0x48, 0xbc, 0x85, 0xed, 0x77, 0x1e, 0xf9, 0xf6, 0xac, 0x8f, 0x39, 0x30, 0x56,
-	0x44, 0xca, 0xba, 0xaa, 0x99, 0xe9, 0x3a, 0x78, 0x70, 0xef, 0x99, 0xfe, 0xc0, 0x25, 0x60, 0x86,
-	0x37, 0x90, 0x0e, 0x16, 0x92, 0x27, 0xc7, 0xa9, 0x08, 0xa9, 0x01, 0xf4, 0xf2, 0x38, 0x35, 0x7e,
-	0x20, 0xd5, 0xaa, 0x79, 0xd1, 0x46, 0x12, 0x4b, 0x11, 0x52, 0x17, 0x88, 0x34, 0xa1, 0x76, 0xd3,
-	0x26, 0x6c, 0xd3, 0x6c, 0xbd, 0xc4, 0x8b, 0xe0, 0x82, 0xe3, 0x95, 0x86, 0xf4, 0x53, 0xd2, 0x81,
-	0x36, 0xb5, 0xfa, 0x6b, 0x34, 0xe0, 0x46, 0xa7, 0x01, 0xb4, 0x1f, 0xb5, 0x34, 0xb3, 0xfa, 0x51,
-	0xeb, 0x80, 0x1a, 0xf1, 0x4e, 0x08, 0x8f, 0xe6, 0x78, 0x17, 0x5b, 0xd1, 0x64, 0xd6, 0xe6, 0x34,
-	0xa8, 0x55, 0x9d, 0x3b, 0x6a, 0x60, 0xc8, 0x1d, 0x35, 0x38, 0xc4, 0x8e, 0xca, 0x5f, 0x01, 0xa0,
-	0x69, 0xda, 0x4f, 0x54, 0x09, 0xe1, 0xe1, 0x34, 0xd6, 0xb4, 0x3d, 0xd2, 0x1a, 0xf5, 0xc3, 0xbd,
-	0x8d, 0xfa, 0x74, 0x8a, 0x8f, 0x30, 0xa6, 0xf8, 0xe8, 0x10, 0xd3, 0x5c, 0xec, 0x15, 0x4f, 0xf1,
-	0x97, 0x40, 0x18, 0xe9, 0xcd, 0x46, 0x05, 0x26, 0x00, 0xb6, 0xc4, 0x7a, 0xe3, 0x13, 0x20, 0xb2,
-	0xd5, 0x54, 0xab, 0xe6, 0xb7, 0x68, 0x0c, 0x03, 0xec, 0x57, 0x7e, 0x06, 0xc4, 0x70, 0x26, 0xee,
-	0x48, 0x68, 0x27, 0x11, 0xb7, 0x56, 0x70, 0x5d, 0x86, 0xff, 0x92, 0xd0, 0x4e, 0xfe, 0x4e, 0x67,
-	0x42, 0x5e, 0x6b, 0xbb, 0x0d, 0x60, 0x67, 0x99, 0x58, 0x07, 0x73, 0xfe, 0x18, 0x67, 0x3e, 0xf8,
-	0x7f, 0xc5, 0xe1, 0x25, 0x63, 0x45, 0x96, 0xcd, 0x04, 0xd8, 0xac, 0x57, 0x75, 0x49, 0x26, 0x5d,
-	0xdb, 0x62, 0x32, 0x44, 0x45, 0xe7, 0x40, 0x4c, 0xb2, 0x99, 0xe0, 0x92, 0x8e, 0x15, 0x26, 0x5f,
-	0x1e, 0xa7, 0x26, 0x48, 0x1d, 0x53, 0x90, 0x58, 0x6a, 0xa1, 0xe5, 0xff, 0xd2, 0xe9, 0xb9, 0xeb,
-	0xb6, 0xe7, 0xfc, 0x94, 0x14, 0x6f, 0x82, 0xf9, 0x2e, 0x28, 0xb4, 0xdc, 0xbf, 0xe1, 0xf0, 0xa7,
-	0xb7, 0x04, 0x6b, 0xfa, 0x2e, 0xfc, 0x6d, 0x98, 0x9d, 0xef, 0x34, 0x7b, 0xde, 0x36, 0xbb, 0x8b,
-	0x9e, 0xe2, 0x22, 0x58, 0xe8, 0x8e, 0x45, 0x8d, 0xff, 0x89, 0xcc, 0x5e, 0x76, 0x8e, 0xb9, 0x97,
-	0x8c, 0xb3, 0xeb, 0x73, 0xc3, 0xde, 0xc5, 0x05, 0x86, 0xe9, 0x73, 0x82, 0x63, 0x3a, 0x20, 0x37,
-	0x0c, 0x1d, 0x33, 0x40, 0xff, 0x97, 0x0c, 0xf9, 0x5c, 0x67, 0x94, 0x52, 0xee, 0xb2, 0x76, 0x6f,
-	0x31, 0x07, 0x38, 0xd7, 0x3c, 0xa0, 0x67, 0x76, 0xe9, 0x47, 0x6b, 0x3b, 0xe0, 0xa8, 0xed, 0xaf,
-	0x39, 0xc7, 0xe2, 0x60, 0x8b, 0xfc, 0x37, 0x6e, 0xd1, 0xfd, 0x8f, 0xd8, 0x33, 0x64, 0x2d, 0x22,
-	0xed, 0x7e, 0x94, 0xb8, 0x54, 0x83, 0x7b, 0x84, 0xdd, 0x60, 0x3b, 0x84, 0xe7, 0xed, 0x19, 0x43,
-	0x63, 0x71, 0x16, 0x7f, 0xa2, 0x19, 0x10, 0x67, 0x59, 0x9b, 0xe6, 0x6e, 0x40, 0xe3, 0x9f, 0x12,
-	0xaa, 0x92, 0x14, 0xc1, 0x68, 0x83, 0x97, 0xf2, 0x03, 0xb3, 0xc9, 0x5b, 0x4c, 0xac, 0x52, 0x5e,
-	0x6c, 0x95, 0x32, 0x05, 0x89, 0xde, 0xbc, 0x28, 0x4e, 0x3e, 0xd3, 0x99, 0x3c, 0xd4, 0x60, 0x86,
-	0xce, 0x96, 0xc1, 0x0c, 0x88, 0x6d, 0x70, 0xee, 0x68, 0x1c, 0x04, 0x8a, 0x48, 0xe1, 0x37, 0x40,
-	0xac, 0x75, 0x8d, 0xce, 0x28, 0x18, 0xe7, 0x35, 0xb3, 0x30, 0xe7, 0x0f, 0xa7, 0x19, 0xf9, 0x06,
-	0xb8, 0xc0, 0x9a, 0x83, 0xd2, 0x4c, 0x72, 0x06, 0xa6, 0xb0, 0xd4, 0x2b, 0x26, 0x15, 0x69, 0x80,
-	0x49, 0xe6, 0x95, 0xe5, 0xcd, 0x5e, 0x39, 0xe5, 0x84, 0xe5, 0x9e, 0x51, 0xa9, 0x54, 0x08, 0xc6,
-	0xdd, 0xd7, 0x5e, 0xd7, 0x99, 0x5c, 0x5c, 0x58, 0xc2, 0x62, 0x2f, 0x58, 0x4e, 0x31, 0xee, 0x5e,
-	0xcb, 0x16, 0xe3, 0xc2, 0xf2, 0x10, 0xe3, 0xd5, 0x48, 0xfe, 0x0b, 0xc6, 0x9c, 0xd7, 0x1f, 0xb3,
-	0x4c, 0x62, 0x07, 0x86, 0x90, 0xee, 0x86, 0x41, 0x59, 0xff, 0x07, 0x00, 0xc7, 0x45, 0x43, 0x8a,
-	0x49, 0xd7, 0x42, 0x10, 0xe6, 0xbb, 0x20, 0x50, 0xbe, 0x6f, 0x82, 0x29, 0xaf, 0x9b, 0x80, 0x45,
-	0x1f, 0xe5, 0x3a, 0xb0, 0x85, 0xdb, 0xfd, 0x60, 0x53, 0xf1, 0x8f, 0x40, 0xbc, 0x6d, 0xbb, 0xbe,
-	0xea, 0xc3, 0x85, 0xa0, 0x08, 0x37, 0xbb, 0xa2, 0x38, 0xb9, 0xb7, 0xad, 0xbb, 0x6c, 0xee, 0x4e,
-	0x14, 0x0f, 0xee, 0xcc, 0x85, 0x72, 0x1d, 0x44, 0xe9, 0xe2, 0x78, 0x85, 0x49, 0x66, 0x83, 0x85,
-	0x1b, 0xbe, 0x60, 0x67, 0x90, 0x1d, 0xbb, 0x1c, 0x3b, 0xc8, 0x2d, 0x04, 0x8f, 0x20, 0x77, 0xae,
-	0x58, 0xfc, 0xbb, 0x1c, 0x98, 0xf1, 0xdb, 0xaf, 0x96, 0xbc, 0xdb, 0x12, 0x9b, 0x42, 0xb8, 0xdb,
-	0x2f, 0x05, 0xd5, 0xe5, 0x29, 0x07, 0x52, 0xdd, 0x86, 0x3f, 0x76, 0x2e, 0x75, 0xa1, 0x12, 0xfe,
-	0x36, 0x08, 0x15, 0xd5, 0xeb, 0x7d, 0x0e, 0x5c, 0xf6, 0x1d, 0xc4, 0xd9, 0xdd, 0xcd, 0x8f, 0x44,
-	0xb8, 0xd7, 0x37, 0x89, 0xb3, 0x2e, 0xbd, 0xa6, 0xc4, 0x45, 0x5f, 0xdf, 0xbb, 0x3b, 0xd8, 0xed,
-	0x7e, 0xb0, 0x9d, 0x1f, 0x20, 0xd6, 0xe4, 0xe2, 0xd7, 0xaf, 0xda, 0x30, 0x3d, 0x3e, 0x40, 0x3e,
-	0x13, 0x84, 0x29, 0x92, 0x35, 0x3d, 0xb0, 0x45, 0x32, 0x30, 0x3d, 0x44, 0xfa, 0x7c, 0xc3, 0x85,
-	0xd0, 0x5b, 0xe6, 0x1a, 0x5a, 0xb8, 0x7f, 0xf4, 0x63, 0x72, 0xe4, 0xe8, 0x24, 0xc9, 0x3d, 0x3b,
-	0x49, 0x72, 0x3f, 0x9c, 0x24, 0xb9, 0x0f, 0x5e, 0x24, 0x47, 0x9e, 0xbd, 0x48, 0x8e, 0x3c, 0x7f,
-	0x91, 0x1c, 0xf9, 0xdf, 0x9c, 0x63, 0xc9, 0x5d, 0xd5, 0x51, 0xed, 0xa1, 0xfd, 0x13, 0xbc, 0x9c,
-	0xdd, 0x27, 0x3f, 0xc5, 0xe3, 0x45, 0x77, 0x2b, 0x8c, 0x7f, 0x5a, 0xff, 0xd3, 0xaf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa4, 0x88, 0x8b, 0xf7, 0x24, 0x20, 0x00, 0x00,
+	// 1884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0x5d,
+	0x15, 0xce, 0xc4, 0xef, 0x13, 0xf3, 0x37, 0xff, 0xd4, 0x8d, 0x9d, 0x49, 0x7f, 0x3b, 0x9d, 0xb6,
+	0x89, 0x13, 0x52, 0x3b, 0x31, 0xa5, 0xb4, 0x86, 0x4d, 0x9c, 0xf2, 0x48, 0x85, 0xa5, 0x68, 0xa2,
+	0x50, 0x81, 0x2a, 0x59, 0x13, 0xcf, 0xcd, 0x64, 0xa8, 0x3d, 0x63, 0x7c, 0xc7, 0x79, 0x2c, 0x90,
+	0x50, 0x85, 0x90, 0x40, 0x20, 0x75, 0xd3, 0x0d, 0xac, 0x91, 0x80, 0x0d, 0x59, 0xb0, 0x63, 0x8b,
+	0x50, 0x85, 0x58, 0x54, 0x88, 0x45, 0x57, 0x01, 0xd2, 0x45, 0x56, 0x6c, 0xba, 0x64, 0x81, 0xd0,
+	0xcc, 0x9d, 0xb9, 0x1e, 0x8f, 0xaf, 0xdf, 0x51, 0xcb, 0xe2, 0xdf, 0x38, 0x9e, 0x7b, 0xbe, 0x73,
+	0xee, 0x79, 0xcf, 0xb9, 0x37, 0x86, 0xf9, 0xaa, 0x81, 0xeb, 0xc7, 0x32, 0xae, 0xe7, 0xed, 0x8f,
+	0xa3, 0x8d, 0xbc, 0x79, 0x92, 0x6b, 0x34, 0x0d, 0xd3, 0xe0, 0x67, 0x5d, 0x52, 0xce, 0xfe, 0x38,
+	0xda, 0x10, 0xd2, 0xd6, 0x8a, 0x81, 0xf3, 0xfb, 0x32, 0x46, 0xf9, 0xa3, 0x8d, 0x7d, 0x64, 0xca,
+	0x1b, 0xf9, 0xaa, 0xa1, 0xe9, 0x84, 0x43, 0x48, 0x3a, 0xf4, 0x3a, 0x56, 0x2d, 0x49, 0x75, 0xac,
+	0x3a, 0x84, 0x84, 0x6a, 0xa8, 0x86, 0xfd, 0x35, 0x6f, 0x7d, 0x73, 0x56, 0x6f, 0x76, 0xef, 0x7d,
+	0xda, 0x40, 0xd8, 0xa1, 0xce, 0x13, 0x61, 0x15, 0xc2, 0x46, 0x1e, 0x1c, 0xd2, 0xa7, 0x72, 0x5d,
+	0xd3, 0x8d, 0xbc, 0xfd, 0x49, 0x96, 0xc4, 0xff, 0x72, 0x10, 0x2f, 0x63, 0x75, 0xd7, 0x34, 0x9a,
+	0x68, 0xcb, 0x50, 0x10, 0xbf, 0x0e, 0x61, 0x8c, 0x74, 0x05, 0x35, 0x53, 0xdc, 0x22, 0x97, 0x8d,
+	0x95, 0x52, 0x7f, 0xfb, 0xc3, 0xbd, 0x84, 0x23, 0x65, 0x53, 0x51, 0x9a, 0x08, 0xe3, 0x5d, 0xb3,
+	0xa9, 0xe9, 0xaa, 0xe4, 0xe0, 0xf8, 0x07, 0xf0, 0x89, 0xa5, 0x47, 0x65, 0xff, 0xd4, 0x44, 0x95,
+	0xaa, 0xa1, 0xa0, 0xd4, 0xf4, 0x22, 0x97, 0x8d, 0x97, 0x66, 0x2f, 0xce, 0x33, 0xf1, 0xa7, 0x9b,
+	0xbb, 0xe5, 0xd2, 0xa9, 0x69, 0xcb, 0x96, 0xe2, 0x16, 0xce, 0x7d, 0xe2, 0xf7, 0x60, 0x4e, 0xd3,
+	0xb1, 0x29, 0xeb, 0xa6, 0x26, 0x9b, 0xa8, 0xd2, 0x40, 0xcd, 0xba, 0x86, 0xb1, 0x66, 0xe8, 0xa9,
+	0xd0, 0x22, 0x97, 0x9d, 0x29, 0xa4, 0x73, 0x7e, 0x47, 0xe6, 0x36, 0xab, 0x55, 0x84, 0xf1, 0x96,
+	0xa1, 0x1f, 0x68, 0xaa, 0x74, 0xc3, 0xc3, 0xbd, 0x43, 0x99, 0x8b, 0xb7, 0x5e, 0x5c, 0x9e, 0xad,
+	0x3a, 0xba, 0xfd, 0xec, 0xf2, 0x6c, 0xf5, 0x53, 0xdb, 0x49, 0x5e, 0x1b, 0x9f, 0x04, 0xa3, 0x81,
+	0xd9, 0xe0, 0x93, 0x60, 0x34, 0x38, 0x1b, 0x12, 0x9f, 0x42, 0xc2, 0x4b, 0x93, 0x10, 0x6e, 0x18,
+	0x3a, 0x46, 0xfc, 0x6d, 0x88, 0x58, 0xb6, 0x54, 0x34, 0xc5, 0x76, 0x44, 0xb0, 0x04, 0x17, 0xe7,
+	0x99, 0xb0, 0x05, 0xd9, 0x7e, 0x2c, 0x85, 0x2d, 0xd2, 0xb6, 0xc2, 0x0b, 0x10, 0xad, 0x1e, 0xa2,
+	0xea, 0x73, 0xdc, 0xaa, 0x13, 0xa3, 0x25, 0xfa, 0x2c, 0xbe, 0x0a, 0xc0, 0x5c, 0x19, 0xab, 0xdb,
+	0x6d, 0x25, 0xb7, 0x0c, 0xdd, 0x6c, 0xca, 0x55, 0x73, 0x0c, 0x1f, 0xe7, 0x20, 0x24, 0x2b, 0x75,
+	0x4d, 0x4f, 0x4d, 0x0f, 0x60, 0x20, 0x30, 0xaf, 0xf6, 0x81, 0x9e, 0xda, 0x27, 0x20, 0x54, 0x93,
+	0xf7, 0x51, 0x2d, 0x15, 0xb4, 0x84, 0x4a, 0xe4, 0x81, 0x7f, 0x08, 0x81, 0x3a, 0x56, 0xed, 0x18,
+	0xc4, 0x4b, 0x4b, 0xff, 0x39, 0xcf, 0xf0, 0x92, 0x7c, 0xec, 0xaa, 0x5e, 0x46, 0x18, 0xcb, 0x2a,
+	0xfa, 0xe5, 0xe5, 0xd9, 0xea, 0x8c, 0xa6, 0xd7, 0x34, 0x1d, 0x55, 0xbe, 0x8f, 0x0d, 0x5d, 0xb2,
+	0x58, 0xf8, 0x63, 0x08, 0x1d, 0xb4, 0x74, 0x05, 0xa7, 0xc2, 0x8b, 0x81, 0xec, 0x4c, 0x61, 0x3e,
+	0xe7, 0x68, 0x68, 0xa5, 0x7d, 0xce, 0x49, 0xfb, 0xdc, 0x96, 0xa1, 0xe9, 0xa5, 0x6f, 0xbc, 0x3e,
+	0xcf, 0x4c, 0xfd, 0xee, 0x1f, 0x99, 0xac, 0xaa, 0x99, 0x87, 0xad, 0xfd, 0x5c, 0xd5, 0xa8, 0x3b,
+	0x99, 0xea, 0xfc, 0xb9, 0x87, 0x95, 0xe7, 0x4e, 0x56, 0x5b, 0x0c, 0xd8, 0xda, 0x30, 0x5e, 0x43,
+	0xaa, 0x5c, 0x3d, 0xad, 0x58, 0x85, 0x83, 0x7f, 0x73, 0x79, 0xb6, 0xca, 0x49, 0x64, 0xbf, 0xe2,
+	0x17, 0x7d, 0x21, 0x5f, 0x70, 0x43, 0xce, 0x70, 0xbe, 0x78, 0x08, 0x69, 0x36, 0x85, 0x86, 0xbe,
+	0x00, 0x11, 0x99, 0x38, 0x75, 0x60, 0x7c, 0x5c, 0x20, 0xcf, 0x43, 0x50, 0x91, 0x4d, 0xd9, 0xc9,
+	0x02, 0xfb, 0xbb, 0xf8, 0xa7, 0x00, 0x24, 0xd9, 0x5b, 0x15, 0x3e, 0x4f, 0x81, 0xab, 0x4d, 0x01,
+	0xcb, 0xff, 0x58, 0xae, 0x99, 0xa9, 0x08, 0xf1, 0xbf, 0xf5, 0x9d, 0x4f, 0x42, 0xe4, 0x40, 0x3b,
+	0xa9, 0x58, 0xa6, 0x44, 0x17, 0xb9, 0x6c, 0x54, 0x0a, 0x1f, 0x68, 0x27, 0x65, 0xac, 0x16, 0xd7,
+	0x7c, 0xf9, 0x72, 0xb3, 0x4f, 0xbe, 0x14, 0x44, 0x0d, 0x32, 0x3d, 0x48, 0x57, 0x9e, 0x31, 0x6f,
+	0xa7, 0x81, 0x2f, 0x63, 0xf5, 0xeb, 0x27, 0xa8, 0xda, 0x9a, 0xa8, 0x5f, 0xdc, 0x87, 0x68, 0xd5,
+	0xe1, 0x1e, 0x98, 0x2f, 0x14, 0xe9, 0xc6, 0x3d, 0x30, 0x41, 0xdc, 0x43, 0x1f, 0xb8, 0xf4, 0x97,
+	0x7d, 0xa1, 0x4c, 0xba, 0xa1, 0xf4, 0xf9, 0x50, 0x5c, 0x07, 0xa1, 0x7b, 0x95, 0x06, 0xd0, 0x0d,
+	0x06, 0xe7, 0x09, 0xc6, 0x8f, 0x49, 0x30, 0xca, 0x9a, 0xda, 0x94, 0x3f, 0x42, 0x30, 0x86, 0xaa,
+	0x5f, 0x27, 0x62, 0xc1, 0x91, 0x23, 0xd6, 0xdb, 0x71, 0x3e, 0x7b, 0x1d, 0xc7, 0xf9, 0x56, 0xfb,
+	0x3a, 0xee, 0xef, 0x1c, 0x7c, 0x52, 0xc6, 0xea, 0x5e, 0x43, 0x91, 0x4d, 0xb4, 0x69, 0x37, 0xa3,
+	0xd1, 0x9d, 0xf6, 0x65, 0x88, 0xe9, 0xe8, 0xb8, 0x32, 0x5c, 0xcb, 0x8b, 0xea, 0xe8, 0x98, 0x6c,
+	0xe4, 0xf5, 0x75, 0x60, 0x58, 0x5f, 0x17, 0x6f, 0xfb, 0x9c, 0x71, 0xdd, 0x75, 0x86, 0xc7, 0x06,
+	0x31, 0x05, 0x73, 0x9d, 0x2b, 0xae, 0x13, 0xc4, 0x5f, 0x71, 0xf0, 0x85, 0x32, 0x56, 0xb7, 0x6a,
+	0x48, 0x6e, 0x8e, 0x6b, 0xef, 0x78, 0x8a, 0x8b, 0x3e, 0xc5, 0x79, 0x57, 0xf1, 0xb6, 0x2e, 0x62,
+	0x12, 0x6e, 0x74, 0x2c, 0x50, 0xb5, 0x5f, 0x4c, 0x83, 0x40, 0x2d, 0xea, 0xec, 0x6f, 0x07, 0x9a,
+	0x3a, 0x86, 0x0d, 0x9e, 0x94, 0x9d, 0xee, 0x99, 0xb2, 0xcf, 0x40, 0xb0, 0x02, 0xdb, 0x63, 0xf4,
+	0x0b, 0x0c, 0x35, 0xfa, 0xa5, 0x74, 0x74, 0xbc, 0xcd, 0x9c, 0xfe, 0xf2, 0x3e, 0x87, 0x64, 0x3a,
+	0x23, 0xd9, 0x65, 0xa5, 0x78, 0x07, 0xc4, 0xde, 0x54, 0xea, 0xaa, 0xdf, 0x73, 0x70, 0x8d, 0xc2,
+	0x76, 0xe4, 0xa6, 0x5c, 0xc7, 0xfc, 0x03, 0x88, 0xc9, 0x2d, 0xf3, 0xd0, 0x68, 0x6a, 0xe6, 0xe9,
+	0x40, 0x17, 0xb5, 0xa1, 0xfc, 0x57, 0x21, 0xdc, 0xb0, 0x25, 0xd8, 0x4e, 0x9a, 0x29, 0xa4, 0xba,
+	0x8d, 0x25, 0x3b, 0x94, 0x62, 0x56, 0xaf, 0x24, 0xed, 0xce, 0x61, 0x21, 0x65, 0xdb, 0x16, 0x66,
+	0x99, 0x98, 0xe8, 0x34, 0x91, 0xf0, 0x8a, 0xf3, 0x90, 0xf4, 0x2d, 0x51, 0x63, 0x2e, 0x88, 0x31,
+	0xbb, 0x2d, 0xc5, 0xa0, 0x5d, 0x6d, 0x5c, 0x63, 0x3e, 0xf0, 0x8b, 0xa6, 0xaf, 0xfd, 0x5e, 0x83,
+	0xc4, 0x7b, 0x90, 0xf4, 0x2d, 0xf5, 0xed, 0x59, 0xbf, 0xe6, 0x60, 0xa6, 0x8c, 0xd5, 0x1d, 0x4d,
+	0xb7, 0xd2, 0x75, 0xfc, 0xe0, 0x3e, 0x82, 0xa8, 0x53, 0x02, 0x56, 0x78, 0x03, 0xd9, 0x60, 0x29,
+	0x7d, 0x71, 0x9e, 0x89, 0x90, 0x1a, 0xc0, 0xef, 0xcf, 0x33, 0xd7, 0x4e, 0xe5, 0x7a, 0xad, 0x28,
+	0xba, 0x20, 0x51, 0x8a, 0x90, 0xba, 0xc0, 0xa4, 0x09, 0x75, 0x9a, 0x36, 0xeb, 0x9a, 0xe6, 0xea,
+	0x25, 0xde, 0x80, 0xeb, 0x9e, 0x47, 0x1a, 0xd2, 0xdf, 0x92, 0x0e, 0xb4, 0xa7, 0x37, 0x3e, 0xa2,
+	0x01, 0x77, 0xbb, 0x0d, 0xa0, 0xfd, 0xa8, 0xad, 0x99, 0xd3, 0x8f, 0xda, 0x0b, 0xd4, 0x88, 0x9f,
+	0x84, 0x20, 0xed, 0x9e, 0xc5, 0x36, 0x75, 0x85, 0x75, 0x72, 0x1a, 0xd7, 0xaa, 0xee, 0x33, 0x6a,
+	0x60, 0xc2, 0x33, 0x6a, 0x70, 0x82, 0x33, 0x2a, 0xff, 0x19, 0x40, 0xcb, 0xb2, 0x9f, 0xa8, 0x12,
+	0xb2, 0x87, 0xd3, 0x58, 0xcb, 0xf5, 0x48, 0x7b, 0xd4, 0x0f, 0x0f, 0x37, 0xea, 0xd3, 0x29, 0x3e,
+	0xc2, 0x98, 0xe2, 0xa3, 0x13, 0x4c, 0x73, 0xb1, 0x0f, 0x3c, 0xc5, 0xcf, 0x41, 0x18, 0x1b, 0xad,
+	0x66, 0x15, 0xa5, 0xc0, 0xb6, 0xc4, 0x79, 0xe2, 0x53, 0x10, 0xd9, 0x6f, 0x69, 0x35, 0xeb, 0x5d,
+	0x34, 0x63, 0x13, 0xdc, 0x47, 0x7e, 0x01, 0x62, 0x76, 0x26, 0x1e, 0xca, 0xf8, 0x30, 0x15, 0x77,
+	0x8e, 0xe0, 0x86, 0x82, 0xbe, 0x25, 0xe3, 0xc3, 0xe2, 0x83, 0xee, 0x84, 0xbc, 0xdd, 0x71, 0x1b,
+	0xc0, 0xce, 0x32, 0xb1, 0x01, 0x4b, 0xfd, 0x11, 0x57, 0x3e, 0xf8, 0xff, 0x99, 0xb3, 0x0f, 0x19,
+	0x9b, 0x8a, 0x62, 0x25, 0xc0, 0x5e, 0xa3, 0x66, 0xc8, 0x0a, 0xe9, 0xda, 0x8e, 0x90, 0x09, 0x2a,
+	0xba, 0x00, 0x31, 0xd9, 0x15, 0x62, 0x97, 0x74, 0xac, 0x94, 0x78, 0x7f, 0x9e, 0x99, 0x25, 0x75,
+	0x4c, 0x49, 0xa2, 0xd4, 0x86, 0x15, 0xbf, 0xd2, 0xed, 0xb9, 0x3b, 0xae, 0xe7, 0xfa, 0x29, 0x29,
+	0xae, 0xc0, 0xf2, 0x00, 0x08, 0x2d, 0xf7, 0xbf, 0x72, 0xf6, 0xab, 0x57, 0x42, 0x75, 0xe3, 0x08,
+	0xfd, 0x7f, 0x98, 0x5d, 0xec, 0x36, 0x7b, 0xd9, 0x35, 0x7b, 0x80, 0x9e, 0xe2, 0x1a, 0xac, 0x0e,
+	0x46, 0x51, 0xe3, 0xff, 0x4d, 0x66, 0x2f, 0x37, 0xc7, 0xfc, 0x87, 0x8c, 0xab, 0xeb, 0x73, 0x93,
+	0xde, 0xc5, 0x05, 0x26, 0xe9, 0x73, 0x82, 0x67, 0x3a, 0x20, 0x37, 0x0c, 0x5d, 0x33, 0xc0, 0xe8,
+	0x97, 0x0c, 0xc5, 0x42, 0x77, 0x94, 0x32, 0xfe, 0xb2, 0xf6, 0x9f, 0x62, 0x4e, 0x41, 0xec, 0x4d,
+	0xbd, 0xb2, 0x4b, 0x3f, 0x5a, 0xdb, 0x01, 0x4f, 0x6d, 0xff, 0x85, 0xf3, 0x1c, 0x1c, 0xdc, 0x2d,
+	0xbf, 0x6d, 0xb7, 0xe8, 0xd1, 0x47, 0xec, 0x05, 0x72, 0x2c, 0x22, 0xed, 0x7e, 0x9a, 0xb8, 0x54,
+	0x47, 0xc7, 0x44, 0xdc, 0x78, 0x67, 0x88, 0x9e, 0xb7, 0x67, 0x0c, 0x8d, 0xc5, 0x45, 0x48, 0xb3,
+	0x29, 0x34, 0xb3, 0x5f, 0x4e, 0xdb, 0xe6, 0xee, 0x22, 0xf3, 0x9b, 0x32, 0xae, 0x91, 0x14, 0xb1,
+	0x61, 0xe3, 0x97, 0xf2, 0x13, 0xab, 0xc9, 0x3b, 0x42, 0x9c, 0x52, 0x5e, 0x6b, 0x97, 0x32, 0x25,
+	0x89, 0xbd, 0x65, 0x51, 0x0c, 0x5f, 0x82, 0x70, 0xd5, 0xce, 0x59, 0x27, 0xb3, 0x33, 0xdd, 0x99,
+	0xdd, 0xd6, 0xfb, 0x40, 0x53, 0x3b, 0x86, 0x70, 0xc2, 0x59, 0xcc, 0x75, 0x27, 0x20, 0x75, 0x1a,
+	0xc3, 0x6e, 0xc7, 0x69, 0x0c, 0x0a, 0x75, 0xda, 0x2f, 0x48, 0x8e, 0x48, 0x48, 0xd5, 0xb0, 0x89,
+	0x9a, 0x25, 0x59, 0x7f, 0xbe, 0x63, 0x68, 0xba, 0x89, 0x9a, 0x63, 0xe4, 0x48, 0x02, 0x42, 0x0a,
+	0xd2, 0x8d, 0xba, 0x93, 0x1f, 0xe4, 0xa1, 0x77, 0x98, 0x19, 0x9b, 0x8a, 0x45, 0x48, 0xb3, 0x29,
+	0xb4, 0x54, 0x52, 0x10, 0x69, 0x90, 0x25, 0xa2, 0x97, 0xe4, 0x3e, 0x16, 0xfe, 0x38, 0x0b, 0x81,
+	0x32, 0x56, 0xf9, 0x5d, 0x88, 0xb5, 0xff, 0xad, 0xc0, 0x68, 0x20, 0xde, 0x6b, 0x77, 0x61, 0xa9,
+	0x3f, 0x9d, 0x6e, 0xfb, 0x03, 0xb8, 0xce, 0x9a, 0x0b, 0xb3, 0x4c, 0x76, 0x06, 0x52, 0x58, 0x1f,
+	0x16, 0x49, 0xb7, 0x34, 0x21, 0xc1, 0xbc, 0xc2, 0x5d, 0x19, 0x56, 0x52, 0x41, 0xd8, 0x18, 0x1a,
+	0x4a, 0x77, 0x45, 0x70, 0xcd, 0x7f, 0x0d, 0x78, 0x87, 0x29, 0xc5, 0x87, 0x12, 0xd6, 0x86, 0x41,
+	0x79, 0xb7, 0xf1, 0xbf, 0x7b, 0xd8, 0xdb, 0xf8, 0x50, 0xc2, 0xda, 0x30, 0x28, 0xba, 0xcd, 0x77,
+	0x61, 0xc6, 0x7b, 0x1d, 0xb4, 0xc8, 0x64, 0xf6, 0x20, 0x84, 0xec, 0x20, 0x04, 0x15, 0xfd, 0x1d,
+	0x00, 0xcf, 0xc5, 0x4b, 0x86, 0xc9, 0xd7, 0x06, 0x08, 0xcb, 0x03, 0x00, 0x54, 0xee, 0x0f, 0x21,
+	0xd9, 0xeb, 0x66, 0x64, 0xad, 0x8f, 0x72, 0x5d, 0x68, 0xe1, 0xfe, 0x28, 0x68, 0xba, 0xfd, 0x33,
+	0x88, 0x77, 0xdc, 0x36, 0xdc, 0xea, 0x23, 0x85, 0x40, 0x84, 0x95, 0x81, 0x10, 0xaf, 0xf4, 0x8e,
+	0xe3, 0x3f, 0x5b, 0xba, 0x17, 0x22, 0xac, 0x0c, 0x84, 0x50, 0xe9, 0x3b, 0x10, 0xa5, 0x07, 0xe9,
+	0xcf, 0x98, 0x6c, 0x2e, 0x59, 0xb8, 0xdb, 0x97, 0xec, 0x0d, 0xb2, 0xe7, 0x6c, 0xcb, 0x0e, 0x72,
+	0x1b, 0x20, 0x2c, 0x0f, 0x00, 0x50, 0xb9, 0x3f, 0xe5, 0x60, 0xa1, 0xdf, 0x79, 0x73, 0xbd, 0x77,
+	0x5b, 0x62, 0x73, 0x08, 0x0f, 0x47, 0xe5, 0xa0, 0xba, 0xbc, 0xe2, 0x20, 0x33, 0x68, 0x18, 0x66,
+	0xe7, 0xd2, 0x00, 0x2e, 0xe1, 0x6b, 0xe3, 0x70, 0x51, 0xbd, 0x7e, 0xce, 0xc1, 0xcd, 0xbe, 0x07,
+	0x13, 0x76, 0x77, 0xeb, 0xc7, 0x22, 0x3c, 0x1a, 0x99, 0xc5, 0x5b, 0x97, 0xbd, 0xa6, 0xe6, 0xb5,
+	0xbe, 0xbe, 0xf7, 0x77, 0xb0, 0xfb, 0xa3, 0xa0, 0xbd, 0x2f, 0x20, 0xd6, 0x24, 0xd7, 0xaf, 0x5f,
+	0x75, 0x20, 0x85, 0xf5, 0x61, 0x91, 0xde, 0x2d, 0x59, 0xd3, 0x14, 0x7b, 0x4b, 0x06, 0x52, 0x58,
+	0x1f, 0x16, 0xe9, 0xdd, 0x92, 0x35, 0x8b, 0x64, 0x7b, 0x24, 0x52, 0x17, 0x52, 0x58, 0x1f, 0x16,
+	0xe9, 0x6e, 0x29, 0x84, 0x7e, 0x64, 0xcd, 0x58, 0xa5, 0xc7, 0xaf, 0xff, 0x95, 0x9e, 0x7a, 0x7d,
+	0x91, 0xe6, 0xde, 0x5c, 0xa4, 0xb9, 0x7f, 0x5e, 0xa4, 0xb9, 0x97, 0xef, 0xd2, 0x53, 0x6f, 0xde,
+	0xa5, 0xa7, 0xde, 0xbe, 0x4b, 0x4f, 0x7d, 0x6f, 0xc9, 0x73, 0xcf, 0xb0, 0x65, 0xe0, 0xfa, 0x53,
+	0xf7, 0x57, 0x10, 0x4a, 0xfe, 0xc4, 0xfe, 0x4b, 0xee, 0x1a, 0xf6, 0xc3, 0xf6, 0xaf, 0x1b, 0xbe,
+	0xf4, 0xbf, 0x01, 0x00, 0x57, 0x4b, 0x61, 0xb7, 0xa7, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1812,6 +1902,8 @@ type MsgClient interface {
 	UpdateContractLabel(ctx context.Context, in *MsgUpdateContractLabel, opts ...grpc.CallOption) (*MsgUpdateContractLabelResponse, error)
 	// SetGaslessContract set contracts are gasless
 	SetGaslessContracts(ctx context.Context, in *MsgSetGaslessContracts, opts ...grpc.CallOption) (*MsgSetGaslessContractsResponse, error)
+	// RegisterBankPointer registers the ERC20 pointer contract of a bank denom
+	RegisterBankPointer(ctx context.Context, in *MsgRegisterBankPointer, opts ...grpc.CallOption) (*MsgRegisterBankPointerResponse, error)
 }
 
 type msgClient struct {
@@ -1984,6 +2076,15 @@ func (c *msgClient) SetGaslessContracts(ctx context.Context, in *MsgSetGaslessCo
 	return out, nil
 }
 
+func (c *msgClient) RegisterBankPointer(ctx context.Context, in *MsgRegisterBankPointer, opts ...grpc.CallOption) (*MsgRegisterBankPointerResponse, error) {
+	out := new(MsgRegisterBankPointerResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.Msg/RegisterBankPointer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
@@ -2048,6 +2149,8 @@ type MsgServer interface {
 	UpdateContractLabel(context.Context, *MsgUpdateContractLabel) (*MsgUpdateContractLabelResponse, error)
 	// SetGaslessContract set contracts are gasless
 	SetGaslessContracts(context.Context, *MsgSetGaslessContracts) (*MsgSetGaslessContractsResponse, error)
+	// RegisterBankPointer registers the ERC20 pointer contract of a bank denom
+	RegisterBankPointer(context.Context, *MsgRegisterBankPointer) (*MsgRegisterBankPointerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2108,6 +2211,9 @@ func (*UnimplementedMsgServer) UpdateContractLabel(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SetGaslessContracts(ctx context.Context, req *MsgSetGaslessContracts) (*MsgSetGaslessContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetGaslessContracts not implemented")
 }
+func (*UnimplementedMsgServer) RegisterBankPointer(ctx context.Context, req *MsgRegisterBankPointer) (*MsgRegisterBankPointerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBankPointer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2437,6 +2543,24 @@ func _Msg_SetGaslessContracts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterBankPointer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBankPointer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBankPointer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1.Msg/RegisterBankPointer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBankPointer(ctx, req.(*MsgRegisterBankPointer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2513,6 +2637,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetGaslessContracts",
 			Handler:    _Msg_SetGaslessContracts_Handler,
 		},
+		{
+			MethodName: "RegisterBankPointer",
+			Handler:    _Msg_RegisterBankPointer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmwasm/wasm/v1/tx.proto",
@@ -3944,6 +4072,16 @@ func (m *MsgSetGaslessContracts) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Contracts[iNdEx])
@@ -3986,6 +4124,73 @@ func (m *MsgSetGaslessContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterBankPointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBankPointer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBankPointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBankPointerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBankPointerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBankPointerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pointer) > 0 {
+		i -= len(m.Pointer)
+		copy(dAtA[i:], m.Pointer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pointer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -4643,6 +4848,8 @@ func (m *MsgSetGaslessContracts) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -4655,6 +4862,36 @@ func (m *MsgSetGaslessContractsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterBankPointer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterBankPointerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pointer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -9035,6 +9272,39 @@ func (m *MsgSetGaslessContracts) Unmarshal(dAtA []byte) error {
 			}
 			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9106,6 +9376,202 @@ func (m *MsgSetGaslessContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterBankPointer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBankPointer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBankPointer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBankPointerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBankPointerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBankPointerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,10 +5,12 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -177,6 +179,11 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// PrecompileGas defines the gas charged by the EVM precompiles
+	PrecompileGas PrecompileGasSchedule `protobuf:"bytes,3,opt,name=precompile_gas,json=precompileGas,proto3" json:"precompile_gas" yaml:"precompile_gas"`
+	// GaslessTxLimits bounds the transactions that execute gasless contracts
+	// without fees
+	GaslessTxLimits GaslessTxLimits `protobuf:"bytes,4,opt,name=gasless_tx_limits,json=gaslessTxLimits,proto3" json:"gasless_tx_limits" yaml:"gasless_tx_limits"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -211,6 +218,183 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// GaslessTxLimits bounds the transactions that execute gasless contracts
+// without fees
+type GaslessTxLimits struct {
+	// MaxGas is the max gas limit of a gasless transaction. Transactions without
+	// fees are not accepted when 0.
+	MaxGas uint64 `protobuf:"varint,1,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+	// MaxTxsPerAccount is the max number of gasless transactions of a fee payer
+	// within a window
+	MaxTxsPerAccount uint32 `protobuf:"varint,2,opt,name=max_txs_per_account,json=maxTxsPerAccount,proto3" json:"max_txs_per_account,omitempty" yaml:"max_txs_per_account"`
+	// WindowBlocks is the number of blocks of a rate limit window
+	WindowBlocks int64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+}
+
+func (m *GaslessTxLimits) Reset()         { *m = GaslessTxLimits{} }
+func (m *GaslessTxLimits) String() string { return proto.CompactTextString(m) }
+func (*GaslessTxLimits) ProtoMessage()    {}
+func (*GaslessTxLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+func (m *GaslessTxLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessTxLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessTxLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessTxLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessTxLimits.Merge(m, src)
+}
+func (m *GaslessTxLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessTxLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessTxLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessTxLimits proto.InternalMessageInfo
+
+// PrecompileGasSchedule defines the gas charged by the EVM precompiles for
+// their calls, on top of the intrinsic EVM gas
+type PrecompileGasSchedule struct {
+	// Default is the cost of the precompile methods without a cost in Methods.
+	// When not set, the precompiles charge the SDK gas consumed only.
+	Default *PrecompileGasCost `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty" yaml:"default"`
+	// Methods defines the costs of single precompiles or precompile methods
+	Methods []PrecompileMethodGasCost `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods" yaml:"methods"`
+}
+
+func (m *PrecompileGasSchedule) Reset()         { *m = PrecompileGasSchedule{} }
+func (m *PrecompileGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGasSchedule) ProtoMessage()    {}
+func (*PrecompileGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{4}
+}
+func (m *PrecompileGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGasSchedule.Merge(m, src)
+}
+func (m *PrecompileGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGasSchedule proto.InternalMessageInfo
+
+// PrecompileGasCost defines the EVM gas charged for a precompile call as base
+// + per byte of input + SDK gas consumed times the multiplier
+type PrecompileGasCost struct {
+	// BaseGas is charged once per call
+	BaseGas uint64 `protobuf:"varint,1,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty" yaml:"base_gas"`
+	// PerByteGas is charged per byte of the call input, including the selector
+	PerByteGas uint64 `protobuf:"varint,2,opt,name=per_byte_gas,json=perByteGas,proto3" json:"per_byte_gas,omitempty" yaml:"per_byte_gas"`
+	// SDKGasMultiplier converts the SDK gas consumed by the call into EVM gas
+	SDKGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=sdk_gas_multiplier,json=sdkGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"sdk_gas_multiplier" yaml:"sdk_gas_multiplier"`
+}
+
+func (m *PrecompileGasCost) Reset()         { *m = PrecompileGasCost{} }
+func (m *PrecompileGasCost) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGasCost) ProtoMessage()    {}
+func (*PrecompileGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
+}
+func (m *PrecompileGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGasCost.Merge(m, src)
+}
+func (m *PrecompileGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGasCost proto.InternalMessageInfo
+
+// PrecompileMethodGasCost defines the gas cost of a precompile method
+type PrecompileMethodGasCost struct {
+	// Address is the hex address of the precompile
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Method is the ABI name of the method. When empty, the cost applies to all
+	// methods of the precompile without their own cost.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Cost is the gas cost of the method
+	Cost PrecompileGasCost `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost"`
+}
+
+func (m *PrecompileMethodGasCost) Reset()         { *m = PrecompileMethodGasCost{} }
+func (m *PrecompileMethodGasCost) String() string { return proto.CompactTextString(m) }
+func (*PrecompileMethodGasCost) ProtoMessage()    {}
+func (*PrecompileMethodGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{6}
+}
+func (m *PrecompileMethodGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileMethodGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileMethodGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileMethodGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileMethodGasCost.Merge(m, src)
+}
+func (m *PrecompileMethodGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileMethodGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileMethodGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileMethodGasCost proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -225,7 +409,7 @@ func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{7}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +460,7 @@ func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +503,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{9}
 }
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +546,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +587,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 func (m *Model) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,98 +616,382 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// GaslessConfig is the gas budget of a gasless contract
+type GaslessConfig struct {
+	// MaxGasPerBlock is the total gas that gasless executions of the contract
+	// can consume within a block. Zero means unlimited.
+	MaxGasPerBlock uint64 `protobuf:"varint,1,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty"`
+	// MaxGasPerTx is the total gas that gasless executions of the contract can
+	// consume within a transaction. Zero means unlimited.
+	MaxGasPerTx uint64 `protobuf:"varint,2,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// Sponsor is an optional account that is debited for the consumed gas
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// SponsorGasPrice is the price per gas unit charged to the sponsor
+	SponsorGasPrice types1.DecCoin `protobuf:"bytes,4,opt,name=sponsor_gas_price,json=sponsorGasPrice,proto3" json:"sponsor_gas_price"`
+	// Policy optionally restricts the executions that are gasless
+	Policy *GaslessPolicy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *GaslessConfig) Reset()         { *m = GaslessConfig{} }
+func (m *GaslessConfig) String() string { return proto.CompactTextString(m) }
+func (*GaslessConfig) ProtoMessage()    {}
+func (*GaslessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{12}
+}
+func (m *GaslessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessConfig.Merge(m, src)
+}
+func (m *GaslessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessConfig proto.InternalMessageInfo
+
+// GaslessPolicy restricts gasless executions to the accepted callers and
+// messages. Executions rejected by the policy pay for their gas.
+type GaslessPolicy struct {
+	// Filter defines the contract messages that are gasless. When not set, all
+	// messages are accepted.
+	Filter *types.Any `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// AllowedCallers are the addresses that can execute gasless. When empty,
+	// all callers are accepted.
+	AllowedCallers []string `protobuf:"bytes,2,rep,name=allowed_callers,json=allowedCallers,proto3" json:"allowed_callers,omitempty"`
+}
+
+func (m *GaslessPolicy) Reset()         { *m = GaslessPolicy{} }
+func (m *GaslessPolicy) String() string { return proto.CompactTextString(m) }
+func (*GaslessPolicy) ProtoMessage()    {}
+func (*GaslessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+func (m *GaslessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessPolicy.Merge(m, src)
+}
+func (m *GaslessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessPolicy proto.InternalMessageInfo
+
+// GaslessUsage tracks the gas consumed by gasless executions of a contract
+type GaslessUsage struct {
+	// Height is the block height the usage was recorded at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BlockGasUsed is the gas consumed within the block
+	BlockGasUsed uint64 `protobuf:"varint,2,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+	// TxIndex is the position of the transaction within the block
+	TxIndex uint32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// TxGasUsed is the gas consumed within the transaction
+	TxGasUsed uint64 `protobuf:"varint,4,opt,name=tx_gas_used,json=txGasUsed,proto3" json:"tx_gas_used,omitempty"`
+}
+
+func (m *GaslessUsage) Reset()         { *m = GaslessUsage{} }
+func (m *GaslessUsage) String() string { return proto.CompactTextString(m) }
+func (*GaslessUsage) ProtoMessage()    {}
+func (*GaslessUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
+}
+func (m *GaslessUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessUsage.Merge(m, src)
+}
+func (m *GaslessUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessUsage proto.InternalMessageInfo
+
+// BankAllowance is the amount of a bank denom that the spender can transfer
+// from the owner account through the bank precompile
+type BankAllowance struct {
+	// Owner is the account the funds are transferred from
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Spender is the account allowed to transfer the funds
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// Denom is the bank denom of the allowance
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Amount is the amount left to transfer
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *BankAllowance) Reset()         { *m = BankAllowance{} }
+func (m *BankAllowance) String() string { return proto.CompactTextString(m) }
+func (*BankAllowance) ProtoMessage()    {}
+func (*BankAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{15}
+}
+func (m *BankAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BankAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BankAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BankAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BankAllowance.Merge(m, src)
+}
+func (m *BankAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BankAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BankAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BankAllowance proto.InternalMessageInfo
+
+// BankPointer is the ERC20 pointer contract registered for a bank denom
+type BankPointer struct {
+	// Denom is the bank denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Address is the hex encoded address of the pointer contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *BankPointer) Reset()         { *m = BankPointer{} }
+func (m *BankPointer) String() string { return proto.CompactTextString(m) }
+func (*BankPointer) ProtoMessage()    {}
+func (*BankPointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{16}
+}
+func (m *BankPointer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BankPointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BankPointer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BankPointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BankPointer.Merge(m, src)
+}
+func (m *BankPointer) XXX_Size() int {
+	return m.Size()
+}
+func (m *BankPointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BankPointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BankPointer proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*GaslessTxLimits)(nil), "cosmwasm.wasm.v1.GaslessTxLimits")
+	proto.RegisterType((*PrecompileGasSchedule)(nil), "cosmwasm.wasm.v1.PrecompileGasSchedule")
+	proto.RegisterType((*PrecompileGasCost)(nil), "cosmwasm.wasm.v1.PrecompileGasCost")
+	proto.RegisterType((*PrecompileMethodGasCost)(nil), "cosmwasm.wasm.v1.PrecompileMethodGasCost")
 	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*GaslessConfig)(nil), "cosmwasm.wasm.v1.GaslessConfig")
+	proto.RegisterType((*GaslessPolicy)(nil), "cosmwasm.wasm.v1.GaslessPolicy")
+	proto.RegisterType((*GaslessUsage)(nil), "cosmwasm.wasm.v1.GaslessUsage")
+	proto.RegisterType((*BankAllowance)(nil), "cosmwasm.wasm.v1.BankAllowance")
+	proto.RegisterType((*BankPointer)(nil), "cosmwasm.wasm.v1.BankPointer")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0xf2, 0xfd, 0xb2, 0x1d, 0x52, 0xd5, 0x31, 0x91, 0x6d, 0x96,
-	0x12, 0xda, 0xb4, 0xb5, 0xdb, 0x80, 0x2a, 0xd4, 0x43, 0x25, 0xff, 0xd8, 0x36, 0x5b, 0x29, 0xb6,
-	0x35, 0x76, 0x29, 0x41, 0x2a, 0xab, 0xf5, 0xee, 0xd8, 0x19, 0x6a, 0xef, 0x58, 0x3b, 0xe3, 0xd4,
-	0xbe, 0x72, 0x42, 0x46, 0x48, 0x1c, 0x38, 0x20, 0x24, 0x4b, 0x48, 0x20, 0xe8, 0xb1, 0x87, 0xfe,
-	0x03, 0xdc, 0x2a, 0x4e, 0x15, 0x27, 0x4e, 0x16, 0xa4, 0x87, 0x72, 0xce, 0x81, 0x43, 0x4f, 0x68,
-	0x67, 0x62, 0x76, 0x45, 0x9b, 0xc6, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x3e, 0x9f, 0xf7, 0xde, 0x67,
-	0x66, 0xdf, 0x2e, 0x58, 0xb7, 0x29, 0xeb, 0xdd, 0xb7, 0x58, 0xaf, 0x20, 0x1e, 0xfb, 0x57, 0x0a,
-	0x7c, 0xd4, 0xc7, 0x2c, 0xdf, 0xf7, 0x28, 0xa7, 0x50, 0x9d, 0x79, 0xf3, 0xe2, 0xb1, 0x7f, 0x25,
-	0xbd, 0xe6, 0x5b, 0x28, 0x33, 0x85, 0xbf, 0x20, 0x37, 0x32, 0x38, 0xbd, 0xda, 0xa1, 0x1d, 0x2a,
-	0xed, 0xfe, 0xea, 0xc8, 0xba, 0xd6, 0xa1, 0xb4, 0xd3, 0xc5, 0x05, 0xb1, 0x6b, 0x0d, 0xda, 0x05,
-	0xcb, 0x1d, 0x1d, 0xb9, 0x4e, 0x59, 0x3d, 0xe2, 0xd2, 0x82, 0x78, 0x4a, 0x93, 0x76, 0x17, 0xbc,
-	0x56, 0xb4, 0x6d, 0xcc, 0x58, 0x73, 0xd4, 0xc7, 0x75, 0xcb, 0xb3, 0x7a, 0xb0, 0x02, 0x16, 0xf6,
-	0xad, 0xee, 0x00, 0xa7, 0x94, 0x9c, 0x72, 0xee, 0xff, 0x5b, 0xeb, 0xf9, 0x7f, 0xd6, 0x94, 0x0f,
-	0x10, 0x25, 0xf5, 0x70, 0x9a, 0x5d, 0x19, 0x59, 0xbd, 0xee, 0x35, 0x4d, 0x80, 0x34, 0x24, 0xc1,
-	0xd7, 0xe2, 0x5f, 0x7f, 0x9b, 0x55, 0xb4, 0x1f, 0x15, 0xb0, 0x22, 0xa3, 0xcb, 0xd4, 0x6d, 0x93,
-	0x0e, 0x6c, 0x00, 0xd0, 0xc7, 0x5e, 0x8f, 0x30, 0x46, 0xa8, 0x3b, 0x57, 0x86, 0xd3, 0x87, 0xd3,
-	0xec, 0x29, 0x99, 0x21, 0x40, 0x6a, 0x28, 0x44, 0x03, 0xaf, 0x82, 0xa4, 0xe5, 0x38, 0x1e, 0x66,
-	0x0c, 0xb3, 0x54, 0x2c, 0x17, 0x3b, 0x97, 0x2c, 0xa5, 0x7e, 0x79, 0x74, 0x69, 0xf5, 0x48, 0xad,
-	0xa2, 0xf4, 0x35, 0xb8, 0x47, 0xdc, 0x0e, 0x0a, 0x42, 0x65, 0x8d, 0xb7, 0xe2, 0x89, 0xa8, 0x1a,
-	0xd3, 0xbe, 0x8a, 0x82, 0x45, 0xd1, 0x3f, 0x83, 0x1c, 0x40, 0x9b, 0x3a, 0xd8, 0x1c, 0xf4, 0xbb,
-	0xd4, 0x72, 0x4c, 0x4b, 0xd4, 0x22, 0x6a, 0x5d, 0xde, 0xca, 0x1c, 0x57, 0xab, 0xec, 0xaf, 0xb4,
-	0xf1, 0x78, 0x9a, 0x8d, 0x1c, 0x4e, 0xb3, 0x6b, 0xb2, 0xe2, 0x17, 0x79, 0xb4, 0x07, 0xcf, 0x1e,
-	0x6e, 0x2a, 0x48, 0xf5, 0x3d, 0xb7, 0x85, 0x43, 0xe2, 0xe1, 0x17, 0x0a, 0xc8, 0x10, 0x97, 0x71,
-	0xcb, 0xe5, 0xc4, 0xe2, 0xd8, 0x74, 0x70, 0xdb, 0x1a, 0x74, 0xb9, 0x19, 0x92, 0x2b, 0x3a, 0x87,
-	0x5c, 0xe7, 0x0f, 0xa7, 0xd9, 0xb7, 0x65, 0xf2, 0x57, 0xb3, 0x69, 0x68, 0x3d, 0x14, 0x50, 0x91,
-	0xfe, 0xfa, 0xdf, 0x6e, 0x21, 0x4e, 0x44, 0xfb, 0x49, 0x01, 0x89, 0x32, 0x75, 0xb0, 0xe1, 0xb6,
-	0x29, 0x7c, 0x03, 0x24, 0x45, 0x43, 0x7b, 0x16, 0xdb, 0x13, 0x7a, 0xac, 0xa0, 0x84, 0x6f, 0xd8,
-	0xb6, 0xd8, 0x1e, 0xdc, 0x02, 0x4b, 0xb6, 0x87, 0x2d, 0x4e, 0x3d, 0x51, 0xe7, 0xab, 0x8e, 0x60,
-	0x16, 0x08, 0x3f, 0x04, 0x30, 0x5c, 0xa4, 0x2d, 0x34, 0x4c, 0x2d, 0xcc, 0xa5, 0x74, 0xd2, 0x57,
-	0x5a, 0x8a, 0x79, 0x2a, 0x44, 0x22, 0xbd, 0xb7, 0xe2, 0x89, 0x98, 0x1a, 0xbf, 0x15, 0x4f, 0xc4,
-	0xd5, 0x05, 0xed, 0xd3, 0x18, 0x58, 0x29, 0x53, 0x97, 0x7b, 0x96, 0xcd, 0x45, 0x1f, 0x6f, 0x81,
-	0x25, 0xd1, 0x07, 0x71, 0x44, 0x17, 0xf1, 0x12, 0x38, 0x98, 0x66, 0x17, 0x45, 0x9b, 0x15, 0xb4,
-	0xe8, 0xbb, 0x0c, 0xe7, 0x3f, 0xf5, 0x93, 0x07, 0x0b, 0x96, 0xd3, 0x23, 0x6e, 0x2a, 0x76, 0x02,
-	0x42, 0x86, 0xc1, 0x55, 0xb0, 0xd0, 0xb5, 0x5a, 0xb8, 0x9b, 0x8a, 0xfb, 0xf1, 0x48, 0x6e, 0xe0,
-	0xf5, 0xa3, 0xcc, 0xd8, 0x39, 0x92, 0xe2, 0xec, 0x4b, 0xa4, 0x68, 0x31, 0xda, 0x1d, 0x70, 0xdc,
-	0x1c, 0xd6, 0x29, 0x23, 0x9c, 0x50, 0x17, 0xcd, 0x40, 0xf0, 0x12, 0x58, 0x26, 0x2d, 0xdb, 0xec,
-	0x53, 0x8f, 0xfb, 0x2d, 0x2e, 0x8a, 0x5a, 0xfe, 0x77, 0x30, 0xcd, 0x26, 0x8d, 0x52, 0xb9, 0x4e,
-	0x3d, 0x6e, 0x54, 0x50, 0x92, 0xb4, 0x6c, 0xb1, 0x74, 0xe0, 0xc7, 0x20, 0x89, 0x87, 0x1c, 0xbb,
-	0xe2, 0x8a, 0x2d, 0x89, 0x84, 0xab, 0x79, 0x39, 0x44, 0xf2, 0xb3, 0x21, 0x92, 0x2f, 0xba, 0xa3,
-	0xd2, 0xe6, 0xcf, 0x8f, 0x2e, 0x6d, 0xbc, 0x50, 0x49, 0x58, 0x59, 0x7d, 0xc6, 0x83, 0x02, 0xca,
-	0x6b, 0xf1, 0x3f, 0xfc, 0x49, 0xf0, 0x79, 0x14, 0xa4, 0x66, 0xa1, 0xbe, 0xd2, 0xdb, 0x84, 0x71,
-	0xea, 0x8d, 0x74, 0x97, 0x7b, 0x23, 0x58, 0x07, 0x49, 0xda, 0xc7, 0x9e, 0xc5, 0x83, 0xa1, 0xb0,
-	0x95, 0x3f, 0x36, 0x53, 0x08, 0x5e, 0x9b, 0xa1, 0xfc, 0xbb, 0x8f, 0x02, 0x92, 0xf0, 0x11, 0x47,
-	0x8f, 0x3d, 0xe2, 0xeb, 0x60, 0x69, 0xd0, 0x77, 0x84, 0xd0, 0xb1, 0x7f, 0x23, 0xf4, 0x11, 0x08,
-	0xbe, 0x0f, 0x62, 0x3d, 0xd6, 0x11, 0x87, 0xb7, 0x52, 0xda, 0x78, 0x3e, 0xcd, 0x42, 0x64, 0xdd,
-	0x9f, 0x55, 0xb9, 0x83, 0x19, 0xb3, 0x3a, 0xf8, 0x9b, 0x67, 0x0f, 0x37, 0x97, 0x89, 0xdb, 0x25,
-	0x2e, 0x36, 0x3f, 0x61, 0xd4, 0x45, 0x3e, 0x44, 0x43, 0x00, 0xbe, 0x48, 0x0c, 0xdf, 0x04, 0x2b,
-	0xad, 0x2e, 0xb5, 0xef, 0x99, 0x7b, 0x98, 0x74, 0xf6, 0xb8, 0xbc, 0x9c, 0x68, 0x59, 0xd8, 0xb6,
-	0x85, 0x09, 0xae, 0x81, 0x04, 0x1f, 0x9a, 0xc4, 0x75, 0xf0, 0x50, 0x36, 0x86, 0x96, 0xf8, 0xd0,
-	0xf0, 0xb7, 0x1a, 0x06, 0x0b, 0x3b, 0xd4, 0xc1, 0x5d, 0x78, 0x03, 0xc4, 0xee, 0xe1, 0x91, 0x7c,
-	0x41, 0x4b, 0xef, 0x3d, 0x9f, 0x66, 0x2f, 0x77, 0x08, 0xdf, 0x1b, 0xb4, 0xf2, 0x36, 0xed, 0x15,
-	0x6c, 0xda, 0xc3, 0xbc, 0xd5, 0xe6, 0xc1, 0xa2, 0x4b, 0x5a, 0xac, 0xd0, 0x1a, 0x71, 0xcc, 0xf2,
-	0xdb, 0x78, 0x58, 0xf2, 0x17, 0xc8, 0x27, 0xf0, 0x6f, 0xa7, 0xfc, 0x10, 0x44, 0xc5, 0xab, 0x2e,
-	0x37, 0x9b, 0x7f, 0x2a, 0x00, 0x04, 0xf3, 0x06, 0x5e, 0x05, 0x67, 0x8a, 0xe5, 0xb2, 0xde, 0x68,
-	0x98, 0xcd, 0xdd, 0xba, 0x6e, 0xde, 0xae, 0x36, 0xea, 0x7a, 0xd9, 0xb8, 0x61, 0xe8, 0x15, 0x35,
-	0x92, 0x5e, 0x1b, 0x4f, 0x72, 0xa7, 0x83, 0xe0, 0xdb, 0x2e, 0xeb, 0x63, 0x9b, 0xb4, 0x09, 0x76,
-	0xe0, 0x45, 0x00, 0xc3, 0xb8, 0x6a, 0xad, 0x54, 0xab, 0xec, 0xaa, 0x4a, 0x7a, 0x75, 0x3c, 0xc9,
-	0xa9, 0x01, 0xa4, 0x4a, 0x5b, 0xd4, 0x19, 0xc1, 0x2d, 0x70, 0x3a, 0x1c, 0xad, 0x7f, 0xa0, 0xa3,
-	0x5d, 0x01, 0x88, 0xa5, 0xcf, 0x8c, 0x27, 0xb9, 0xd7, 0x03, 0x80, 0xbe, 0x8f, 0xbd, 0x91, 0xc0,
-	0x5c, 0x07, 0xeb, 0x61, 0x4c, 0xb1, 0xba, 0x6b, 0xd6, 0x6e, 0x98, 0xc5, 0x4a, 0x05, 0xe9, 0x8d,
-	0x86, 0xde, 0x50, 0xe3, 0xe9, 0xf5, 0xf1, 0x24, 0x97, 0x0a, 0xa0, 0x45, 0x77, 0x54, 0x6b, 0x17,
-	0x67, 0x5f, 0x87, 0x74, 0xe2, 0xb3, 0xef, 0x32, 0x91, 0x07, 0xdf, 0x67, 0x22, 0x9a, 0xff, 0x85,
-	0x88, 0x6e, 0xfe, 0x10, 0x03, 0xb9, 0x93, 0xae, 0x20, 0xc4, 0xe0, 0x72, 0xb9, 0x56, 0x6d, 0xa2,
-	0x62, 0xb9, 0x69, 0x96, 0x6b, 0x15, 0xdd, 0xdc, 0x36, 0x1a, 0xcd, 0x1a, 0xda, 0x35, 0x6b, 0x75,
-	0x1d, 0x15, 0x9b, 0x46, 0xad, 0xfa, 0x32, 0x9d, 0x0a, 0xe3, 0x49, 0xee, 0xc2, 0x49, 0xdc, 0x61,
-	0xf5, 0xee, 0x80, 0xf3, 0x73, 0xa5, 0x31, 0xaa, 0x46, 0x53, 0x55, 0xd2, 0xe7, 0xc6, 0x93, 0xdc,
-	0xd9, 0x93, 0xf8, 0x0d, 0x97, 0x70, 0x78, 0x17, 0x5c, 0x9c, 0x8b, 0x78, 0xc7, 0xb8, 0x89, 0x8a,
-	0x4d, 0x5d, 0x8d, 0xa6, 0x2f, 0x8c, 0x27, 0xb9, 0x77, 0x4e, 0xe2, 0xde, 0x21, 0x1d, 0xcf, 0xe2,
-	0x78, 0x6e, 0xfa, 0x9b, 0x7a, 0x55, 0x6f, 0x18, 0x0d, 0x35, 0x36, 0x1f, 0xfd, 0x4d, 0xec, 0x62,
-	0x46, 0x58, 0x3a, 0xee, 0x1f, 0x59, 0x69, 0xfb, 0xf1, 0xef, 0x99, 0xc8, 0x83, 0x83, 0x8c, 0xf2,
-	0xf8, 0x20, 0xa3, 0x3c, 0x39, 0xc8, 0x28, 0xbf, 0x1d, 0x64, 0x94, 0x2f, 0x9f, 0x66, 0x22, 0x4f,
-	0x9e, 0x66, 0x22, 0xbf, 0x3e, 0xcd, 0x44, 0x3e, 0xda, 0x08, 0xbd, 0x10, 0x65, 0xca, 0x7a, 0x77,
-	0x66, 0xff, 0x63, 0x4e, 0x61, 0x28, 0xff, 0xcb, 0xc4, 0x4f, 0x59, 0x6b, 0x51, 0xcc, 0xbf, 0x77,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xba, 0x63, 0x50, 0xbd, 0xb5, 0x09, 0x00, 0x00,
+	// 1988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcb, 0x6f, 0x1b, 0xc9,
+	0xd1, 0xd7, 0x90, 0xd4, 0x83, 0xad, 0x17, 0xd5, 0x96, 0xd6, 0x34, 0xd7, 0x20, 0xb9, 0xe3, 0xc7,
+	0xfa, 0xb1, 0x26, 0x6d, 0x7d, 0x1f, 0x36, 0x89, 0x81, 0x35, 0xc0, 0x21, 0x69, 0x89, 0xce, 0x4a,
+	0x62, 0x86, 0x74, 0xbc, 0x4e, 0xb0, 0x19, 0x34, 0x67, 0x5a, 0xd4, 0x44, 0x33, 0xd3, 0xc4, 0x74,
+	0xd3, 0x22, 0x73, 0xcc, 0x25, 0x81, 0x82, 0x20, 0xb9, 0x24, 0x08, 0x02, 0x08, 0x48, 0x90, 0x20,
+	0x31, 0x90, 0xcb, 0x1e, 0xfc, 0x0f, 0xe4, 0x14, 0x63, 0x91, 0xc3, 0x62, 0x73, 0x09, 0x72, 0x20,
+	0x12, 0xf9, 0xb0, 0x39, 0xeb, 0x90, 0xc3, 0x1e, 0x82, 0xa0, 0x1f, 0x14, 0x47, 0x96, 0xf5, 0x48,
+	0x2e, 0xd4, 0x74, 0x57, 0xfd, 0x7e, 0x55, 0x5d, 0x55, 0x53, 0xd5, 0x23, 0x70, 0xd9, 0x26, 0xd4,
+	0xdf, 0x41, 0xd4, 0x2f, 0x8a, 0x9f, 0x67, 0xf7, 0x8a, 0xac, 0xdf, 0xc1, 0xb4, 0xd0, 0x09, 0x09,
+	0x23, 0x30, 0x35, 0x94, 0x16, 0xc4, 0xcf, 0xb3, 0x7b, 0x99, 0x4b, 0x7c, 0x87, 0x50, 0x4b, 0xc8,
+	0x8b, 0x72, 0x21, 0x95, 0x33, 0x8b, 0x6d, 0xd2, 0x26, 0x72, 0x9f, 0x3f, 0xa9, 0xdd, 0x4b, 0x6d,
+	0x42, 0xda, 0x1e, 0x2e, 0x8a, 0x55, 0xab, 0xbb, 0x59, 0x44, 0x41, 0x5f, 0x89, 0x16, 0x90, 0xef,
+	0x06, 0xa4, 0x28, 0x7e, 0xd5, 0x56, 0x56, 0x32, 0x16, 0x5b, 0x88, 0xe2, 0xe2, 0xb3, 0x7b, 0x2d,
+	0xcc, 0xd0, 0xbd, 0xa2, 0x4d, 0xdc, 0x40, 0xca, 0xf5, 0x8f, 0xc1, 0x7c, 0xc9, 0xb6, 0x31, 0xa5,
+	0xcd, 0x7e, 0x07, 0xd7, 0x51, 0x88, 0x7c, 0x58, 0x01, 0xe3, 0xcf, 0x90, 0xd7, 0xc5, 0x69, 0x2d,
+	0xaf, 0xdd, 0x98, 0x5b, 0xbe, 0x5c, 0x78, 0xdd, 0xe7, 0xc2, 0x08, 0x61, 0xa4, 0x0e, 0x06, 0xb9,
+	0x99, 0x3e, 0xf2, 0xbd, 0xfb, 0xba, 0x00, 0xe9, 0xa6, 0x04, 0xdf, 0x4f, 0xfc, 0xe2, 0x57, 0x39,
+	0x4d, 0xff, 0xbd, 0x06, 0x66, 0xa4, 0x76, 0x99, 0x04, 0x9b, 0x6e, 0x1b, 0x36, 0x00, 0xe8, 0xe0,
+	0xd0, 0x77, 0x29, 0x75, 0x49, 0x70, 0x2e, 0x0b, 0x4b, 0x07, 0x83, 0xdc, 0x82, 0xb4, 0x30, 0x42,
+	0xea, 0x66, 0x84, 0x06, 0xbe, 0x0f, 0x92, 0xc8, 0x71, 0x42, 0x4c, 0x29, 0xa6, 0xe9, 0x78, 0x3e,
+	0x7e, 0x23, 0x69, 0xa4, 0x3f, 0x7f, 0x71, 0x67, 0x51, 0x45, 0xb3, 0x24, 0x65, 0x0d, 0x16, 0xba,
+	0x41, 0xdb, 0x1c, 0xa9, 0x4a, 0x1f, 0x1f, 0x25, 0xa6, 0x62, 0xa9, 0xb8, 0xfe, 0xef, 0x38, 0x98,
+	0x10, 0xe7, 0xa7, 0x90, 0x01, 0x68, 0x13, 0x07, 0x5b, 0xdd, 0x8e, 0x47, 0x90, 0x63, 0x21, 0xe1,
+	0x8b, 0xf0, 0x75, 0x7a, 0x39, 0x7b, 0x92, 0xaf, 0xf2, 0x7c, 0xc6, 0xf5, 0x97, 0x83, 0xdc, 0xd8,
+	0xc1, 0x20, 0x77, 0x49, 0x7a, 0x7c, 0x9c, 0x47, 0x7f, 0xfe, 0xc5, 0x27, 0xb7, 0x34, 0x33, 0xc5,
+	0x25, 0x8f, 0x85, 0x40, 0xe2, 0xe1, 0x8f, 0x35, 0x90, 0x75, 0x03, 0xca, 0x50, 0xc0, 0x5c, 0xc4,
+	0xb0, 0xe5, 0xe0, 0x4d, 0xd4, 0xf5, 0x98, 0x15, 0x09, 0x57, 0xec, 0x1c, 0xe1, 0xba, 0x79, 0x30,
+	0xc8, 0x5d, 0x93, 0xc6, 0x4f, 0x67, 0xd3, 0xcd, 0xcb, 0x11, 0x85, 0x8a, 0x94, 0xd7, 0x47, 0x41,
+	0x0d, 0xc1, 0x5c, 0x27, 0xc4, 0x36, 0xf1, 0x3b, 0xae, 0x87, 0xad, 0x36, 0xe2, 0x91, 0xe5, 0x11,
+	0x78, 0xf7, 0xb8, 0xf9, 0xfa, 0xa1, 0xde, 0x0a, 0xa2, 0x0d, 0x7b, 0x0b, 0x3b, 0x5d, 0x0f, 0x1b,
+	0xba, 0x0a, 0xc5, 0x92, 0x4a, 0xde, 0x11, 0x32, 0x15, 0x86, 0xd9, 0x4e, 0x14, 0x0a, 0x19, 0x58,
+	0x68, 0x23, 0xea, 0x61, 0x4a, 0x2d, 0xd6, 0xb3, 0x3c, 0xd7, 0x77, 0x19, 0x4d, 0x27, 0x84, 0xd9,
+	0x77, 0x8e, 0x9b, 0x5d, 0x91, 0xaa, 0xcd, 0xde, 0x87, 0x42, 0xd1, 0xb8, 0xa6, 0x0c, 0xa6, 0xa5,
+	0xc1, 0x63, 0x4c, 0xca, 0xe6, 0x7c, 0xfb, 0x28, 0x4e, 0x94, 0xc1, 0x98, 0xfe, 0x67, 0x0d, 0xcc,
+	0xbf, 0xc6, 0x08, 0x6f, 0x83, 0x49, 0x1f, 0xf5, 0xc4, 0xe1, 0x79, 0xfa, 0x13, 0x06, 0x3c, 0x18,
+	0xe4, 0xe6, 0x24, 0xbd, 0x12, 0xe8, 0xe6, 0x84, 0x8f, 0x7a, 0xdc, 0xf9, 0x35, 0x70, 0x81, 0xef,
+	0xb1, 0x1e, 0xe5, 0x51, 0xe6, 0xe9, 0x26, 0xdd, 0x80, 0x89, 0xa4, 0xcd, 0x1a, 0xd9, 0x83, 0x41,
+	0x2e, 0x33, 0x02, 0xbe, 0xa6, 0xa4, 0x9b, 0x29, 0x1f, 0xf5, 0x9a, 0x3d, 0x5a, 0xc7, 0x61, 0x49,
+	0x6e, 0xc1, 0x0f, 0xc0, 0xec, 0x8e, 0x1b, 0x38, 0x64, 0xc7, 0x6a, 0x79, 0xc4, 0xde, 0x96, 0xe1,
+	0x8f, 0x1b, 0xe9, 0x83, 0x41, 0x6e, 0x51, 0x12, 0x1d, 0x11, 0xeb, 0xe6, 0x8c, 0x5c, 0x1b, 0x72,
+	0xf9, 0x27, 0x0d, 0x2c, 0xbd, 0x31, 0x2f, 0xf0, 0x1b, 0x60, 0x52, 0x55, 0x83, 0xaa, 0xe9, 0x2b,
+	0x67, 0x64, 0xb4, 0x4c, 0x28, 0x8b, 0x9e, 0x5c, 0xa1, 0x75, 0x73, 0xc8, 0x03, 0xbf, 0x0d, 0x26,
+	0x7d, 0xcc, 0xb6, 0x88, 0x43, 0xd3, 0xb1, 0x7c, 0xfc, 0xc6, 0xf4, 0xf2, 0xcd, 0xd3, 0x28, 0xd7,
+	0x84, 0xea, 0x90, 0xf8, 0x2d, 0x95, 0xb5, 0x61, 0x58, 0x25, 0x8f, 0x6e, 0x0e, 0x19, 0xf5, 0x9f,
+	0xc7, 0xc0, 0xc2, 0x31, 0x7f, 0x60, 0x01, 0x4c, 0xf1, 0x9e, 0x16, 0xc9, 0xcd, 0x85, 0x83, 0x41,
+	0x6e, 0x5e, 0x92, 0x0c, 0x25, 0xba, 0x39, 0xc9, 0x1f, 0x79, 0x76, 0xbe, 0x06, 0x66, 0x78, 0xc0,
+	0x5b, 0x7d, 0x26, 0x31, 0x31, 0x81, 0xb9, 0x78, 0x30, 0xc8, 0x5d, 0x38, 0x6c, 0x2e, 0x87, 0x52,
+	0xd9, 0x5e, 0x8c, 0x3e, 0x13, 0xd0, 0x9f, 0x69, 0x00, 0x52, 0x67, 0x9b, 0x0b, 0x2c, 0xbf, 0xeb,
+	0x31, 0xb7, 0xe3, 0xb9, 0x38, 0x14, 0xf9, 0x48, 0x1a, 0x6d, 0xee, 0xfe, 0xdf, 0x06, 0xb9, 0xb7,
+	0x65, 0xb3, 0xa1, 0xce, 0x76, 0xc1, 0x25, 0x45, 0x1f, 0xb1, 0xad, 0xc2, 0x87, 0xb8, 0x8d, 0xec,
+	0x7e, 0x05, 0xdb, 0xfb, 0x83, 0x5c, 0xaa, 0x51, 0xf9, 0xfa, 0x0a, 0xa2, 0x6b, 0x87, 0xf0, 0x51,
+	0x8f, 0x38, 0x4e, 0xad, 0x7f, 0xfe, 0xe2, 0x0e, 0x50, 0xcd, 0xab, 0x82, 0x6d, 0xd5, 0x31, 0xa8,
+	0xb3, 0x7d, 0x84, 0x41, 0xff, 0x89, 0x06, 0x2e, 0x9e, 0x10, 0x55, 0x98, 0x06, 0x93, 0xaa, 0xcf,
+	0x89, 0xe8, 0x24, 0xcd, 0xe1, 0x12, 0xbe, 0x05, 0x26, 0x64, 0x64, 0x45, 0x08, 0x92, 0xa6, 0x5a,
+	0x41, 0x03, 0x24, 0x6c, 0x42, 0x59, 0x3a, 0x7e, 0xfe, 0x9a, 0x48, 0xf2, 0xb3, 0x4b, 0xef, 0x04,
+	0x56, 0xff, 0xa3, 0x06, 0xa6, 0xca, 0xc4, 0xc1, 0xb5, 0x60, 0x93, 0xc0, 0xb7, 0x41, 0x52, 0xb4,
+	0xbf, 0x2d, 0x44, 0xb7, 0x84, 0x13, 0x33, 0xe6, 0x14, 0xdf, 0x58, 0x45, 0x74, 0x0b, 0x2e, 0x83,
+	0x49, 0x3b, 0xc4, 0x88, 0x91, 0x50, 0xba, 0x71, 0x4a, 0xc3, 0x1e, 0x2a, 0xc2, 0x8f, 0x00, 0x8c,
+	0xb6, 0x34, 0x5b, 0x74, 0xdc, 0xf4, 0xf8, 0xb9, 0xfa, 0x72, 0xc4, 0xd5, 0x85, 0x08, 0x89, 0x94,
+	0x3e, 0x4a, 0x4c, 0xc5, 0x53, 0x89, 0x47, 0x89, 0xa9, 0x44, 0x6a, 0x5c, 0xff, 0x7e, 0x1c, 0xcc,
+	0x94, 0x49, 0xc0, 0x42, 0x64, 0x33, 0x71, 0x8e, 0x2b, 0x60, 0x52, 0x9c, 0xc3, 0x75, 0x54, 0xa1,
+	0x81, 0xfd, 0x41, 0x6e, 0x42, 0x1c, 0xb3, 0x62, 0x4e, 0x70, 0x51, 0xcd, 0xf9, 0x9f, 0xce, 0x53,
+	0x00, 0xe3, 0xc8, 0xf1, 0xdd, 0x20, 0x1d, 0x3f, 0x03, 0x21, 0xd5, 0xe0, 0x22, 0x18, 0xf7, 0x50,
+	0x0b, 0x7b, 0xa2, 0x23, 0x26, 0x4d, 0xb9, 0x80, 0x0f, 0x94, 0x65, 0xec, 0xa8, 0x50, 0x5c, 0x7d,
+	0x43, 0x28, 0x5a, 0x94, 0x78, 0x5d, 0x86, 0x9b, 0xbd, 0x3a, 0xa1, 0x2e, 0x73, 0x49, 0x60, 0x0e,
+	0x41, 0xf0, 0x0e, 0x98, 0x76, 0x5b, 0xb6, 0xd5, 0x21, 0x21, 0xe3, 0x47, 0x9c, 0x10, 0xbe, 0xcc,
+	0xee, 0x0f, 0x72, 0xc9, 0x9a, 0x51, 0xae, 0x93, 0x90, 0xd5, 0x2a, 0x66, 0xd2, 0x6d, 0xd9, 0xe2,
+	0xd1, 0x81, 0xdf, 0x01, 0x49, 0xdc, 0x63, 0x38, 0x10, 0x03, 0x69, 0x52, 0x18, 0x5c, 0x2c, 0xc8,
+	0x2b, 0x49, 0x61, 0x78, 0x25, 0x29, 0x94, 0x82, 0xbe, 0x71, 0xeb, 0xd3, 0x17, 0x77, 0xae, 0x1f,
+	0xf3, 0x24, 0x1a, 0xd9, 0xea, 0x90, 0xc7, 0x1c, 0x51, 0xde, 0x4f, 0xfc, 0x93, 0xdf, 0x1b, 0x7e,
+	0x14, 0x03, 0xe9, 0xa1, 0x2a, 0x8f, 0xf4, 0xaa, 0x4b, 0x19, 0x09, 0xfb, 0xd5, 0x80, 0x85, 0x7d,
+	0x58, 0x07, 0x49, 0xd2, 0xc1, 0x21, 0x62, 0xa3, 0x2b, 0xc4, 0x72, 0xe1, 0x44, 0x4b, 0x11, 0xf8,
+	0xc6, 0x10, 0xc5, 0x27, 0xa5, 0x39, 0x22, 0x89, 0xa6, 0x38, 0x76, 0x62, 0x8a, 0x1f, 0x80, 0xc9,
+	0x6e, 0xc7, 0x11, 0x81, 0x8e, 0xff, 0x37, 0x81, 0x56, 0x20, 0xf8, 0x55, 0x10, 0xf7, 0x69, 0x5b,
+	0x24, 0x6f, 0xc6, 0xb8, 0xfe, 0xe5, 0x20, 0x07, 0x4d, 0xb4, 0x33, 0xf4, 0x72, 0x0d, 0x53, 0x8a,
+	0xda, 0xf8, 0x97, 0x5f, 0x7c, 0x72, 0x6b, 0xda, 0x0d, 0x3c, 0x37, 0xc0, 0xd6, 0x77, 0x29, 0x09,
+	0x4c, 0x0e, 0xd1, 0x4d, 0x00, 0x8f, 0x13, 0xc3, 0x77, 0xc0, 0x8c, 0x68, 0xfd, 0xd6, 0x16, 0x76,
+	0xdb, 0x5b, 0xb2, 0x99, 0x27, 0xcc, 0x69, 0xb1, 0xb7, 0x2a, 0xb6, 0xe0, 0x25, 0x30, 0xc5, 0x7a,
+	0x96, 0x1b, 0x38, 0xb8, 0x27, 0x0f, 0x66, 0x4e, 0xb2, 0x5e, 0x8d, 0x2f, 0x75, 0x0c, 0xc6, 0xd7,
+	0x88, 0x83, 0x3d, 0xf8, 0x10, 0xc4, 0xb7, 0x71, 0x5f, 0xbe, 0xa0, 0xc6, 0xff, 0x7f, 0x39, 0xc8,
+	0xdd, 0x6d, 0xbb, 0x6c, 0xab, 0xdb, 0x2a, 0xd8, 0xc4, 0x2f, 0xda, 0xc4, 0xc7, 0xac, 0xb5, 0xc9,
+	0x46, 0x0f, 0x9e, 0xdb, 0xa2, 0x45, 0xde, 0x27, 0x69, 0x61, 0x15, 0xf7, 0x78, 0x8b, 0xa4, 0x26,
+	0x27, 0xe0, 0xd5, 0x29, 0xaf, 0x8d, 0x31, 0xf1, 0xaa, 0xcb, 0x85, 0xfe, 0xeb, 0x18, 0x98, 0x55,
+	0x53, 0x55, 0xdd, 0x00, 0x6f, 0x82, 0x05, 0x35, 0x3a, 0xc5, 0x04, 0x14, 0xee, 0x2a, 0xdf, 0xe7,
+	0xe4, 0x24, 0xad, 0xe3, 0x50, 0x0c, 0x31, 0x78, 0x05, 0xcc, 0x45, 0x55, 0xd9, 0xf0, 0x10, 0xd3,
+	0x87, 0x7a, 0xcd, 0x1e, 0x7f, 0xf3, 0x68, 0x87, 0x04, 0x94, 0x84, 0x67, 0xbe, 0x47, 0x43, 0x45,
+	0xd8, 0x00, 0x0b, 0xea, 0x51, 0x92, 0x87, 0xae, 0x8d, 0xd5, 0x3d, 0x43, 0xde, 0xae, 0x08, 0x2d,
+	0xf0, 0xc1, 0x51, 0x50, 0x37, 0x66, 0xde, 0x85, 0xcb, 0xc4, 0x0d, 0xa2, 0x6d, 0x64, 0x5e, 0x31,
+	0x70, 0x47, 0x38, 0x1e, 0x7e, 0x05, 0x4c, 0x74, 0x88, 0xe7, 0xda, 0x7d, 0xf5, 0x1e, 0xe6, 0x4e,
+	0xbc, 0xb1, 0xd4, 0x85, 0x9a, 0xa9, 0xd4, 0xf5, 0x3f, 0x68, 0x60, 0xf6, 0x88, 0x04, 0x3e, 0x01,
+	0x13, 0x9b, 0xae, 0xc7, 0x70, 0x98, 0xd6, 0x4e, 0x79, 0xc3, 0x6e, 0x7e, 0xfa, 0xe2, 0xce, 0xb5,
+	0x13, 0xeb, 0xbe, 0xd4, 0x65, 0x5b, 0xdf, 0x7b, 0x28, 0x58, 0x3e, 0x32, 0x15, 0x1d, 0x2c, 0x81,
+	0x79, 0xe4, 0x79, 0x64, 0x07, 0x3b, 0x96, 0x8d, 0x3c, 0x0f, 0x87, 0x72, 0x60, 0x9f, 0x16, 0xb4,
+	0x39, 0x05, 0x28, 0x4b, 0x7d, 0xfd, 0x07, 0x1a, 0x98, 0x51, 0xde, 0x3e, 0xe6, 0x25, 0xcb, 0x07,
+	0x4a, 0xa4, 0x02, 0xe3, 0xa6, 0x5a, 0xc1, 0xab, 0x60, 0x4e, 0xd6, 0x27, 0x0f, 0x71, 0x97, 0x62,
+	0xf5, 0x6e, 0x99, 0xb2, 0x6a, 0x57, 0x10, 0x7d, 0x4c, 0xb1, 0x73, 0xa4, 0x44, 0x79, 0xfe, 0x66,
+	0x0f, 0x4b, 0x14, 0x66, 0xc1, 0x34, 0xeb, 0x8d, 0xd0, 0x09, 0x81, 0x4e, 0xb2, 0x9e, 0x82, 0xea,
+	0x7f, 0xd1, 0xc0, 0xac, 0x81, 0x82, 0xed, 0x12, 0x77, 0x10, 0x05, 0x36, 0xe6, 0x1d, 0x95, 0xec,
+	0x04, 0x2a, 0x6c, 0xa7, 0x76, 0x54, 0xa1, 0x26, 0x6b, 0x07, 0x07, 0x0e, 0x3e, 0x47, 0xd7, 0x56,
+	0x8a, 0xbc, 0xce, 0x1d, 0x1c, 0x10, 0x5f, 0x56, 0x9b, 0x29, 0x17, 0x70, 0x15, 0x4c, 0x20, 0x5f,
+	0xdc, 0xf7, 0x44, 0x73, 0x36, 0xee, 0xaa, 0x6b, 0xc1, 0xd2, 0xf1, 0x6b, 0x41, 0x2d, 0x60, 0x91,
+	0xf9, 0x5e, 0x0b, 0x98, 0xac, 0x27, 0x85, 0xd7, 0x3f, 0x00, 0xd3, 0xfc, 0x50, 0x75, 0xe2, 0x06,
+	0x2c, 0x6a, 0x4e, 0x8b, 0x9a, 0x8b, 0x8c, 0xf7, 0xd8, 0x91, 0xf1, 0x7e, 0xeb, 0x5f, 0x1a, 0x00,
+	0xa3, 0xcf, 0x01, 0xf8, 0x3e, 0xb8, 0x58, 0x2a, 0x97, 0xab, 0x8d, 0x86, 0xd5, 0x7c, 0x5a, 0xaf,
+	0x5a, 0x8f, 0xd7, 0x1b, 0xf5, 0x6a, 0xb9, 0xf6, 0xb0, 0x56, 0xad, 0xa4, 0xc6, 0x32, 0x97, 0x76,
+	0xf7, 0xf2, 0x4b, 0x23, 0xe5, 0xc7, 0x01, 0xed, 0x60, 0xdb, 0xdd, 0x74, 0xb1, 0x03, 0xdf, 0x03,
+	0x30, 0x8a, 0x5b, 0xdf, 0x30, 0x36, 0x2a, 0x4f, 0x53, 0x5a, 0x66, 0x71, 0x77, 0x2f, 0x9f, 0x1a,
+	0x41, 0xd6, 0x49, 0x8b, 0x38, 0x7d, 0xb8, 0x0c, 0x96, 0xa2, 0xda, 0xd5, 0x6f, 0x56, 0xcd, 0xa7,
+	0x02, 0x10, 0xcf, 0x5c, 0xdc, 0xdd, 0xcb, 0x5f, 0x18, 0x01, 0xaa, 0xcf, 0x70, 0xd8, 0x17, 0x98,
+	0x07, 0xe0, 0x72, 0x14, 0x53, 0x5a, 0x7f, 0x6a, 0x6d, 0x3c, 0xb4, 0x4a, 0x95, 0x8a, 0x59, 0x6d,
+	0x34, 0xaa, 0x8d, 0x54, 0x22, 0x73, 0x79, 0x77, 0x2f, 0x9f, 0x1e, 0x41, 0x4b, 0x41, 0x7f, 0x63,
+	0xb3, 0x34, 0xfc, 0x78, 0xcb, 0x4c, 0xfd, 0xf0, 0x37, 0xd9, 0xb1, 0xe7, 0xbf, 0xcd, 0x8e, 0xe9,
+	0xfc, 0x03, 0x2e, 0x76, 0xeb, 0x77, 0x71, 0x90, 0x3f, 0xab, 0xe7, 0x43, 0x0c, 0xee, 0x96, 0x37,
+	0xd6, 0x9b, 0x66, 0xa9, 0xdc, 0xb4, 0xca, 0x1b, 0x95, 0xaa, 0xb5, 0x5a, 0x6b, 0x34, 0x37, 0xcc,
+	0xa7, 0xd6, 0x46, 0xbd, 0x6a, 0x96, 0x9a, 0xb5, 0x8d, 0xf5, 0x37, 0xc5, 0xa9, 0xb8, 0xbb, 0x97,
+	0xbf, 0x7d, 0x16, 0x77, 0x34, 0x7a, 0x4f, 0xc0, 0xcd, 0x73, 0x99, 0xa9, 0xad, 0xd7, 0x9a, 0x29,
+	0x2d, 0x73, 0x63, 0x77, 0x2f, 0x7f, 0xf5, 0x2c, 0xfe, 0x5a, 0xe0, 0x32, 0xf8, 0x31, 0x78, 0xef,
+	0x5c, 0xc4, 0x6b, 0xb5, 0x15, 0xb3, 0xd4, 0xac, 0xa6, 0x62, 0x99, 0xdb, 0xbb, 0x7b, 0xf9, 0x77,
+	0xcf, 0xe2, 0x5e, 0x73, 0xdb, 0x21, 0x62, 0xf8, 0xdc, 0xf4, 0x2b, 0xd5, 0xf5, 0x6a, 0xa3, 0xd6,
+	0x48, 0xc5, 0xcf, 0x47, 0xbf, 0x82, 0x03, 0x4c, 0x5d, 0x9a, 0x49, 0xf0, 0x94, 0x19, 0xab, 0x2f,
+	0xff, 0x91, 0x1d, 0x7b, 0xbe, 0x9f, 0xd5, 0x5e, 0xee, 0x67, 0xb5, 0xcf, 0xf6, 0xb3, 0xda, 0xdf,
+	0xf7, 0xb3, 0xda, 0x4f, 0x5f, 0x65, 0xc7, 0x3e, 0x7b, 0x95, 0x1d, 0xfb, 0xeb, 0xab, 0xec, 0xd8,
+	0xb7, 0xae, 0x47, 0x26, 0x50, 0x99, 0x50, 0xff, 0xc9, 0xf0, 0xdf, 0x29, 0x4e, 0xb1, 0x27, 0xfe,
+	0xca, 0xff, 0xa9, 0xb4, 0x26, 0x44, 0x3b, 0xfc, 0xbf, 0xff, 0x0c, 0x00, 0xf2, 0xbf, 0x87, 0x7b,
+	0x74, 0x11, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -607,16 +1075,22 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
+	if !this.PrecompileGas.Equal(&that1.PrecompileGas) {
+		return false
+	}
+	if !this.GaslessTxLimits.Equal(&that1.GaslessTxLimits) {
+		return false
+	}
 	return true
 }
-func (this *CodeInfo) Equal(that interface{}) bool {
+func (this *GaslessTxLimits) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CodeInfo)
+	that1, ok := that.(*GaslessTxLimits)
 	if !ok {
-		that2, ok := that.(CodeInfo)
+		that2, ok := that.(GaslessTxLimits)
 		if ok {
 			that1 = &that2
 		} else {
@@ -628,25 +1102,25 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+	if this.MaxGas != that1.MaxGas {
 		return false
 	}
-	if this.Creator != that1.Creator {
+	if this.MaxTxsPerAccount != that1.MaxTxsPerAccount {
 		return false
 	}
-	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+	if this.WindowBlocks != that1.WindowBlocks {
 		return false
 	}
 	return true
 }
-func (this *ContractInfo) Equal(that interface{}) bool {
+func (this *PrecompileGasSchedule) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractInfo)
+	that1, ok := that.(*PrecompileGasSchedule)
 	if !ok {
-		that2, ok := that.(ContractInfo)
+		that2, ok := that.(PrecompileGasSchedule)
 		if ok {
 			that1 = &that2
 		} else {
@@ -658,37 +1132,27 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	if !this.Created.Equal(that1.Created) {
+	if !this.Default.Equal(that1.Default) {
 		return false
 	}
-	if this.IBCPortID != that1.IBCPortID {
+	if len(this.Methods) != len(that1.Methods) {
 		return false
 	}
-	if !this.Extension.Equal(that1.Extension) {
-		return false
+	for i := range this.Methods {
+		if !this.Methods[i].Equal(&that1.Methods[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+func (this *PrecompileGasCost) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
+	that1, ok := that.(*PrecompileGasCost)
 	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
+		that2, ok := that.(PrecompileGasCost)
 		if ok {
 			that1 = &that2
 		} else {
@@ -700,28 +1164,25 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Operation != that1.Operation {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
+	if this.BaseGas != that1.BaseGas {
 		return false
 	}
-	if !this.Updated.Equal(that1.Updated) {
+	if this.PerByteGas != that1.PerByteGas {
 		return false
 	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
+	if !this.SDKGasMultiplier.Equal(that1.SDKGasMultiplier) {
 		return false
 	}
 	return true
 }
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+func (this *PrecompileMethodGasCost) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AbsoluteTxPosition)
+	that1, ok := that.(*PrecompileMethodGasCost)
 	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
+		that2, ok := that.(PrecompileMethodGasCost)
 		if ok {
 			that1 = &that2
 		} else {
@@ -733,22 +1194,25 @@ func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if this.Address != that1.Address {
 		return false
 	}
-	if this.TxIndex != that1.TxIndex {
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.Cost.Equal(&that1.Cost) {
 		return false
 	}
 	return true
 }
-func (this *Model) Equal(that interface{}) bool {
+func (this *CodeInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Model)
+	that1, ok := that.(*CodeInfo)
 	if !ok {
-		that2, ok := that.(Model)
+		that2, ok := that.(CodeInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -760,245 +1224,308 @@ func (this *Model) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
 		return false
 	}
 	return true
 }
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if !this.Extension.Equal(that1.Extension) {
+		return false
+	}
+	return true
 }
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Operation != that1.Operation {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
-		i--
-		dAtA[i] = 0x10
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if !this.Updated.Equal(that1.Updated) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+	if this.BlockHeight != that1.BlockHeight {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TxIndex != that1.TxIndex {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extension != nil {
-		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+func (this *GaslessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GaslessConfig)
+	if !ok {
+		that2, ok := that.(GaslessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if this.MaxGasPerTx != that1.MaxGasPerTx {
+		return false
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	if this.Sponsor != that1.Sponsor {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.SponsorGasPrice.Equal(&that1.SponsorGasPrice) {
+		return false
+	}
+	if !this.Policy.Equal(that1.Policy) {
+		return false
+	}
+	return true
 }
+func (this *GaslessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*GaslessPolicy)
+	if !ok {
+		that2, ok := that.(GaslessPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Filter.Equal(that1.Filter) {
+		return false
+	}
+	if len(this.AllowedCallers) != len(that1.AllowedCallers) {
+		return false
+	}
+	for i := range this.AllowedCallers {
+		if this.AllowedCallers[i] != that1.AllowedCallers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GaslessUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GaslessUsage)
+	if !ok {
+		that2, ok := that.(GaslessUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.BlockGasUsed != that1.BlockGasUsed {
+		return false
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	if this.TxGasUsed != that1.TxGasUsed {
+		return false
+	}
+	return true
+}
+func (this *BankAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BankAllowance)
+	if !ok {
+		that2, ok := that.(BankAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *BankPointer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BankPointer)
+	if !ok {
+		that2, ok := that.(BankPointer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1008,49 +1535,25 @@ func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1060,30 +1563,34 @@ func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1093,205 +1600,2057 @@ func (m *Model) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.GaslessTxLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PrecompileGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0x1a
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GaslessTxLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GaslessTxLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessTxLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.MaxTxsPerAccount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTxsPerAccount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PrecompileGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Default != nil {
+		{
+			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+func (m *PrecompileGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PrecompileGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.SDKGasMultiplier.Size()
+		i -= size
+		if _, err := m.SDKGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.PerByteGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PerByteGas))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	if m.BaseGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PrecompileMethodGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileMethodGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileMethodGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
-	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaslessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.SponsorGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaslessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedCallers) > 0 {
+		for iNdEx := len(m.AllowedCallers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCallers[iNdEx])
+			copy(dAtA[i:], m.AllowedCallers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedCallers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaslessUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxGasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxGasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BankAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BankPointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankPointer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankPointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	l = m.PrecompileGas.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.GaslessTxLimits.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GaslessTxLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGas))
+	}
+	if m.MaxTxsPerAccount != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTxsPerAccount))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *PrecompileGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Default != nil {
+		l = m.Default.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseGas != 0 {
+		n += 1 + sovTypes(uint64(m.BaseGas))
+	}
+	if m.PerByteGas != 0 {
+		n += 1 + sovTypes(uint64(m.PerByteGas))
+	}
+	l = m.SDKGasMultiplier.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PrecompileMethodGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Cost.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GaslessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerBlock))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerTx))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SponsorGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GaslessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AllowedCallers) > 0 {
+		for _, s := range m.AllowedCallers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaslessUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.BlockGasUsed))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	if m.TxGasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.TxGasUsed))
+	}
+	return n
+}
+
+func (m *BankAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *BankPointer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaslessTxLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GaslessTxLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaslessTxLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessTxLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessTxLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerAccount", wireType)
+			}
+			m.MaxTxsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerAccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Default == nil {
+				m.Default = &PrecompileGasCost{}
+			}
+			if err := m.Default.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, PrecompileMethodGasCost{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerByteGas", wireType)
+			}
+			m.PerByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SDKGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SDKGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileMethodGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileMethodGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileMethodGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1314,17 +3673,17 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			m.Value = 0
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1334,11 +3693,211 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1360,7 +3919,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,17 +3942,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Permission = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1403,16 +3962,35 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1422,23 +4000,61 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1461,7 +4077,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,17 +4100,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1504,30 +4120,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1537,7 +4139,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1563,7 +4165,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *Model) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,15 +4188,15 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1621,48 +4223,16 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1672,23 +4242,24 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1712,7 +4283,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *GaslessConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,17 +4306,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GaslessConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GaslessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
 			}
-			m.CodeID = 0
+			m.MaxGasPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1755,14 +4326,33 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1790,13 +4380,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorGasPrice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1806,29 +4396,30 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.SponsorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1838,27 +4429,81 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &GaslessPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaslessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1885,16 +4530,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
+			if m.Filter == nil {
+				m.Filter = &types.Any{}
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCallers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1922,43 +4567,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedCallers = append(m.AllowedCallers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1981,7 +4590,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *GaslessUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,17 +4613,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: GaslessUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GaslessUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Operation = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2024,16 +4633,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
 			}
-			m.CodeID = 0
+			m.BlockGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2043,16 +4652,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			var msglen int
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2062,33 +4671,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxGasUsed", wireType)
 			}
-			var byteLen int
+			m.TxGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2098,26 +4690,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TxGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2139,7 +4716,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *BankAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,17 +4739,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: BankAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BankAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2182,16 +4759,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
-			m.TxIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2201,11 +4791,90 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2227,7 +4896,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *BankPointer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,17 +4919,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: BankPointer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BankPointer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2270,31 +4939,29 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2304,25 +4971,23 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex